@@ -0,0 +1,141 @@
+package elf
+
+import (
+	"errors"
+	"fmt"
+)
+
+// UnmappedAddressError is returned for virtual addresses not covered by any PT_LOAD segment
+type UnmappedAddressError struct {
+	Address MemoryAddress
+}
+
+func (e *UnmappedAddressError) Error() string {
+	return fmt.Sprintf("address %v is not mapped by any loadable segment", e.Address)
+}
+
+// UnmappedOffsetError is returned for file offsets not loaded by any PT_LOAD segment
+type UnmappedOffsetError struct {
+	Offset FileOffset
+}
+
+func (e *UnmappedOffsetError) Error() string {
+	return fmt.Sprintf("file offset %v is not loaded by any loadable segment", e.Offset)
+}
+
+// ErrAddressNotInFile is returned for addresses of zero filled segment tails (.bss) which have no file offset
+var ErrAddressNotInFile = errors.New("address is not backed by file")
+
+// AddressToOffset translates virtual address to file offset using PT_LOAD segments
+func (f *File) AddressToOffset(address MemoryAddress) (FileOffset, error) {
+	segment := f.loadSegmentFor(address)
+	if segment == nil {
+		return 0, &UnmappedAddressError{Address: address}
+	}
+	delta := uint64(address - segment.VirtualAddress)
+	if delta >= segment.SizeInFile {
+		return 0, fmt.Errorf("%w: %v is in zero filled tail of segment at %v", ErrAddressNotInFile, address, segment.VirtualAddress)
+	}
+	return segment.FileOffset + FileOffset(delta), nil
+}
+
+// OffsetToAddress translates file offset to virtual address it's loaded at using PT_LOAD segments
+func (f *File) OffsetToAddress(offset FileOffset) (MemoryAddress, error) {
+	for _, segment := range f.Segments {
+		if segment.Type != SegmentTypeLoad {
+			continue
+		}
+		if offset >= segment.FileOffset && uint64(offset-segment.FileOffset) < segment.SizeInFile {
+			return segment.VirtualAddress + MemoryAddress(offset-segment.FileOffset), nil
+		}
+	}
+	return 0, &UnmappedOffsetError{Offset: offset}
+}
+
+// VirtualMemory returns view of process image as described by PT_LOAD segments
+func (f *File) VirtualMemory() *VirtualMemory {
+	return &VirtualMemory{file: f}
+}
+
+// VirtualMemory reads process image by virtual addresses, zero filled segment tails read as zeros
+type VirtualMemory struct {
+	file *File
+}
+
+// ReadAt reads len(p) bytes starting at virtual address, possibly spanning several adjacent segments.
+// UnmappedAddressError is returned together with count of bytes read if range reaches unmapped address
+func (vm *VirtualMemory) ReadAt(p []byte, address int64) (int, error) {
+	var n int
+	for n < len(p) {
+		current := MemoryAddress(uint64(address) + uint64(n))
+		segment := vm.file.loadSegmentFor(current)
+		if segment == nil {
+			return n, &UnmappedAddressError{Address: current}
+		}
+		delta := uint64(current - segment.VirtualAddress)
+		chunk := p[n:]
+		if available := memorySize(segment) - delta; uint64(len(chunk)) > available {
+			chunk = chunk[:available]
+		}
+		reader := zeroPaddedReaderAt{
+			reader:     segment.reader,
+			offset:     int64(segment.FileOffset),
+			sizeInFile: int64(segment.SizeInFile),
+		}
+		read, err := reader.ReadAt(chunk, int64(delta))
+		n += read
+		if err != nil {
+			return n, err
+		}
+	}
+	return n, nil
+}
+
+// read reads size bytes at virtual address. Size is checked against bytes mapped from address first, so sizes of malformed
+// tables fail without allocating huge buffers
+func (vm *VirtualMemory) read(address MemoryAddress, size uint64) ([]byte, error) {
+	if mapped := vm.mappedSize(address); size > mapped {
+		return nil, fmt.Errorf("%v bytes at %v overflow %v mapped bytes", size, address, mapped)
+	}
+	content := make([]byte, size)
+	if _, err := vm.ReadAt(content, int64(address)); err != nil {
+		return nil, err
+	}
+	return content, nil
+}
+
+// mappedSize returns count of bytes mapped from address up to first unmapped address, adjacent segments included
+func (vm *VirtualMemory) mappedSize(address MemoryAddress) uint64 {
+	var size uint64
+	for current := address; ; {
+		segment := vm.file.loadSegmentFor(current)
+		if segment == nil {
+			return size
+		}
+		available := memorySize(segment) - uint64(current-segment.VirtualAddress)
+		size += available
+		if current+MemoryAddress(available) <= current {
+			return size // segment ends at the top of address space
+		}
+		current += MemoryAddress(available)
+	}
+}
+
+func (f *File) loadSegmentFor(address MemoryAddress) *Segment {
+	for _, segment := range f.Segments {
+		if segment.Type != SegmentTypeLoad {
+			continue
+		}
+		if address >= segment.VirtualAddress && uint64(address-segment.VirtualAddress) < memorySize(segment) {
+			return segment
+		}
+	}
+	return nil
+}
+
+func memorySize(segment *Segment) uint64 {
+	if segment.SizeInFile > segment.SizeInMemory {
+		return segment.SizeInFile
+	}
+	return segment.SizeInMemory
+}
@@ -0,0 +1,70 @@
+package elf
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAddressOffsetTranslation(t *testing.T) {
+	file, err := Open(filepath.Join("testdata", "helloworld_linux_amd64"))
+	assert.NoError(t, err)
+	defer file.Close()
+
+	for _, name := range []string{".text", ".rodata", ".data", ".note.go.buildid"} {
+		section := file.Section(name)
+		offset, err := file.AddressToOffset(section.Virtual + 3)
+		assert.NoError(t, err)
+		assert.Equal(t, section.Offset+3, offset, name)
+
+		address, err := file.OffsetToAddress(section.Offset + 3)
+		assert.NoError(t, err)
+		assert.Equal(t, section.Virtual+3, address, name)
+	}
+
+	_, err = file.AddressToOffset(file.Section(".bss").Virtual)
+	assert.True(t, errors.Is(err, ErrAddressNotInFile))
+
+	_, err = file.AddressToOffset(0x10)
+	var unmappedAddress *UnmappedAddressError
+	assert.True(t, errors.As(err, &unmappedAddress))
+	assert.Equal(t, MemoryAddress(0x10), unmappedAddress.Address)
+
+	_, err = file.OffsetToAddress(0x7FFFFFFF)
+	var unmappedOffset *UnmappedOffsetError
+	assert.True(t, errors.As(err, &unmappedOffset))
+	assert.Equal(t, FileOffset(0x7FFFFFFF), unmappedOffset.Offset)
+}
+
+func TestVirtualMemory(t *testing.T) {
+	file, err := Open(filepath.Join("testdata", "helloworld_linux_amd64"))
+	assert.NoError(t, err)
+	defer file.Close()
+	memory := file.VirtualMemory()
+
+	text, err := file.Section(".text").Data()
+	assert.NoError(t, err)
+	buff := make([]byte, 64)
+	n, err := memory.ReadAt(buff, 0x401000)
+	assert.NoError(t, err)
+	assert.Equal(t, 64, n)
+	assert.Equal(t, text[:64], buff)
+
+	// last 4 bytes of .data are followed by zero filled .bss
+	data, err := file.Section(".data").Data()
+	assert.NoError(t, err)
+	dataEnd := int64(file.Section(".data").Virtual) + int64(len(data))
+	n, err = memory.ReadAt(buff[:8], dataEnd-4)
+	assert.NoError(t, err)
+	assert.Equal(t, 8, n)
+	assert.Equal(t, append(append([]byte{}, data[len(data)-4:]...), 0, 0, 0, 0), buff[:8])
+
+	// first segment ends at 0x491499 and next one starts at 0x492000
+	n, err = memory.ReadAt(buff[:8], 0x491499-2)
+	assert.Equal(t, 2, n)
+	var unmapped *UnmappedAddressError
+	assert.True(t, errors.As(err, &unmapped))
+	assert.Equal(t, MemoryAddress(0x491499), unmapped.Address)
+}
@@ -0,0 +1,326 @@
+package elf
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+type relocationKind int
+
+const (
+	relocationKindNone       relocationKind = iota
+	relocationKindAbsolute                  // S + A
+	relocationKindPCRelative                // S + A - P
+	relocationKindSymbolSize                // Z + A
+	relocationKindAdd                       // V + S + A
+	relocationKindSub                       // V - S - A
+	relocationKindSub6                      // V - S - A in low 6 bits
+	relocationKindSet6                      // S + A in low 6 bits
+	relocationKindSetULEB128                // S + A encoded in place of existing ULEB128
+	relocationKindSubULEB128                // V - S - A encoded in place of existing ULEB128
+)
+
+// relocationAction is how relocation of given type is applied and how many bytes it patches
+type relocationAction struct {
+	kind relocationKind
+	size int
+}
+
+var amd64RelocationActions = map[RelocationTypeAMD64]relocationAction{
+	R_X86_64_NONE:     {relocationKindNone, 0},
+	R_X86_64_64:       {relocationKindAbsolute, 8},
+	R_X86_64_32:       {relocationKindAbsolute, 4},
+	R_X86_64_32S:      {relocationKindAbsolute, 4},
+	R_X86_64_16:       {relocationKindAbsolute, 2},
+	R_X86_64_8:        {relocationKindAbsolute, 1},
+	R_X86_64_PC64:     {relocationKindPCRelative, 8},
+	R_X86_64_PC32:     {relocationKindPCRelative, 4},
+	R_X86_64_PLT32:    {relocationKindPCRelative, 4},
+	R_X86_64_PC16:     {relocationKindPCRelative, 2},
+	R_X86_64_PC8:      {relocationKindPCRelative, 1},
+	R_X86_64_SIZE64:   {relocationKindSymbolSize, 8},
+	R_X86_64_SIZE32:   {relocationKindSymbolSize, 4},
+	R_X86_64_DTPOFF64: {relocationKindAbsolute, 8},
+	R_X86_64_DTPOFF32: {relocationKindAbsolute, 4},
+}
+
+var i386RelocationActions = map[RelocationType386]relocationAction{
+	R_386_NONE:       {relocationKindNone, 0},
+	R_386_32:         {relocationKindAbsolute, 4},
+	R_386_16:         {relocationKindAbsolute, 2},
+	R_386_8:          {relocationKindAbsolute, 1},
+	R_386_PC32:       {relocationKindPCRelative, 4},
+	R_386_PLT32:      {relocationKindPCRelative, 4},
+	R_386_PC16:       {relocationKindPCRelative, 2},
+	R_386_PC8:        {relocationKindPCRelative, 1},
+	R_386_SIZE32:     {relocationKindSymbolSize, 4},
+	R_386_TLS_LDO_32: {relocationKindAbsolute, 4},
+}
+
+var aarch64RelocationActions = map[RelocationTypeAArch64]relocationAction{
+	R_AARCH64_NONE:   {relocationKindNone, 0},
+	R_AARCH64_NULL:   {relocationKindNone, 0},
+	R_AARCH64_ABS64:  {relocationKindAbsolute, 8},
+	R_AARCH64_ABS32:  {relocationKindAbsolute, 4},
+	R_AARCH64_ABS16:  {relocationKindAbsolute, 2},
+	R_AARCH64_PREL64: {relocationKindPCRelative, 8},
+	R_AARCH64_PREL32: {relocationKindPCRelative, 4},
+	R_AARCH64_PREL16: {relocationKindPCRelative, 2},
+}
+
+var armRelocationActions = map[RelocationTypeARM]relocationAction{
+	R_ARM_NONE:      {relocationKindNone, 0},
+	R_ARM_ABS32:     {relocationKindAbsolute, 4},
+	R_ARM_TARGET1:   {relocationKindAbsolute, 4},
+	R_ARM_ABS16:     {relocationKindAbsolute, 2},
+	R_ARM_ABS8:      {relocationKindAbsolute, 1},
+	R_ARM_REL32:     {relocationKindPCRelative, 4},
+	R_ARM_TLS_LDO32: {relocationKindAbsolute, 4},
+}
+
+var ppc64RelocationActions = map[RelocationTypePPC64]relocationAction{
+	R_PPC64_NONE:     {relocationKindNone, 0},
+	R_PPC64_ADDR64:   {relocationKindAbsolute, 8},
+	R_PPC64_ADDR32:   {relocationKindAbsolute, 4},
+	R_PPC64_ADDR16:   {relocationKindAbsolute, 2},
+	R_PPC64_REL64:    {relocationKindPCRelative, 8},
+	R_PPC64_REL32:    {relocationKindPCRelative, 4},
+	R_PPC64_DTPREL64: {relocationKindAbsolute, 8},
+}
+
+var riscvRelocationActions = map[RelocationTypeRISCV]relocationAction{
+	R_RISCV_NONE:         {relocationKindNone, 0},
+	R_RISCV_RELAX:        {relocationKindNone, 0},
+	R_RISCV_64:           {relocationKindAbsolute, 8},
+	R_RISCV_32:           {relocationKindAbsolute, 4},
+	R_RISCV_32_PCREL:     {relocationKindPCRelative, 4},
+	R_RISCV_SET32:        {relocationKindAbsolute, 4},
+	R_RISCV_SET16:        {relocationKindAbsolute, 2},
+	R_RISCV_SET8:         {relocationKindAbsolute, 1},
+	R_RISCV_SET6:         {relocationKindSet6, 1},
+	R_RISCV_ADD64:        {relocationKindAdd, 8},
+	R_RISCV_ADD32:        {relocationKindAdd, 4},
+	R_RISCV_ADD16:        {relocationKindAdd, 2},
+	R_RISCV_ADD8:         {relocationKindAdd, 1},
+	R_RISCV_SUB64:        {relocationKindSub, 8},
+	R_RISCV_SUB32:        {relocationKindSub, 4},
+	R_RISCV_SUB16:        {relocationKindSub, 2},
+	R_RISCV_SUB8:         {relocationKindSub, 1},
+	R_RISCV_SUB6:         {relocationKindSub6, 1},
+	R_RISCV_SET_ULEB128:  {relocationKindSetULEB128, 0},
+	R_RISCV_SUB_ULEB128:  {relocationKindSubULEB128, 0},
+	R_RISCV_TLS_DTPREL64: {relocationKindAbsolute, 8},
+	R_RISCV_TLS_DTPREL32: {relocationKindAbsolute, 4},
+}
+
+var s390RelocationActions = map[RelocationTypeS390]relocationAction{
+	R_390_NONE:      {relocationKindNone, 0},
+	R_390_64:        {relocationKindAbsolute, 8},
+	R_390_32:        {relocationKindAbsolute, 4},
+	R_390_16:        {relocationKindAbsolute, 2},
+	R_390_8:         {relocationKindAbsolute, 1},
+	R_390_PC64:      {relocationKindPCRelative, 8},
+	R_390_PC32:      {relocationKindPCRelative, 4},
+	R_390_PC16:      {relocationKindPCRelative, 2},
+	R_390_TLS_LDO64: {relocationKindAbsolute, 8},
+	R_390_TLS_LDO32: {relocationKindAbsolute, 4},
+}
+
+var ErrUnsupportedRelocation = errors.New("unsupported relocation")
+
+func relocationActionFor(relocationType RelocationType) (relocationAction, bool) {
+	var action relocationAction
+	var ok bool
+	switch rt := relocationType.(type) {
+	case RelocationTypeAMD64:
+		action, ok = amd64RelocationActions[rt]
+	case RelocationType386:
+		action, ok = i386RelocationActions[rt]
+	case RelocationTypeAArch64:
+		action, ok = aarch64RelocationActions[rt]
+	case RelocationTypeARM:
+		action, ok = armRelocationActions[rt]
+	case RelocationTypePPC64:
+		action, ok = ppc64RelocationActions[rt]
+	case RelocationTypeRISCV:
+		action, ok = riscvRelocationActions[rt]
+	case RelocationTypeS390:
+		action, ok = s390RelocationActions[rt]
+	}
+	return action, ok
+}
+
+// RelocationSectionFor returns SHT_REL or SHT_RELA section which applies to given section or nil if there is none
+func (f *File) RelocationSectionFor(section *Section) *Section {
+	index, ok := f.sectionIndex(section)
+	if !ok {
+		return nil
+	}
+	for _, candidate := range f.Sections {
+		if (candidate.Type == SectionTypeRelocEnt || candidate.Type == SectionTypeRelocEntNA) && candidate.Info == uint32(index) {
+			return candidate
+		}
+	}
+	return nil
+}
+
+// ApplyRelocations returns content of section with all entries of its relocation section applied. Symbol values are used as
+// is, so for ET_REL objects references to other sections resolve to offsets within them - what debug sections need
+func (f *File) ApplyRelocations(section *Section, relocationSection *Section) ([]byte, error) {
+	index, ok := f.sectionIndex(section)
+	if !ok {
+		return nil, fmt.Errorf("%w section %v does not belong to file", ErrInvalidRelocation, section.Name)
+	}
+	if relocationSection.Info != uint32(index) {
+		return nil, fmt.Errorf("%w section %v applies to section %v, not %v", ErrInvalidRelocation, relocationSection.Name, relocationSection.Info, index)
+	}
+	relocations, err := f.Relocations(relocationSection)
+	if err != nil {
+		return nil, err
+	}
+	content, err := section.Data()
+	if err != nil {
+		return nil, fmt.Errorf("%w section %v read: %v", ErrInvalidRelocation, section.Name, err)
+	}
+	// r_offset of ET_REL objects is relative to section, virtual address otherwise
+	base := section.Virtual
+	if f.ObjectType == ET_REL {
+		base = 0
+	}
+	if err := f.applyRelocations(content, section.Virtual, base, relocations); err != nil {
+		return nil, err
+	}
+	return content, nil
+}
+
+// applyRelocations patches content placed at given address, relocation offsets are relative to given base
+func (f *File) applyRelocations(content []byte, address, base MemoryAddress, relocations []Relocation) error {
+	byteOrder := f.ByteOrder()
+	for i, relocation := range relocations {
+		action, ok := relocationActionFor(relocation.Type)
+		if !ok {
+			return fmt.Errorf("%w %v of %v at %v", ErrUnsupportedRelocation, relocation.Type, f.ISet, relocation.Offset)
+		}
+		if action.kind == relocationKindNone {
+			continue
+		}
+
+		if relocation.Offset < base || uint64(relocation.Offset-base) >= uint64(len(content)) {
+			return fmt.Errorf("%w relocation %v offset %v out of section bounds", ErrInvalidRelocation, i, relocation.Offset)
+		}
+		location := uint64(relocation.Offset - base)
+		if location+uint64(action.size) > uint64(len(content)) {
+			return fmt.Errorf("%w relocation %v at %v overflows section", ErrInvalidRelocation, i, relocation.Offset)
+		}
+		field := content[location : location+uint64(action.size)]
+
+		var symbolValue, symbolSize uint64
+		if relocation.Symbol != nil {
+			symbolValue = uint64(relocation.Symbol.Value)
+			symbolSize = relocation.Symbol.Size
+		}
+		addend := uint64(relocation.Addend)
+		if !relocation.HasAddend {
+			// SHT_REL keeps addend in place being relocated
+			addend = readField(byteOrder, field)
+		}
+
+		switch action.kind {
+		case relocationKindAbsolute:
+			writeField(byteOrder, field, symbolValue+addend)
+		case relocationKindPCRelative:
+			place := uint64(address) + location
+			writeField(byteOrder, field, symbolValue+addend-place)
+		case relocationKindSymbolSize:
+			writeField(byteOrder, field, symbolSize+addend)
+		case relocationKindAdd:
+			writeField(byteOrder, field, readField(byteOrder, field)+symbolValue+uint64(relocation.Addend))
+		case relocationKindSub:
+			writeField(byteOrder, field, readField(byteOrder, field)-symbolValue-uint64(relocation.Addend))
+		case relocationKindSet6:
+			field[0] = field[0]&0xC0 | byte(symbolValue+uint64(relocation.Addend))&0x3F
+		case relocationKindSub6:
+			field[0] = field[0]&0xC0 | (field[0]-byte(symbolValue+uint64(relocation.Addend)))&0x3F
+		case relocationKindSetULEB128, relocationKindSubULEB128:
+			value, size := readULEB128(content[location:])
+			if size == 0 {
+				return fmt.Errorf("%w relocation %v at %v has no valid ULEB128", ErrInvalidRelocation, i, relocation.Offset)
+			}
+			if action.kind == relocationKindSetULEB128 {
+				value = symbolValue + uint64(relocation.Addend)
+			} else {
+				value -= symbolValue + uint64(relocation.Addend)
+			}
+			if !writeULEB128(content[location:location+uint64(size)], value) {
+				return fmt.Errorf("%w relocation %v at %v value 0x%X does not fit %v bytes", ErrInvalidRelocation, i, relocation.Offset, value, size)
+			}
+		}
+	}
+	return nil
+}
+
+func (f *File) sectionIndex(section *Section) (int, bool) {
+	for i, candidate := range f.Sections {
+		if candidate == section {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+func readField(byteOrder binary.ByteOrder, field []byte) uint64 {
+	switch len(field) {
+	case 1:
+		return uint64(field[0])
+	case 2:
+		return uint64(byteOrder.Uint16(field))
+	case 4:
+		return uint64(byteOrder.Uint32(field))
+	case 8:
+		return byteOrder.Uint64(field)
+	}
+	return 0
+}
+
+func writeField(byteOrder binary.ByteOrder, field []byte, value uint64) {
+	switch len(field) {
+	case 1:
+		field[0] = byte(value)
+	case 2:
+		byteOrder.PutUint16(field, uint16(value))
+	case 4:
+		byteOrder.PutUint32(field, uint32(value))
+	case 8:
+		byteOrder.PutUint64(field, value)
+	}
+}
+
+// readULEB128 returns decoded value and count of bytes it occupies, zero count means invalid encoding
+func readULEB128(content []byte) (uint64, int) {
+	var value uint64
+	var shift uint
+	for i, b := range content {
+		if shift < 64 {
+			value |= uint64(b&0x7F) << shift
+		}
+		shift += 7
+		if b&0x80 == 0 {
+			return value, i + 1
+		}
+	}
+	return 0, 0
+}
+
+// writeULEB128 encodes value to exactly len(field) bytes, padding with continuation bytes if needed
+func writeULEB128(field []byte, value uint64) bool {
+	for i := range field {
+		b := byte(value & 0x7F)
+		value >>= 7
+		if i < len(field)-1 {
+			b |= 0x80
+		}
+		field[i] = b
+	}
+	return value == 0
+}
@@ -0,0 +1,146 @@
+package elf
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestApplyRelocationsToDebugInfo(t *testing.T) {
+	tcs := []struct {
+		filename string
+		producer string
+	}{
+		{"sample_linux_amd64.o", "GNU C++17"},
+		{"object_linux_386.o", "GNU C17"},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.filename, func(t *testing.T) {
+			file, err := Open(filepath.Join("testdata", tc.filename))
+			assert.NoError(t, err)
+			defer file.Close()
+
+			debugInfo := file.Section(".debug_info")
+			relocationSection := file.RelocationSectionFor(debugInfo)
+			assert.NotNil(t, relocationSection)
+			assert.Contains(t, relocationSection.Name, ".debug_info")
+
+			raw, err := debugInfo.Data()
+			assert.NoError(t, err)
+			relocated, err := file.ApplyRelocations(debugInfo, relocationSection)
+			assert.NoError(t, err)
+			assert.Len(t, relocated, len(raw))
+			assert.NotEqual(t, raw, relocated)
+
+			// DW_AT_producer of first compilation unit is DW_FORM_strp at offset 0x0D
+			producerOffset := file.ByteOrder().Uint32(relocated[0x0D:])
+			debugStr, err := file.Section(".debug_str").Data()
+			assert.NoError(t, err)
+			producer, err := StringTable(debugStr).String(producerOffset)
+			assert.NoError(t, err)
+			assert.Contains(t, producer, tc.producer)
+		})
+	}
+}
+
+func TestApplyRelocationsRejectsForeignRelocationSection(t *testing.T) {
+	file, err := Open(filepath.Join("testdata", "sample_linux_amd64.o"))
+	assert.NoError(t, err)
+	defer file.Close()
+
+	_, err = file.ApplyRelocations(file.Section(".debug_line"), file.Section(".rela.debug_info"))
+	assert.True(t, errors.Is(err, ErrInvalidRelocation))
+
+	_, err = file.ApplyRelocations(file.Section(".text"), file.Section(".rela.text"))
+	assert.True(t, errors.Is(err, ErrUnsupportedRelocation))
+	assert.Nil(t, file.RelocationSectionFor(file.Section(".debug_str")))
+}
+
+func TestApplyRelocationKinds(t *testing.T) {
+	symbol := &Symbol{Value: 0x10, Size: 0x20}
+	tcs := []struct {
+		name        string
+		header      Header
+		content     []byte
+		relocations []Relocation
+		expected    []byte
+	}{
+		{
+			name:    "s390x big endian absolute and pc relative",
+			header:  Header{Class: ELFClass64, Endianess: BigEndian, ISet: ISS390WithS390x},
+			content: make([]byte, 12),
+			relocations: []Relocation{
+				{Offset: 0, Type: R_390_64, Symbol: symbol, Addend: 1, HasAddend: true},
+				{Offset: 8, Type: R_390_PC32, Symbol: symbol, Addend: 0, HasAddend: true},
+			},
+			expected: []byte{0, 0, 0, 0, 0, 0, 0, 0x11, 0, 0, 0, 0x08},
+		},
+		{
+			name:    "i386 implicit addend",
+			header:  Header{Class: ELFClass32, Endianess: LittleEndian, ISet: ISx86},
+			content: []byte{0x05, 0, 0, 0},
+			relocations: []Relocation{
+				{Offset: 0, Type: R_386_32, Symbol: symbol},
+			},
+			expected: []byte{0x15, 0, 0, 0},
+		},
+		{
+			name:    "amd64 symbol size",
+			header:  Header{Class: ELFClass64, Endianess: LittleEndian, ISet: ISAmd64},
+			content: make([]byte, 4),
+			relocations: []Relocation{
+				{Offset: 0, Type: R_X86_64_SIZE32, Symbol: symbol, Addend: 2, HasAddend: true},
+			},
+			expected: []byte{0x22, 0, 0, 0},
+		},
+		{
+			name:    "riscv label arithmetic",
+			header:  Header{Class: ELFClass64, Endianess: LittleEndian, ISet: ISRISCV},
+			content: []byte{0x30, 0x00, 0xC5, 0x80, 0x00, 0x00},
+			relocations: []Relocation{
+				{Offset: 0, Type: R_RISCV_ADD16, Symbol: symbol, Addend: 0x40, HasAddend: true},
+				{Offset: 0, Type: R_RISCV_SUB16, Symbol: symbol, Addend: 0x08, HasAddend: true},
+				{Offset: 2, Type: R_RISCV_SUB6, Symbol: symbol, Addend: 0x01, HasAddend: true},
+				{Offset: 3, Type: R_RISCV_SET_ULEB128, Symbol: symbol, Addend: 0x80, HasAddend: true},
+				{Offset: 3, Type: R_RISCV_SUB_ULEB128, Symbol: symbol, Addend: 0x00, HasAddend: true},
+				{Offset: 3, Type: R_RISCV_RELAX},
+			},
+			expected: []byte{0x68, 0x00, 0xF4, 0x80, 0x01, 0x00},
+		},
+		{
+			name:    "aarch64 relative",
+			header:  Header{Class: ELFClass64, Endianess: LittleEndian, ISet: ISAArch64},
+			content: make([]byte, 8),
+			relocations: []Relocation{
+				{Offset: 4, Type: R_AARCH64_PREL32, Symbol: symbol, Addend: 0x100, HasAddend: true},
+			},
+			expected: []byte{0, 0, 0, 0, 0x0C, 0x01, 0, 0},
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			file := &File{Header: tc.header}
+			content := append([]byte(nil), tc.content...)
+			err := file.applyRelocations(content, 0, 0, tc.relocations)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, content)
+		})
+	}
+}
+
+func TestApplyRelocationsReportsUnsupportedAndOutOfBounds(t *testing.T) {
+	file := &File{Header: Header{Class: ELFClass64, Endianess: LittleEndian, ISet: ISAmd64}}
+
+	err := file.applyRelocations(make([]byte, 8), 0, 0, []Relocation{{Offset: 0, Type: R_X86_64_GOTPCREL}})
+	assert.True(t, errors.Is(err, ErrUnsupportedRelocation))
+	assert.Contains(t, err.Error(), "R_X86_64_GOTPCREL")
+
+	err = file.applyRelocations(make([]byte, 8), 0, 0, []Relocation{{Offset: 6, Type: R_X86_64_32}})
+	assert.True(t, errors.Is(err, ErrInvalidRelocation))
+
+	file.ISet = ISIA64
+	err = file.applyRelocations(make([]byte, 8), 0, 0, []Relocation{{Offset: 0, Type: NewRelocationType(ISIA64, 1)}})
+	assert.True(t, errors.Is(err, ErrUnsupportedRelocation))
+}
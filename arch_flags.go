@@ -0,0 +1,295 @@
+package elf
+
+import (
+	"fmt"
+	"strings"
+)
+
+// FloatABI is floating point calling convention declared by e_flags
+type FloatABI int
+
+const (
+	FloatABIUnknown FloatABI = iota
+	FloatABISoft             // floating point values are passed in integer registers
+	FloatABIHard             // ARM VFP registers are used for floating point values
+	FloatABISingle           // RISC-V single precision registers are used
+	FloatABIDouble           // RISC-V double precision registers are used
+	FloatABIQuad             // RISC-V quad precision registers are used
+)
+
+func (fa FloatABI) String() string {
+	switch fa {
+	case FloatABISoft:
+		return "soft-float"
+	case FloatABIHard:
+		return "hard-float"
+	case FloatABISingle:
+		return "single-float"
+	case FloatABIDouble:
+		return "double-float"
+	case FloatABIQuad:
+		return "quad-float"
+	}
+	return "unknown float ABI"
+}
+
+// ArchFlags is ArchNativeFlags interpreted according to instruction set of the file
+type ArchFlags struct {
+	ISet     InstructionSet
+	Flags    ArchNativeFlags
+	ABI      string // ARM EABI version, MIPS ABI, PPC64 ELF ABI version or RISC-V calling convention, empty if not declared
+	ISALevel string // MIPS architecture level, e.g. mips32r2
+	FloatABI FloatABI
+	Features []string        // other flags set, named as readelf does
+	Unknown  ArchNativeFlags // bits not known for the instruction set
+}
+
+func (af ArchFlags) String() string {
+	var parts []string
+	if af.ABI != "" {
+		parts = append(parts, af.ABI)
+	}
+	if af.ISALevel != "" {
+		parts = append(parts, af.ISALevel)
+	}
+	if af.FloatABI != FloatABIUnknown {
+		parts = append(parts, af.FloatABI.String())
+	}
+	parts = append(parts, af.Features...)
+	if af.Unknown != 0 {
+		parts = append(parts, fmt.Sprintf("unknown flags: %v", af.Unknown))
+	}
+	return fmt.Sprintf("%v [%v]", af.Flags, strings.Join(parts, ", "))
+}
+
+// archFlag is single bit flag named as a feature
+type archFlag struct {
+	mask ArchNativeFlags
+	name string
+}
+
+// ARM e_flags
+const (
+	armEABIMask     ArchNativeFlags = 0xff000000
+	armABIFloatSoft ArchNativeFlags = 0x00000200 // EF_ARM_SOFT_FLOAT in pre EABI files
+	armABIFloatHard ArchNativeFlags = 0x00000400
+)
+
+var armEABIFlags = []archFlag{
+	{0x00800000, "BE8"},
+	{0x00400000, "LE8"},
+}
+
+var armEABIOldFlags = []archFlag{
+	{0x00000004, "sorted symbol tables"},
+	{0x00000008, "dynamic symbols use segment index"},
+	{0x00000010, "mapping symbols precede others"},
+}
+
+var armLegacyFlags = []archFlag{
+	{0x00000004, "interworking enabled"},
+	{0x00000008, "uses APCS/26"},
+	{0x00000010, "uses APCS/float"},
+	{0x00000020, "position independent"},
+	{0x00000040, "8 bit structure alignment"},
+	{0x00000080, "uses new ABI"},
+	{0x00000100, "uses old ABI"},
+	{0x00000200, "software FP"},
+	{0x00000400, "VFP"},
+	{0x00000800, "Maverick FP"},
+}
+
+// RISC-V e_flags
+const (
+	riscvRVC          ArchNativeFlags = 0x0001
+	riscvFloatABIMask ArchNativeFlags = 0x0006
+	riscvRVE          ArchNativeFlags = 0x0008
+	riscvTSO          ArchNativeFlags = 0x0010
+)
+
+// MIPS e_flags
+const (
+	mipsABI2     ArchNativeFlags = 0x00000020
+	mipsABIMask  ArchNativeFlags = 0x0000f000
+	mipsMachMask ArchNativeFlags = 0x00ff0000
+	mipsArchMask ArchNativeFlags = 0xf0000000
+)
+
+var mipsFlags = []archFlag{
+	{0x00000001, "noreorder"},
+	{0x00000002, "pic"},
+	{0x00000004, "cpic"},
+	{0x00000008, "xgot"},
+	{0x00000010, "ucode"},
+	{0x00000080, "odk first"},
+	{0x00000100, "32bitmode"},
+	{0x00000200, "fp64"},
+	{0x00000400, "nan2008"},
+	{0x02000000, "micromips"},
+	{0x04000000, "mips16"},
+	{0x08000000, "mdmx"},
+}
+
+var mipsABINames = map[ArchNativeFlags]string{
+	0x1000: "o32",
+	0x2000: "o64",
+	0x3000: "eabi32",
+	0x4000: "eabi64",
+}
+
+// mipsMachNames are E_MIPS_MACH_* values of binutils include/elf/mips.h named as by readelf
+var mipsMachNames = map[ArchNativeFlags]string{
+	0x00810000: "3900",
+	0x00820000: "4010",
+	0x00830000: "4100",
+	0x00840000: "allegrex",
+	0x00850000: "4650",
+	0x00870000: "4120",
+	0x00880000: "4111",
+	0x008a0000: "sb1",
+	0x008b0000: "octeon",
+	0x008c0000: "xlr",
+	0x008d0000: "octeon2",
+	0x008e0000: "octeon3",
+	0x00910000: "5400",
+	0x00920000: "5900",
+	0x00930000: "interaptiv-mr2",
+	0x00980000: "5500",
+	0x00990000: "9000",
+	0x00a00000: "loongson-2e",
+	0x00a10000: "loongson-2f",
+	0x00a20000: "gs464",
+	0x00a30000: "gs464e",
+	0x00a40000: "gs264e",
+}
+
+var mipsArchNames = [...]string{"mips1", "mips2", "mips3", "mips4", "mips5", "mips32", "mips64", "mips32r2", "mips64r2", "mips32r6", "mips64r6"}
+
+// PowerPC e_flags
+const ppc64ABIMask ArchNativeFlags = 0x3
+
+var ppcFlags = []archFlag{
+	{0x80000000, "emb"},
+	{0x00010000, "relocatable"},
+	{0x00008000, "relocatable-lib"},
+}
+
+// DecodeArchFlags interprets ArchNativeFlags according to instruction set. For architectures without known flags all set bits are Unknown
+func (h Header) DecodeArchFlags() ArchFlags {
+	archFlags := ArchFlags{ISet: h.ISet, Flags: h.ArchNativeFlags}
+	flags := h.ArchNativeFlags
+	switch h.ISet {
+	case ISARM:
+		flags = decodeARMFlags(&archFlags, flags)
+	case ISRISCV:
+		flags = decodeRISCVFlags(&archFlags, flags, h.Class)
+	case ISMIPS, ISMIPSRS3LE:
+		flags = decodeMIPSFlags(&archFlags, flags, h.Class)
+	case ISPowerPC64:
+		switch flags & ppc64ABIMask {
+		case 1:
+			archFlags.ABI = "ELFv1"
+		case 2:
+			archFlags.ABI = "ELFv2"
+		}
+		flags &^= ppc64ABIMask
+	case ISPowerPC:
+		flags = decodeFlagList(&archFlags, flags, ppcFlags)
+	}
+	archFlags.Unknown = flags
+	return archFlags
+}
+
+func decodeARMFlags(archFlags *ArchFlags, flags ArchNativeFlags) ArchNativeFlags {
+	version := flags >> 24
+	flags &^= armEABIMask
+	switch {
+	case version == 0:
+		archFlags.ABI = "GNU EABI"
+		if flags&armABIFloatSoft != 0 {
+			archFlags.FloatABI = FloatABISoft
+		}
+		return decodeFlagList(archFlags, flags, armLegacyFlags)
+	case version <= 3:
+		archFlags.ABI = fmt.Sprintf("EABI%d", version)
+		return decodeFlagList(archFlags, flags, armEABIOldFlags)
+	case version <= 5:
+		archFlags.ABI = fmt.Sprintf("EABI%d", version)
+		if version == 5 {
+			switch {
+			case flags&armABIFloatHard != 0:
+				archFlags.FloatABI = FloatABIHard
+			case flags&armABIFloatSoft != 0:
+				archFlags.FloatABI = FloatABISoft
+			}
+			flags &^= armABIFloatHard | armABIFloatSoft
+		}
+		return decodeFlagList(archFlags, flags, armEABIFlags)
+	}
+	archFlags.ABI = fmt.Sprintf("unknown EABI%d", version)
+	return flags
+}
+
+func decodeRISCVFlags(archFlags *ArchFlags, flags ArchNativeFlags, class ELFClass) ArchNativeFlags {
+	abi := "ilp32"
+	if class == ELFClass64 {
+		abi = "lp64"
+	}
+	switch flags & riscvFloatABIMask {
+	case 0x0:
+		archFlags.FloatABI = FloatABISoft
+	case 0x2:
+		archFlags.FloatABI = FloatABISingle
+		abi += "f"
+	case 0x4:
+		archFlags.FloatABI = FloatABIDouble
+		abi += "d"
+	case 0x6:
+		archFlags.FloatABI = FloatABIQuad
+		abi += "q"
+	}
+	if flags&riscvRVE != 0 {
+		abi += "e"
+	}
+	archFlags.ABI = abi
+	flags &^= riscvFloatABIMask
+	return decodeFlagList(archFlags, flags, []archFlag{{riscvRVC, "RVC"}, {riscvRVE, "RVE"}, {riscvTSO, "TSO"}})
+}
+
+func decodeMIPSFlags(archFlags *ArchFlags, flags ArchNativeFlags, class ELFClass) ArchNativeFlags {
+	if name, ok := mipsABINames[flags&mipsABIMask]; ok {
+		archFlags.ABI = name
+		flags &^= mipsABIMask
+	} else if flags&mipsABIMask == 0 {
+		switch {
+		case flags&mipsABI2 != 0:
+			archFlags.ABI = "n32"
+		case class == ELFClass64:
+			archFlags.ABI = "n64"
+		default:
+			archFlags.ABI = "o32"
+		}
+	}
+	flags &^= mipsABI2
+
+	if arch := int(flags >> 28); arch < len(mipsArchNames) {
+		archFlags.ISALevel = mipsArchNames[arch]
+		flags &^= mipsArchMask
+	}
+	if name, ok := mipsMachNames[flags&mipsMachMask]; ok {
+		archFlags.Features = append(archFlags.Features, name)
+		flags &^= mipsMachMask
+	}
+	return decodeFlagList(archFlags, flags, mipsFlags)
+}
+
+// decodeFlagList appends names of set flags to features and returns remaining bits
+func decodeFlagList(archFlags *ArchFlags, flags ArchNativeFlags, list []archFlag) ArchNativeFlags {
+	for _, flag := range list {
+		if flags&flag.mask != 0 {
+			archFlags.Features = append(archFlags.Features, flag.name)
+			flags &^= flag.mask
+		}
+	}
+	return flags
+}
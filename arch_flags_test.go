@@ -0,0 +1,58 @@
+package elf
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeArchFlags(t *testing.T) {
+	tcs := []struct {
+		name     string
+		header   Header
+		abi      string
+		isa      string
+		floatABI FloatABI
+		features []string
+		unknown  ArchNativeFlags
+	}{
+		{"ARM hard-float", Header{ISet: ISARM, ArchNativeFlags: 0x05000400}, "EABI5", "", FloatABIHard, nil, 0},
+		{"ARM soft-float BE8", Header{ISet: ISARM, ArchNativeFlags: 0x05800200}, "EABI5", "", FloatABISoft, []string{"BE8"}, 0},
+		{"ARM EABI4", Header{ISet: ISARM, ArchNativeFlags: 0x04000000}, "EABI4", "", FloatABIUnknown, nil, 0},
+		{"ARM legacy", Header{ISet: ISARM, ArchNativeFlags: 0x00000204}, "GNU EABI", "", FloatABISoft, []string{"interworking enabled", "software FP"}, 0},
+		{"RISC-V lp64d", Header{ISet: ISRISCV, Class: ELFClass64, ArchNativeFlags: 0x5}, "lp64d", "", FloatABIDouble, []string{"RVC"}, 0},
+		{"RISC-V ilp32e", Header{ISet: ISRISCV, Class: ELFClass32, ArchNativeFlags: 0x18}, "ilp32e", "", FloatABISoft, []string{"RVE", "TSO"}, 0},
+		{"RISC-V unknown bits", Header{ISet: ISRISCV, Class: ELFClass64, ArchNativeFlags: 0x102}, "lp64f", "", FloatABISingle, nil, 0x100},
+		{"MIPS o32", Header{ISet: ISMIPS, Class: ELFClass32, ArchNativeFlags: 0x70001007}, "o32", "mips32r2", FloatABIUnknown, []string{"noreorder", "pic", "cpic"}, 0},
+		{"MIPS n32", Header{ISet: ISMIPS, Class: ELFClass32, ArchNativeFlags: 0x80000020}, "n32", "mips64r2", FloatABIUnknown, nil, 0},
+		{"MIPS o32 4650", Header{ISet: ISMIPS, Class: ELFClass32, ArchNativeFlags: 0x00851000}, "o32", "mips1", FloatABIUnknown, []string{"4650"}, 0},
+		{"MIPS n64 octeon", Header{ISet: ISMIPS, Class: ELFClass64, ArchNativeFlags: 0x808b0000}, "n64", "mips64r2", FloatABIUnknown, []string{"octeon"}, 0},
+		{"PPC64 ELFv2", Header{ISet: ISPowerPC64, ArchNativeFlags: 0x2}, "ELFv2", "", FloatABIUnknown, nil, 0},
+		{"PPC emb", Header{ISet: ISPowerPC, ArchNativeFlags: 0x80000000}, "", "", FloatABIUnknown, []string{"emb"}, 0},
+		{"amd64", Header{ISet: ISAmd64, ArchNativeFlags: 0x10}, "", "", FloatABIUnknown, nil, 0x10},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			flags := tc.header.DecodeArchFlags()
+			assert.Equal(t, tc.abi, flags.ABI)
+			assert.Equal(t, tc.isa, flags.ISALevel)
+			assert.Equal(t, tc.floatABI, flags.FloatABI)
+			assert.Equal(t, tc.features, flags.Features)
+			assert.Equal(t, tc.unknown, flags.Unknown)
+		})
+	}
+}
+
+func TestArchFlagsString(t *testing.T) {
+	header := Header{ISet: ISRISCV, Class: ELFClass64, ArchNativeFlags: 0x105}
+	assert.Equal(t, "0x0105 [lp64d, double-float, RVC, unknown flags: 0x0100]", header.DecodeArchFlags().String())
+}
+
+func TestDecodeArchFlagsFromFile(t *testing.T) {
+	file, err := Open(filepath.Join("testdata", "helloworld_linux_ppc64"))
+	assert.NoError(t, err)
+	defer file.Close()
+
+	assert.Equal(t, "ELFv1", file.DecodeArchFlags().ABI)
+}
@@ -0,0 +1,74 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/tadovas/elf"
+)
+
+func runGroups(args []string) error {
+	flags := flag.NewFlagSet("groups", flag.ContinueOnError)
+	duplicates := flags.Bool("duplicates", false, "list only COMDAT signatures contributed by more than one file")
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "usage: elftool groups [-duplicates] file...")
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if flags.NArg() == 0 {
+		flags.Usage()
+		return errors.New("no files given")
+	}
+
+	contributors := map[string][]string{}
+	for _, path := range flags.Args() {
+		groups, err := sectionGroups(path)
+		if err != nil {
+			return fmt.Errorf("%v: %w", path, err)
+		}
+		if !*duplicates {
+			fmt.Printf("%v:\n", path)
+		}
+		for _, group := range groups {
+			if group.IsComdat() {
+				contributors[group.Signature] = append(contributors[group.Signature], path)
+			}
+			if *duplicates {
+				continue
+			}
+			members := make([]string, len(group.Members))
+			for i, member := range group.Members {
+				members[i] = member.Name
+			}
+			fmt.Printf("  %v [%v]: %v\n", group.Signature, group.Flags, strings.Join(members, " "))
+		}
+	}
+
+	if *duplicates {
+		var signatures []string
+		for signature, paths := range contributors {
+			if len(paths) > 1 {
+				signatures = append(signatures, signature)
+			}
+		}
+		sort.Strings(signatures)
+		for _, signature := range signatures {
+			fmt.Printf("%v: %v\n", signature, strings.Join(contributors[signature], " "))
+		}
+	}
+	return nil
+}
+
+func sectionGroups(path string) ([]*elf.SectionGroup, error) {
+	file, err := elf.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	return file.SectionGroups()
+}
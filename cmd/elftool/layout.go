@@ -0,0 +1,132 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"strings"
+
+	"github.com/tadovas/elf"
+)
+
+func runLayout(args []string) error {
+	flags := flag.NewFlagSet("layout", flag.ContinueOnError)
+	cacheline := flags.Uint64("cacheline", elf.DefaultCachelineSize, "cacheline size in bytes")
+	holes := flags.Bool("holes", false, "print only types with holes or which could be shrunk by reordering")
+	typeName := flags.String("type", "", "print only structures, classes or unions of given name")
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "usage: elftool layout [-cacheline size] [-holes] [-type name] file...")
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if flags.NArg() == 0 {
+		flags.Usage()
+		return errors.New("no files given")
+	}
+	if *cacheline == 0 {
+		return errors.New("cacheline size must be positive")
+	}
+
+	for _, path := range flags.Args() {
+		layouts, err := structLayouts(path)
+		if err != nil {
+			return fmt.Errorf("%v: %w", path, err)
+		}
+		fmt.Printf("%v:\n", path)
+		// the same type is described by every unit which uses it
+		printed := map[string]bool{}
+		for _, layout := range layouts {
+			key := fmt.Sprintf("%v %v %v", layout.Entry.Tag, layout.Name, layout.Size)
+			if layout.Name == "" || printed[key] || *typeName != "" && layout.Name != *typeName {
+				continue
+			}
+			if count, _ := layout.Holes(); *holes && count == 0 && layout.Savable == 0 {
+				continue
+			}
+			printed[key] = true
+			printLayout(layout, *cacheline)
+		}
+	}
+	return nil
+}
+
+func structLayouts(path string) ([]*elf.StructLayout, error) {
+	file, err := elf.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	return file.StructLayouts()
+}
+
+func printLayout(layout *elf.StructLayout, cacheline uint64) {
+	keyword := map[elf.DWARFTag]string{
+		elf.DWARFTagStructureType: "struct",
+		elf.DWARFTagClassType:     "class",
+		elf.DWARFTagUnionType:     "union",
+	}[layout.Entry.Tag]
+	fmt.Printf("%v %v {\n", keyword, layout.Name)
+
+	boundaries := map[int]bool{}
+	for _, index := range layout.CachelineBoundaries(cacheline) {
+		boundaries[index] = true
+	}
+	var sum uint64
+	for i, member := range layout.Members {
+		if boundaries[i] {
+			boundary := member.Offset / cacheline * cacheline
+			fmt.Printf("\t/* --- cacheline %v boundary (%v bytes) --- */\n", boundary/cacheline, boundary)
+		}
+		declaration := memberDeclaration(member.TypeName, member.Name)
+		if member.IsBaseClass() {
+			declaration = "/* base */ " + member.TypeName
+		}
+		if member.IsBitField() {
+			fmt.Printf("\t%-40v /* %5v:%-2v %4v */\n", fmt.Sprintf("%v:%v;", declaration, member.BitSize), member.Offset, member.BitOffset, member.Size)
+		} else {
+			fmt.Printf("\t%-40v /* %8v %4v */\n", declaration+";", member.Offset, member.Size)
+			sum += member.Size
+		}
+		if member.BitHole > 0 {
+			fmt.Printf("\n\t/* XXX %v bits hole, try to pack */\n\n", member.BitHole)
+		}
+		if member.Hole > 0 {
+			fmt.Printf("\n\t/* XXX %v bytes hole, try to pack */\n\n", member.Hole)
+		}
+	}
+
+	count, size := layout.Holes()
+	fmt.Printf("\n\t/* size: %v, cachelines: %v, members: %v */\n", layout.Size, layout.Cachelines(cacheline), len(layout.Members))
+	if layout.Entry.Tag != elf.DWARFTagUnionType {
+		fmt.Printf("\t/* sum members: %v, holes: %v, sum holes: %v */\n", sum, count, size)
+	}
+	if layout.Padding > 0 {
+		fmt.Printf("\t/* padding: %v */\n", layout.Padding)
+	}
+	if layout.BitPadding > 0 {
+		fmt.Printf("\t/* bit padding: %v bits */\n", layout.BitPadding)
+	}
+	if layout.Savable > 0 {
+		fmt.Printf("\t/* could save %v bytes by reordering */\n", layout.Savable)
+	}
+	fmt.Print("};\n\n")
+}
+
+// memberDeclaration places member name into C declaration of its type
+func memberDeclaration(typeName, name string) string {
+	if name == "" {
+		return typeName
+	}
+	if i := strings.Index(typeName, "(*)"); i >= 0 {
+		return typeName[:i+2] + name + typeName[i+2:]
+	}
+	if i := strings.Index(typeName, "["); i >= 0 {
+		return typeName[:i] + " " + name + typeName[i:]
+	}
+	if strings.HasSuffix(typeName, "*") || strings.HasSuffix(typeName, "&") {
+		return typeName + name
+	}
+	return typeName + " " + name
+}
@@ -0,0 +1,43 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+)
+
+type command struct {
+	description string
+	run         func(args []string) error
+}
+
+var commands = map[string]command{
+	"groups":   {"list section groups with their members, or COMDAT signatures duplicated across files", runGroups},
+	"layout":   {"print struct, class and union layouts with holes, padding and cachelines from DWARF", runLayout},
+	"versions": {"report highest required GLIBC_/GLIBCXX_/CXXABI_/GCC_ versions and check them against policy", runVersions},
+}
+
+func main() {
+	if len(os.Args) < 2 || commands[os.Args[1]].run == nil {
+		usage()
+		os.Exit(2)
+	}
+	if err := commands[os.Args[1]].run(os.Args[2:]); err != nil {
+		fmt.Fprintf(os.Stderr, "elftool %v: %v\n", os.Args[1], err)
+		os.Exit(1)
+	}
+}
+
+func usage() {
+	var names []string
+	for name := range commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var lines []string
+	for _, name := range names {
+		lines = append(lines, fmt.Sprintf("  %-12v %v", name, commands[name].description))
+	}
+	fmt.Fprintf(os.Stderr, "usage: elftool <command> [arguments]\n\ncommands:\n%v\n", strings.Join(lines, "\n"))
+}
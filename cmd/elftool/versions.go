@@ -0,0 +1,79 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/tadovas/elf"
+)
+
+var errPolicyViolated = errors.New("version policy violated")
+
+func runVersions(args []string) error {
+	flags := flag.NewFlagSet("versions", flag.ContinueOnError)
+	policyPath := flags.String("policy", "", "JSON policy file with highest allowed version per prefix")
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "usage: elftool versions [-policy policy.json] file...")
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if flags.NArg() == 0 {
+		flags.Usage()
+		return errors.New("no files given")
+	}
+
+	var policy *elf.VersionPolicy
+	if *policyPath != "" {
+		policyFile, err := os.Open(*policyPath)
+		if err != nil {
+			return err
+		}
+		decoded, err := elf.ReadVersionPolicy(policyFile)
+		policyFile.Close()
+		if err != nil {
+			return err
+		}
+		policy = &decoded
+	}
+
+	violated := false
+	for _, path := range flags.Args() {
+		required, err := requiredVersions(path)
+		if err != nil {
+			return fmt.Errorf("%v: %w", path, err)
+		}
+		fmt.Printf("%v:\n", path)
+		for _, library := range required {
+			versions := make([]string, len(library.Versions))
+			for i, version := range library.Versions {
+				versions[i] = version.String()
+			}
+			fmt.Println(strings.TrimRight(fmt.Sprintf("  %v: %v", library.Library, strings.Join(versions, " ")), " "))
+		}
+		if policy == nil {
+			continue
+		}
+		for _, violation := range policy.Check(required) {
+			violated = true
+			fmt.Fprintf(os.Stderr, "%v: %v policy: %v\n", path, policy.Name, violation)
+		}
+	}
+	if violated {
+		return errPolicyViolated
+	}
+	return nil
+}
+
+func requiredVersions(path string) ([]elf.LibraryVersions, error) {
+	file, err := elf.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	return file.RequiredVersions()
+}
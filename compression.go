@@ -0,0 +1,215 @@
+package elf
+
+import (
+	"compress/zlib"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math"
+	"strings"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+// CompressionType is ch_type of compression header of SHF_COMPRESSED section
+type CompressionType uint32
+
+const (
+	//1	ELFCOMPRESS_ZLIB	ZLIB/DEFLATE algorithm
+	CompressionZlib CompressionType = 1
+	//2	ELFCOMPRESS_ZSTD	Zstandard algorithm
+	CompressionZstd CompressionType = 2
+	//0x60000000	ELFCOMPRESS_LOOS	OS-specific types
+	CompressionLowOS CompressionType = 0x60000000
+	//0x6fffffff	ELFCOMPRESS_HIOS
+	CompressionHighOS CompressionType = 0x6fffffff
+	//0x70000000	ELFCOMPRESS_LOPROC	Processor-specific types
+	CompressionLowProc CompressionType = 0x70000000
+	//0x7fffffff	ELFCOMPRESS_HIPROC
+	CompressionHighProc CompressionType = 0x7fffffff
+)
+
+var compressionTypeNames = newConstantNames("CompressionType", []constantName{
+	{0x1, "ELFCOMPRESS_ZLIB", "CompressionZlib", "ZLIB"},
+	{0x2, "ELFCOMPRESS_ZSTD", "CompressionZstd", "ZSTD"},
+	{0x60000000, "ELFCOMPRESS_LOOS", "CompressionLowOS", ""},
+	{0x6fffffff, "ELFCOMPRESS_HIOS", "CompressionHighOS", ""},
+	{0x70000000, "ELFCOMPRESS_LOPROC", "CompressionLowProc", ""},
+	{0x7fffffff, "ELFCOMPRESS_HIPROC", "CompressionHighProc", ""},
+})
+
+func (ct CompressionType) String() string {
+	if text, ok := compressionTypeNames.text(uint64(ct)); ok {
+		return text
+	}
+	switch {
+	case ct >= CompressionLowOS && ct <= CompressionHighOS:
+		return fmt.Sprintf("OS specific: 0x%08X", uint32(ct))
+	case ct >= CompressionLowProc && ct <= CompressionHighProc:
+		return fmt.Sprintf("proc specific: 0x%08X", uint32(ct))
+	}
+	return fmt.Sprintf("unknown: 0x%08X", uint32(ct))
+}
+
+func (ct CompressionType) GoString() string {
+	return compressionTypeNames.goString(uint64(ct))
+}
+
+// ParseCompressionType resolves specification (ELFCOMPRESS_ZLIB) or Go (CompressionZlib) constant name
+func ParseCompressionType(name string) (CompressionType, error) {
+	value, err := compressionTypeNames.parse(name)
+	return CompressionType(value), err
+}
+
+// CompressionHeader is Elf32_Chdr or Elf64_Chdr at the start of SHF_COMPRESSED section.
+// Legacy .zdebug sections are described by the same structure with CompressionZlib type and alignment of the section itself
+type CompressionHeader struct {
+	Type      CompressionType // 4 bytes, followed by 4 reserved bytes in 64 bit class
+	Size      uint64          // 4 or 8 bytes, size of uncompressed data
+	Alignment Alignment       // 4 or 8 bytes, alignment of uncompressed data
+}
+
+const (
+	compressionHeaderSize32 = 12
+	compressionHeaderSize64 = 24
+	// legacy .zdebug sections start with "ZLIB" magic followed by 8 byte big endian uncompressed size
+	legacyCompressionMagic      = "ZLIB"
+	legacyCompressionHeaderSize = 12
+	legacyCompressionPrefix     = ".zdebug"
+)
+
+var ErrInvalidCompressionHeader = errors.New("invalid compression header")
+var ErrUnsupportedCompression = errors.New("unsupported compression type")
+var ErrInvalidCompressedData = errors.New("invalid compressed data")
+
+func ReadCompressionHeader(nativeReader NativeWordReader) (CompressionHeader, error) {
+	var header CompressionHeader
+
+	uint32val, err := nativeReader.Uint32()
+	if err != nil {
+		return header, fmt.Errorf("%w read type: %v", ErrInvalidCompressionHeader, err)
+	}
+	header.Type = CompressionType(uint32val)
+
+	if nativeReader.Class == ELFClass64 {
+		if _, err := nativeReader.Uint32(); err != nil {
+			return header, fmt.Errorf("%w read reserved: %v", ErrInvalidCompressionHeader, err)
+		}
+	}
+
+	uint64val, err := nativeReader.ReadNativeWord()
+	if err != nil {
+		return header, fmt.Errorf("%w read size: %v", ErrInvalidCompressionHeader, err)
+	}
+	header.Size = uint64val
+
+	uint64val, err = nativeReader.ReadNativeWord()
+	if err != nil {
+		return header, fmt.Errorf("%w read alignment: %v", ErrInvalidCompressionHeader, err)
+	}
+	header.Alignment = Alignment(uint64val)
+	return header, nil
+}
+
+// IsCompressed reports if section content is compressed either by SHF_COMPRESSED flag or as legacy .zdebug section
+func (s *Section) IsCompressed() bool {
+	return s.hasFileBytes() && (s.Flags.Compressed() || s.isLegacyCompressed())
+}
+
+func (s *Section) isLegacyCompressed() bool {
+	return strings.HasPrefix(s.Name, legacyCompressionPrefix)
+}
+
+// CompressionHeader returns compression header of compressed section, nil if section is not compressed
+func (s *Section) CompressionHeader() (*CompressionHeader, error) {
+	if !s.IsCompressed() {
+		return nil, nil
+	}
+	header, _, err := s.compressionHeader()
+	if err != nil {
+		return nil, err
+	}
+	return &header, nil
+}
+
+// compressionHeader reads header of compressed section and returns it together with its size in file
+func (s *Section) compressionHeader() (CompressionHeader, uint64, error) {
+	if s.Flags.Compressed() {
+		size := uint64(compressionHeaderSize32)
+		if s.file.Class == ELFClass64 {
+			size = compressionHeaderSize64
+		}
+		if s.Size < size {
+			return CompressionHeader{}, 0, fmt.Errorf("%w section size %v is smaller than header", ErrInvalidCompressionHeader, s.Size)
+		}
+		header, err := ReadCompressionHeader(s.file.NativeReader(io.NewSectionReader(s.reader, int64(s.Offset), int64(size))))
+		return header, size, err
+	}
+
+	content, err := readAt(s.reader, s.Offset, minUint64(s.Size, legacyCompressionHeaderSize))
+	if err != nil {
+		return CompressionHeader{}, 0, fmt.Errorf("%w read: %v", ErrInvalidCompressionHeader, err)
+	}
+	if len(content) < legacyCompressionHeaderSize || string(content[:4]) != legacyCompressionMagic {
+		return CompressionHeader{}, 0, fmt.Errorf("%w %v has no %v magic", ErrInvalidCompressionHeader, s.Name, legacyCompressionMagic)
+	}
+	return CompressionHeader{
+		Type:      CompressionZlib,
+		Size:      binary.BigEndian.Uint64(content[4:]),
+		Alignment: s.Align,
+	}, legacyCompressionHeaderSize, nil
+}
+
+// decompress returns uncompressed content of compressed section
+func (s *Section) decompress() ([]byte, error) {
+	header, headerSize, err := s.compressionHeader()
+	if err != nil {
+		return nil, err
+	}
+	compressed := io.NewSectionReader(s.reader, int64(s.Offset)+int64(headerSize), int64(s.Size-headerSize))
+
+	var reader io.Reader
+	switch header.Type {
+	case CompressionZlib:
+		zlibReader, err := zlib.NewReader(compressed)
+		if err != nil {
+			return nil, fmt.Errorf("%w %v: %v", ErrInvalidCompressedData, header.Type, err)
+		}
+		defer zlibReader.Close()
+		reader = zlibReader
+	case CompressionZstd:
+		zstdReader, err := zstd.NewReader(compressed, zstd.WithDecoderConcurrency(1))
+		if err != nil {
+			return nil, fmt.Errorf("%w %v: %v", ErrInvalidCompressedData, header.Type, err)
+		}
+		defer zstdReader.Close()
+		reader = zstdReader
+	default:
+		return nil, fmt.Errorf("%w: %v", ErrUnsupportedCompression, header.Type)
+	}
+
+	// content is read up to one byte past declared size, so neither bogus sizes are allocated upfront nor longer streams accepted
+	content, err := ioutil.ReadAll(io.LimitReader(reader, int64(minUint64(header.Size, math.MaxInt64-1))+1))
+	if err != nil {
+		return nil, fmt.Errorf("%w %v: %v", ErrInvalidCompressedData, header.Type, err)
+	}
+	if uint64(len(content)) != header.Size {
+		return nil, fmt.Errorf("%w %v: declared size %v does not match decompressed content", ErrInvalidCompressedData, header.Type, header.Size)
+	}
+	return content, nil
+}
+
+// errorReader is io.ReadSeeker failing with the same error on any call
+type errorReader struct {
+	err error
+}
+
+func (er errorReader) Read([]byte) (int, error) {
+	return 0, er.err
+}
+
+func (er errorReader) Seek(int64, int) (int64, error) {
+	return 0, er.err
+}
@@ -0,0 +1,151 @@
+package elf
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompressedSectionData(t *testing.T) {
+	plain, err := Open(filepath.Join("testdata", "object_linux_386.o"))
+	assert.NoError(t, err)
+	defer plain.Close()
+
+	tcs := []struct {
+		filename        string
+		section         string
+		compressionType CompressionType
+		alignment       Alignment
+	}{
+		{"object_zlib_linux_386.o", ".debug_info", CompressionZlib, 1},
+		{"object_zstd_linux_386.o", ".debug_info", CompressionZstd, 1},
+		{"object_zdebug_linux_386.o", ".zdebug_info", CompressionZlib, 1},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.filename, func(t *testing.T) {
+			file, err := Open(filepath.Join("testdata", tc.filename))
+			assert.NoError(t, err)
+			defer file.Close()
+
+			expected, err := plain.Section(".debug_info").Data()
+			assert.NoError(t, err)
+
+			section := file.Section(tc.section)
+			assert.True(t, section.IsCompressed())
+			header, err := section.CompressionHeader()
+			assert.NoError(t, err)
+			assert.Equal(t, &CompressionHeader{Type: tc.compressionType, Size: uint64(len(expected)), Alignment: tc.alignment}, header)
+
+			data, err := section.Data()
+			assert.NoError(t, err)
+			assert.Equal(t, expected, data)
+
+			content, err := ioutil.ReadAll(section.Open())
+			assert.NoError(t, err)
+			assert.Equal(t, expected, content)
+
+			raw, err := section.RawData()
+			assert.NoError(t, err)
+			assert.Len(t, raw, int(section.Size))
+		})
+	}
+}
+
+func TestCompressedSection64(t *testing.T) {
+	file, err := Open(filepath.Join("testdata", "object_zstd_linux_amd64.o"))
+	assert.NoError(t, err)
+	defer file.Close()
+
+	section := file.Section(".debug_abbrev")
+	header, err := section.CompressionHeader()
+	assert.NoError(t, err)
+	assert.Equal(t, &CompressionHeader{Type: CompressionZstd, Size: 0xa2, Alignment: 1}, header)
+
+	raw, err := section.RawData()
+	assert.NoError(t, err)
+	// Elf64_Chdr followed by zstd frame magic
+	assert.Equal(t, []byte{0x28, 0xb5, 0x2f, 0xfd}, raw[compressionHeaderSize64:compressionHeaderSize64+4])
+
+	data, err := section.Data()
+	assert.NoError(t, err)
+	assert.Len(t, data, 0xa2)
+	// first abbreviation code
+	assert.Equal(t, byte(1), data[0])
+}
+
+func TestUncompressedSection(t *testing.T) {
+	file, err := Open(filepath.Join("testdata", "object_zlib_linux_386.o"))
+	assert.NoError(t, err)
+	defer file.Close()
+
+	section := file.Section(".debug_str")
+	assert.False(t, section.IsCompressed())
+	header, err := section.CompressionHeader()
+	assert.NoError(t, err)
+	assert.Nil(t, header)
+
+	data, err := section.Data()
+	assert.NoError(t, err)
+	raw, err := section.RawData()
+	assert.NoError(t, err)
+	assert.Equal(t, raw, data)
+}
+
+func TestCompressedSectionErrors(t *testing.T) {
+	content, err := ioutil.ReadFile(filepath.Join("testdata", "object_zlib_linux_386.o"))
+	assert.NoError(t, err)
+	file, err := Open(filepath.Join("testdata", "object_zlib_linux_386.o"))
+	assert.NoError(t, err)
+	defer file.Close()
+	offset := file.Section(".debug_info").Offset
+
+	unknownType := append([]byte{}, content...)
+	unknownType[offset] = 7
+	section := sectionOf(t, unknownType, ".debug_info")
+	_, err = section.Data()
+	assert.True(t, errors.Is(err, ErrUnsupportedCompression))
+	_, err = ioutil.ReadAll(section.Open())
+	assert.True(t, errors.Is(err, ErrUnsupportedCompression))
+
+	wrongSize := append([]byte{}, content...)
+	wrongSize[offset+4]++
+	_, err = sectionOf(t, wrongSize, ".debug_info").Data()
+	assert.True(t, errors.Is(err, ErrInvalidCompressedData))
+
+	corrupted := append([]byte{}, content...)
+	corrupted[offset+compressionHeaderSize32] = 0
+	_, err = sectionOf(t, corrupted, ".debug_info").Data()
+	assert.True(t, errors.Is(err, ErrInvalidCompressedData))
+}
+
+func TestLegacyCompressedSectionWithoutMagic(t *testing.T) {
+	content, err := ioutil.ReadFile(filepath.Join("testdata", "object_zdebug_linux_386.o"))
+	assert.NoError(t, err)
+	file, err := Open(filepath.Join("testdata", "object_zdebug_linux_386.o"))
+	assert.NoError(t, err)
+	defer file.Close()
+
+	content[file.Section(".zdebug_info").Offset] = 'X'
+	_, err = sectionOf(t, content, ".zdebug_info").CompressionHeader()
+	assert.True(t, errors.Is(err, ErrInvalidCompressionHeader))
+}
+
+func TestCompressionTypeString(t *testing.T) {
+	assert.Equal(t, "ZSTD", CompressionZstd.String())
+	assert.Equal(t, "OS specific: 0x60000001", CompressionType(0x60000001).String())
+	assert.Equal(t, "unknown: 0x00000003", CompressionType(3).String())
+	assert.Equal(t, "elf.CompressionZlib", CompressionZlib.GoString())
+	compressionType, err := ParseCompressionType("ELFCOMPRESS_ZSTD")
+	assert.NoError(t, err)
+	assert.Equal(t, CompressionZstd, compressionType)
+}
+
+func sectionOf(t *testing.T, content []byte, name string) *Section {
+	file, err := NewFile(bytes.NewReader(content))
+	assert.NoError(t, err)
+	return file.Section(name)
+}
@@ -0,0 +1,66 @@
+package elf
+
+import (
+	"errors"
+	"fmt"
+)
+
+var ErrUnknownConstantName = errors.New("unknown constant name")
+
+// constantName describes single enum constant by its specification name (e.g. SHT_PROGBITS), exported Go name and String() form.
+// Range bounds like SHT_LOOS have empty text, so String() falls back to range formatting of the type
+type constantName struct {
+	value  uint64
+	spec   string
+	goName string
+	text   string
+}
+
+// constantNames is lookup table for enum type, first entry wins when several constants share the same value
+type constantNames struct {
+	typeName string
+	byValue  map[uint64]constantName
+	byName   map[string]constantName
+}
+
+func newConstantNames(typeName string, names []constantName) *constantNames {
+	cn := &constantNames{
+		typeName: typeName,
+		byValue:  make(map[uint64]constantName, len(names)),
+		byName:   make(map[string]constantName, 2*len(names)),
+	}
+	for _, name := range names {
+		if current, ok := cn.byValue[name.value]; !ok || current.text == "" && name.text != "" {
+			cn.byValue[name.value] = name
+		}
+		cn.byName[name.spec] = name
+		cn.byName[name.goName] = name
+	}
+	return cn
+}
+
+// text returns String() form of value if it's known constant
+func (cn *constantNames) text(value uint64) (string, bool) {
+	name, ok := cn.byValue[value]
+	if !ok || name.text == "" {
+		return "", false
+	}
+	return name.text, true
+}
+
+// goString returns Go syntax of value - qualified constant name or conversion of number to the type
+func (cn *constantNames) goString(value uint64) string {
+	if name, ok := cn.byValue[value]; ok {
+		return "elf." + name.goName
+	}
+	return fmt.Sprintf("elf.%v(0x%X)", cn.typeName, value)
+}
+
+// parse resolves either specification or Go constant name
+func (cn *constantNames) parse(name string) (uint64, error) {
+	constant, ok := cn.byName[name]
+	if !ok {
+		return 0, fmt.Errorf("%w %q for %v", ErrUnknownConstantName, name, cn.typeName)
+	}
+	return constant.value, nil
+}
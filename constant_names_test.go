@@ -0,0 +1,127 @@
+package elf
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConstantStrings(t *testing.T) {
+	tcs := []struct {
+		value    fmt.Stringer
+		expected string
+	}{
+		{ELFClass64, "64bit"},
+		{ELFClass(7), "unknown: 7"},
+		{BigEndian, "Big endian"},
+		{Endianess(-1), "unknown: -1"},
+		{Solaris, "Solaris"},
+		{OSAbi(6), "Solaris"},
+		{OSAbi(18), "Stratus Technologies OpenVOS"},
+		{OSAbi(64), "arch specific: 64"},
+		{OSAbi(300), "unknown: 300"},
+		{ET_DYN, "dyn"},
+		{ObjectType(0xfe01), "OS specific: FE01"},
+		{ISAmd64, "amd64"},
+		{ISLOONGARCH, "LoongArch"},
+		{InstructionSet(6), "Intel MCU"},
+		{InstructionSet(0x1234), "unknown: 0x1234"},
+		{SectionTypeGNUHash, "GNU_HASH"},
+		{SectionTypeRelr, "RELR"},
+		{SectionTypeLLVMAddrSig, "LLVM_ADDRSIG"},
+		{SectionTypeGNUVerSym, "VERSYM"},
+		{SectionType(0x60000005), "OS specific: 0x60000005"},
+		{SectionType(0x70000001), "proc specific: 0x70000001"},
+		{SectionType(0x80000001), "user specific: 0x80000001"},
+		{SectionType(0x20), "unknown: 0x00000020"},
+		{SegmentTypeGNUStack, "GNU_STACK"},
+		{SegmentTypeGNUEHFrame, "GNU_EH_FRAME"},
+		{SegmentTypeReserved, "SHLIB"},
+		{SegmentTypeProgramHeaderTable, "PHDR"},
+		{SegmentType(0x70000001), "proc specific: 0x70000001"},
+		{Alignment(1), "none"},
+		{Alignment(0x1000), "12 bits"},
+		{Alignment(1 << 63), "63 bits"},
+		{Alignment(3), "invalid: 3"},
+	}
+	for _, tc := range tcs {
+		assert.Equal(t, tc.expected, tc.value.String())
+	}
+}
+
+func TestConstantStringsDoNotPanic(t *testing.T) {
+	for value := -1; value <= 0x10000; value++ {
+		_ = ELFClass(value).String()
+		_ = Endianess(value).String()
+		_ = OSAbi(value).String()
+		_ = ObjectType(value).String()
+		_ = InstructionSet(value).String()
+		_ = SectionType(value).String()
+		_ = SegmentType(value).String()
+		_ = Alignment(value).String()
+	}
+}
+
+func TestConstantGoStrings(t *testing.T) {
+	assert.Equal(t, "elf.SectionTypeGNUHash", fmt.Sprintf("%#v", SectionTypeGNUHash))
+	assert.Equal(t, "elf.SectionTypeOSSpecific", fmt.Sprintf("%#v", SectionType(0x60000000)))
+	assert.Equal(t, "elf.SectionType(0x20)", fmt.Sprintf("%#v", SectionType(0x20)))
+	assert.Equal(t, "elf.SegmentTypeGNURelRO", SegmentTypeGNURelRO.GoString())
+	assert.Equal(t, "elf.ISAmd64", ISAmd64.GoString())
+	assert.Equal(t, "elf.ET_EXEC", ET_EXEC.GoString())
+	assert.Equal(t, "elf.Linux", Linux.GoString())
+	assert.Equal(t, "elf.ELFClass64", ELFClass64.GoString())
+	assert.Equal(t, "elf.LittleEndian", LittleEndian.GoString())
+}
+
+func TestParseConstants(t *testing.T) {
+	sectionType, err := ParseSectionType("SHT_GNU_HASH")
+	assert.NoError(t, err)
+	assert.Equal(t, SectionTypeGNUHash, sectionType)
+	sectionType, err = ParseSectionType("SectionTypeRelr")
+	assert.NoError(t, err)
+	assert.Equal(t, SectionTypeRelr, sectionType)
+
+	segmentType, err := ParseSegmentType("PT_GNU_PROPERTY")
+	assert.NoError(t, err)
+	assert.Equal(t, SegmentTypeGNUProperty, segmentType)
+
+	machine, err := ParseInstructionSet("EM_X86_64")
+	assert.NoError(t, err)
+	assert.Equal(t, ISAmd64, machine)
+
+	osAbi, err := ParseOSAbi("ELFOSABI_SOLARIS")
+	assert.NoError(t, err)
+	assert.Equal(t, OSAbi(6), osAbi)
+
+	objectType, err := ParseObjectType("ET_CORE")
+	assert.NoError(t, err)
+	assert.Equal(t, ET_CORE, objectType)
+
+	class, err := ParseELFClass("ELFCLASS32")
+	assert.NoError(t, err)
+	assert.Equal(t, ELFClass32, class)
+
+	endianess, err := ParseEndianess("ELFDATA2MSB")
+	assert.NoError(t, err)
+	assert.Equal(t, BigEndian, endianess)
+
+	_, err = ParseSectionType("SHT_NO_SUCH_TYPE")
+	assert.True(t, errors.Is(err, ErrUnknownConstantName))
+}
+
+func TestReadRejectsUnknownClassAndEndianess(t *testing.T) {
+	for _, offset := range []int{4, 5} {
+		content, err := ioutil.ReadFile(filepath.Join("testdata", "helloworld_linux_amd64"))
+		assert.NoError(t, err)
+		content[offset] = 0x7f
+
+		_, err = Read(bytes.NewReader(content))
+		assert.True(t, errors.Is(err, ErrInvalidELF))
+	}
+}
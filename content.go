@@ -0,0 +1,129 @@
+package elf
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"math"
+)
+
+// Data returns section content, compressed sections (SHF_COMPRESSED or legacy .zdebug) are decompressed.
+// SHT_NOBITS and SHT_NULL sections have no file bytes, so their content is empty
+func (s *Section) Data() ([]byte, error) {
+	if s.IsCompressed() {
+		return s.decompress()
+	}
+	return s.RawData()
+}
+
+// RawData returns section content as stored in file, including compression header of compressed sections
+func (s *Section) RawData() ([]byte, error) {
+	if !s.hasFileBytes() {
+		return []byte{}, nil
+	}
+	return readAt(s.reader, s.Offset, s.Size)
+}
+
+// Open returns reader of section content, see Data. Compressed section is decompressed to memory,
+// decompression error is returned by every call of the reader
+func (s *Section) Open() io.ReadSeeker {
+	if !s.hasFileBytes() {
+		return bytes.NewReader(nil)
+	}
+	if s.IsCompressed() {
+		content, err := s.decompress()
+		if err != nil {
+			return errorReader{err: err}
+		}
+		return bytes.NewReader(content)
+	}
+	return io.NewSectionReader(s.reader, int64(s.Offset), int64(s.Size))
+}
+
+func (s *Section) hasFileBytes() bool {
+	return s.Type != SectionTypeBSS && s.Type != SectionTypeNull
+}
+
+// maxZeroTail is largest zero filled segment tail allocated by Segment.Data
+const maxZeroTail = math.MaxInt32
+
+// Data returns segment content as loaded to memory - SizeInFile bytes from file followed by zero filled tail up to SizeInMemory.
+// SizeInMemory is not backed by file, so Data fails for tails larger than 2GB, Open reads them without allocating
+func (s *Segment) Data() ([]byte, error) {
+	if s.SizeInMemory > s.SizeInFile && s.SizeInMemory-s.SizeInFile > maxZeroTail {
+		return nil, fmt.Errorf("zero filled tail of 0x%x bytes is too large, open segment instead", s.SizeInMemory-s.SizeInFile)
+	}
+	content, err := readAt(s.reader, s.FileOffset, s.SizeInFile)
+	if err != nil {
+		return nil, err
+	}
+	if s.SizeInMemory > s.SizeInFile {
+		content = append(content, make([]byte, s.SizeInMemory-s.SizeInFile)...)
+	}
+	return content, nil
+}
+
+// Open returns reader of segment content, see Data
+func (s *Segment) Open() io.ReadSeeker {
+	size := s.SizeInMemory
+	if s.SizeInFile > size {
+		size = s.SizeInFile
+	}
+	return io.NewSectionReader(zeroPaddedReaderAt{
+		reader:     s.reader,
+		offset:     int64(s.FileOffset),
+		sizeInFile: int64(s.SizeInFile),
+	}, 0, int64(size))
+}
+
+// zeroPaddedReaderAt reads sizeInFile bytes starting at offset of underlying reader and returns zeros after them
+type zeroPaddedReaderAt struct {
+	reader     io.ReaderAt
+	offset     int64
+	sizeInFile int64
+}
+
+func (zp zeroPaddedReaderAt) ReadAt(p []byte, off int64) (int, error) {
+	var n int
+	if off < zp.sizeInFile {
+		fromFile := p
+		if int64(len(fromFile)) > zp.sizeInFile-off {
+			fromFile = fromFile[:zp.sizeInFile-off]
+		}
+		read, err := zp.reader.ReadAt(fromFile, zp.offset+off)
+		n += read
+		if read < len(fromFile) {
+			return n, err
+		}
+	}
+	for i := n; i < len(p); i++ {
+		p[i] = 0
+	}
+	return len(p), nil
+}
+
+// readAt reads exactly size bytes at given offset. Content is read in chunks, so bogus sizes from malformed files
+// fail with EOF instead of allocating huge buffers upfront
+func readAt(reader io.ReaderAt, offset FileOffset, size uint64) ([]byte, error) {
+	const chunkSize = 1 << 20
+	if int64(offset) < 0 || int64(size) < 0 || int64(offset)+int64(size) < 0 {
+		return nil, fmt.Errorf("offset %v and size %v out of range", offset, size)
+	}
+	content := make([]byte, 0, minUint64(size, chunkSize))
+	for read := uint64(0); read < size; {
+		chunk := make([]byte, minUint64(size-read, chunkSize))
+		if n, err := reader.ReadAt(chunk, int64(offset)+int64(read)); err != nil && !(err == io.EOF && n == len(chunk)) {
+			return nil, fmt.Errorf("read %v bytes at %v: %v", len(chunk), uint64(offset)+read, err)
+		}
+		content = append(content, chunk...)
+		read += uint64(len(chunk))
+	}
+	return content, nil
+}
+
+func minUint64(a, b uint64) uint64 {
+	if a < b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,94 @@
+package elf
+
+import (
+	"io"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSectionContent(t *testing.T) {
+	file, err := Open(filepath.Join("testdata", "helloworld_linux_amd64"))
+	assert.NoError(t, err)
+	defer file.Close()
+
+	note := file.Section(".note.go.buildid")
+	data, err := note.Data()
+	assert.NoError(t, err)
+	assert.Len(t, data, 0x64)
+	assert.Equal(t, []byte{0x04, 0x00, 0x00, 0x00, 0x53, 0x00, 0x00, 0x00}, data[:8])
+	assert.Equal(t, "Go\x00\x00", string(data[12:16]))
+
+	reader := note.Open()
+	_, err = reader.Seek(12, io.SeekStart)
+	assert.NoError(t, err)
+	rest, err := ioutil.ReadAll(reader)
+	assert.NoError(t, err)
+	assert.Equal(t, data[12:], rest)
+
+	bss := file.Section(".bss")
+	data, err = bss.Data()
+	assert.NoError(t, err)
+	assert.Empty(t, data)
+	rest, err = ioutil.ReadAll(bss.Open())
+	assert.NoError(t, err)
+	assert.Empty(t, rest)
+}
+
+func TestSegmentContentHasZeroFilledTail(t *testing.T) {
+	file, err := Open(filepath.Join("testdata", "helloworld_linux_amd64"))
+	assert.NoError(t, err)
+	defer file.Close()
+
+	var dataSegment *Segment
+	for _, segment := range file.Segments {
+		if segment.Type == SegmentTypeLoad && segment.Flags.Writable() {
+			dataSegment = segment
+		}
+	}
+	assert.NotNil(t, dataSegment)
+	assert.Equal(t, uint64(0x0151c0), dataSegment.SizeInFile)
+	assert.Equal(t, uint64(0x041348), dataSegment.SizeInMemory)
+
+	data, err := dataSegment.Data()
+	assert.NoError(t, err)
+	assert.Len(t, data, 0x041348)
+	assert.Equal(t, make([]byte, 0x041348-0x0151c0), data[0x0151c0:])
+
+	fromFile, err := file.Section(".go.buildinfo").Data()
+	assert.NoError(t, err)
+	assert.Equal(t, fromFile, data[:len(fromFile)])
+
+	reader := dataSegment.Open()
+	_, err = reader.Seek(0x0151c0-4, io.SeekStart)
+	assert.NoError(t, err)
+	buff := make([]byte, 8)
+	_, err = io.ReadFull(reader, buff)
+	assert.NoError(t, err)
+	assert.Equal(t, data[0x0151c0-4:0x0151c0+4], buff)
+
+	_, err = reader.Seek(0, io.SeekEnd)
+	assert.NoError(t, err)
+	_, err = reader.Read(buff)
+	assert.Equal(t, io.EOF, err)
+}
+
+func TestSegmentWithHugeZeroFilledTail(t *testing.T) {
+	file, err := Open(filepath.Join("testdata", "helloworld_linux_amd64"))
+	assert.NoError(t, err)
+	defer file.Close()
+
+	segment := *file.Segments[len(file.Segments)-1]
+	segment.SizeInMemory = segment.SizeInFile + 1<<40
+	_, err = segment.Data()
+	assert.Error(t, err)
+
+	reader := segment.Open()
+	_, err = reader.Seek(-4, io.SeekEnd)
+	assert.NoError(t, err)
+	rest, err := ioutil.ReadAll(reader)
+	assert.NoError(t, err)
+	assert.Equal(t, make([]byte, 4), rest)
+}
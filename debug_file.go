@@ -0,0 +1,232 @@
+package elf
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// DebugLinkSectionName is section naming separate debug file together with its checksum
+const DebugLinkSectionName = ".gnu_debuglink"
+
+// DebugLink is content of .gnu_debuglink section
+type DebugLink struct {
+	Filename string
+	CRC32    uint32 // CRC32 (IEEE) of whole debug file, see DebugLinkCRC32
+}
+
+var ErrNoDebugLink = errors.New("no debug link section")
+var ErrInvalidDebugLink = errors.New("invalid debug link")
+var ErrDebugFileNotFound = errors.New("debug file not found")
+
+// DebugLink decodes .gnu_debuglink section - NUL terminated file name padded to 4 bytes followed by CRC32 in file byte order
+func (f *File) DebugLink() (DebugLink, error) {
+	section := f.Section(DebugLinkSectionName)
+	if section == nil {
+		return DebugLink{}, ErrNoDebugLink
+	}
+	content, err := section.Data()
+	if err != nil {
+		return DebugLink{}, fmt.Errorf("%w read: %v", ErrInvalidDebugLink, err)
+	}
+	end := bytes.IndexByte(content, 0)
+	if end <= 0 {
+		return DebugLink{}, fmt.Errorf("%w file name is empty or not terminated", ErrInvalidDebugLink)
+	}
+	crcOffset := (end + 4) &^ 3
+	if len(content) < crcOffset+4 {
+		return DebugLink{}, fmt.Errorf("%w CRC is missing", ErrInvalidDebugLink)
+	}
+	return DebugLink{
+		Filename: string(content[:end]),
+		CRC32:    f.ByteOrder().Uint32(content[crcOffset:]),
+	}, nil
+}
+
+// DebugLinkCRC32 computes checksum of debug file as stored in .gnu_debuglink section
+func DebugLinkCRC32(reader io.Reader) (uint32, error) {
+	hash := crc32.NewIEEE()
+	if _, err := io.Copy(hash, reader); err != nil {
+		return 0, err
+	}
+	return hash.Sum32(), nil
+}
+
+// DefaultDebugRoots are global debug directories used when DebugFileResolver has none configured
+var DefaultDebugRoots = []string{"/usr/lib/debug"}
+
+// DebugFileSource tells how separate debug file was found
+type DebugFileSource int
+
+const (
+	// DebugFileByBuildID is ROOT/.build-id/xx/yyyy.debug named by NT_GNU_BUILD_ID note, verified to have the same build ID
+	DebugFileByBuildID DebugFileSource = iota
+	// DebugFileByDebugLink is file named by .gnu_debuglink section, verified by its CRC32
+	DebugFileByDebugLink
+)
+
+func (dfs DebugFileSource) String() string {
+	switch dfs {
+	case DebugFileByBuildID:
+		return "build ID"
+	case DebugFileByDebugLink:
+		return "debug link"
+	}
+	return fmt.Sprintf("unknown: %d", int(dfs))
+}
+
+// DebugFileResolver finds separate debug files the way GDB does: by build ID in each debug root first, then by debug link
+// next to the original file, in its .debug subdirectory and under each debug root
+type DebugFileResolver struct {
+	DebugRoots []string // DefaultDebugRoots if empty
+}
+
+// DebugFile is separate debug file found for original file
+type DebugFile struct {
+	*File
+	Path   string
+	Source DebugFileSource
+	// Merged has header and segments of original file and sections of debug file, where sections stripped to SHT_NOBITS
+	// placeholders in debug file are taken from original, so symbols and DWARF come from debug file while
+	// dynamic section, dynamic symbols and code come from original
+	Merged *File
+}
+
+// Find locates, verifies and opens debug file of original file located at path. Path is used for debug link lookup only
+// and may be empty if only build ID lookup is wanted. Returned DebugFile must be closed by caller
+func (r DebugFileResolver) Find(original *File, path string) (*DebugFile, error) {
+	var rejected []string
+	roots := r.DebugRoots
+	if len(roots) == 0 {
+		roots = DefaultDebugRoots
+	}
+
+	if buildID, err := original.BuildID(); err == nil && len(buildID) >= 2 {
+		name := buildID.String()
+		for _, root := range roots {
+			candidate := filepath.Join(root, ".build-id", name[:2], name[2:]+".debug")
+			debugFile, err := openDebugFile(candidate, func(file *File) error {
+				candidateID, err := file.BuildID()
+				if err != nil {
+					return err
+				}
+				if !bytes.Equal(candidateID, buildID) {
+					return fmt.Errorf("build ID %v does not match %v", candidateID, buildID)
+				}
+				return nil
+			})
+			if debugFile != nil {
+				return newDebugFile(original, debugFile, candidate, DebugFileByBuildID), nil
+			}
+			if err != nil {
+				rejected = append(rejected, fmt.Sprintf("%v: %v", candidate, err))
+			}
+		}
+	}
+
+	link, err := original.DebugLink()
+	if err != nil && !errors.Is(err, ErrNoDebugLink) {
+		return nil, err
+	}
+	if err == nil {
+		for _, candidate := range debugLinkCandidates(link.Filename, path, roots) {
+			debugFile, err := openDebugFile(candidate, nil)
+			if debugFile == nil && err == nil {
+				continue
+			}
+			if err == nil {
+				var checksum uint32
+				checksum, err = DebugLinkCRC32(io.NewSectionReader(debugFile.reader, 0, 1<<63-1))
+				if err == nil && checksum != link.CRC32 {
+					err = fmt.Errorf("CRC32 0x%08x does not match 0x%08x", checksum, link.CRC32)
+				}
+				if err == nil {
+					return newDebugFile(original, debugFile, candidate, DebugFileByDebugLink), nil
+				}
+				debugFile.Close()
+			}
+			rejected = append(rejected, fmt.Sprintf("%v: %v", candidate, err))
+		}
+	}
+
+	if len(rejected) > 0 {
+		return nil, fmt.Errorf("%w, rejected candidates: %v", ErrDebugFileNotFound, strings.Join(rejected, "; "))
+	}
+	return nil, ErrDebugFileNotFound
+}
+
+// debugLinkCandidates lists debug link locations in GDB order - directory of original file, its .debug subdirectory and
+// the same directory under each debug root
+func debugLinkCandidates(name, path string, roots []string) []string {
+	if path == "" {
+		return nil
+	}
+	dir := filepath.Dir(path)
+	candidates := []string{filepath.Join(dir, name), filepath.Join(dir, ".debug", name)}
+	if absDir, err := filepath.Abs(dir); err == nil {
+		for _, root := range roots {
+			candidates = append(candidates, filepath.Join(root, absDir, name))
+		}
+	}
+	return candidates
+}
+
+// openDebugFile opens and verifies candidate, returns nil file and nil error if it doesn't exist
+func openDebugFile(path string, verify func(file *File) error) (*File, error) {
+	file, err := Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if verify != nil {
+		if err := verify(file); err != nil {
+			file.Close()
+			return nil, err
+		}
+	}
+	return file, nil
+}
+
+func newDebugFile(original, debugFile *File, path string, source DebugFileSource) *DebugFile {
+	return &DebugFile{
+		File:   debugFile,
+		Path:   path,
+		Source: source,
+		Merged: MergeDebugFile(original, debugFile),
+	}
+}
+
+// MergeDebugFile combines original file with its separate debug file, see DebugFile.Merged. Merged file shares readers
+// of both files, so it must not be used after either of them is closed, and it doesn't need to be closed itself
+func MergeDebugFile(original, debugFile *File) *File {
+	merged := &File{
+		Header:   original.Header,
+		Segments: original.Segments,
+		reader:   original.reader,
+	}
+	merged.SectionHeaderTable = debugFile.SectionHeaderTable
+	merged.NamesSectionIndex = debugFile.NamesSectionIndex
+
+	for _, section := range debugFile.Sections {
+		source := section
+		if section.Type == SectionTypeBSS {
+			if originalSection := original.Section(section.Name); originalSection != nil && originalSection.Type != SectionTypeBSS {
+				source = originalSection
+			}
+		}
+		merged.Sections = append(merged.Sections, &Section{
+			SectionHeader: source.SectionHeader,
+			Name:          source.Name,
+			reader:        source.reader,
+			file:          merged,
+		})
+	}
+	return merged
+}
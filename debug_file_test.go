@@ -0,0 +1,122 @@
+package elf
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDebugLink(t *testing.T) {
+	file, err := Open(filepath.Join("testdata", "debug", "libsample_stripped.so"))
+	assert.NoError(t, err)
+	defer file.Close()
+
+	link, err := file.DebugLink()
+	assert.NoError(t, err)
+	assert.Equal(t, "libsample_stripped.so.debug", link.Filename)
+
+	debugFile, err := os.Open(filepath.Join("testdata", "debug", ".debug", "libsample_stripped.so.debug"))
+	assert.NoError(t, err)
+	defer debugFile.Close()
+	checksum, err := DebugLinkCRC32(debugFile)
+	assert.NoError(t, err)
+	assert.Equal(t, link.CRC32, checksum)
+
+	original, err := Open(filepath.Join("testdata", "libsample.so"))
+	assert.NoError(t, err)
+	defer original.Close()
+	_, err = original.DebugLink()
+	assert.True(t, errors.Is(err, ErrNoDebugLink))
+}
+
+func TestFindDebugFileByBuildID(t *testing.T) {
+	path := filepath.Join("testdata", "debug", "libsample_stripped.so")
+	file, err := Open(path)
+	assert.NoError(t, err)
+	defer file.Close()
+
+	resolver := DebugFileResolver{DebugRoots: []string{filepath.Join("testdata", "debug", "missing"), filepath.Join("testdata", "debug", "root")}}
+	debugFile, err := resolver.Find(file, path)
+	assert.NoError(t, err)
+	defer debugFile.Close()
+	assert.Equal(t, DebugFileByBuildID, debugFile.Source)
+	assert.Equal(t, filepath.Join("testdata", "debug", "root", ".build-id", "1b", "66fe58f6fdac2a677b81f6bc9dee2130a69a4b.debug"), debugFile.Path)
+	assert.NotNil(t, debugFile.Section(".debug_info"))
+}
+
+func TestFindDebugFileByDebugLink(t *testing.T) {
+	path := filepath.Join("testdata", "debug", "libsample_stripped.so")
+	file, err := Open(path)
+	assert.NoError(t, err)
+	defer file.Close()
+
+	resolver := DebugFileResolver{DebugRoots: []string{filepath.Join("testdata", "debug", "missing")}}
+	debugFile, err := resolver.Find(file, path)
+	assert.NoError(t, err)
+	defer debugFile.Close()
+	assert.Equal(t, DebugFileByDebugLink, debugFile.Source)
+	assert.Equal(t, filepath.Join("testdata", "debug", ".debug", "libsample_stripped.so.debug"), debugFile.Path)
+
+	_, err = resolver.Find(file, "")
+	assert.True(t, errors.Is(err, ErrDebugFileNotFound))
+}
+
+func TestFindDebugFileRejectsMismatchedCRC(t *testing.T) {
+	dir, err := ioutil.TempDir("", "debuglink")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	stripped, err := ioutil.ReadFile(filepath.Join("testdata", "debug", "libsample_stripped.so"))
+	assert.NoError(t, err)
+	debugContent, err := ioutil.ReadFile(filepath.Join("testdata", "debug", ".debug", "libsample_stripped.so.debug"))
+	assert.NoError(t, err)
+	debugContent[len(debugContent)-1] ^= 0xff
+	path := filepath.Join(dir, "libsample_stripped.so")
+	assert.NoError(t, ioutil.WriteFile(path, stripped, 0644))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "libsample_stripped.so.debug"), debugContent, 0644))
+
+	file, err := Open(path)
+	assert.NoError(t, err)
+	defer file.Close()
+	_, err = DebugFileResolver{DebugRoots: []string{filepath.Join(dir, "missing")}}.Find(file, path)
+	assert.True(t, errors.Is(err, ErrDebugFileNotFound))
+	assert.Contains(t, err.Error(), "CRC32")
+}
+
+func TestMergedDebugFile(t *testing.T) {
+	path := filepath.Join("testdata", "debug", "libsample_stripped.so")
+	file, err := Open(path)
+	assert.NoError(t, err)
+	defer file.Close()
+	_, err = file.Symbols()
+	assert.True(t, errors.Is(err, ErrNoSymbols))
+
+	debugFile, err := DebugFileResolver{DebugRoots: []string{filepath.Join("testdata", "debug", "root")}}.Find(file, path)
+	assert.NoError(t, err)
+	defer debugFile.Close()
+	merged := debugFile.Merged
+
+	assert.Equal(t, file.Segments, merged.Segments)
+	symbol, err := merged.SymbolForAddress(0x111a, SymbolSourceSymTable)
+	assert.NoError(t, err)
+	assert.Equal(t, "sample_value_v1", symbol.Name)
+
+	// dynamic symbols and code are stripped to SHT_NOBITS in debug file, so they come from original
+	symbol, err = merged.LookupSymbol("sample_name", SymbolSourceDynamic)
+	assert.NoError(t, err)
+	assert.Equal(t, "SAMPLE_1.0", symbol.Version)
+	assert.Equal(t, SectionTypeProgBits, merged.Section(".text").Type)
+	text, err := merged.Section(".text").Data()
+	assert.NoError(t, err)
+	originalText, err := file.Section(".text").Data()
+	assert.NoError(t, err)
+	assert.Equal(t, originalText, text)
+
+	info, err := merged.Section(".debug_info").Data()
+	assert.NoError(t, err)
+	assert.NotEmpty(t, info)
+}
@@ -0,0 +1,376 @@
+package elf
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var ErrNoDWARFSection = errors.New("no DWARF section")
+var ErrInvalidDWARF = errors.New("invalid DWARF")
+
+// DWARFForm is DW_FORM_* encoding of attribute value
+type DWARFForm uint64
+
+const (
+	//0x01	DW_FORM_addr	Target address of address_size bytes
+	DWARFFormAddr DWARFForm = 0x01
+	//0x03	DW_FORM_block2	Block with 2 byte length
+	DWARFFormBlock2 DWARFForm = 0x03
+	//0x04	DW_FORM_block4	Block with 4 byte length
+	DWARFFormBlock4 DWARFForm = 0x04
+	//0x05	DW_FORM_data2	2 byte constant
+	DWARFFormData2 DWARFForm = 0x05
+	//0x06	DW_FORM_data4	4 byte constant
+	DWARFFormData4 DWARFForm = 0x06
+	//0x07	DW_FORM_data8	8 byte constant
+	DWARFFormData8 DWARFForm = 0x07
+	//0x08	DW_FORM_string	Inline NUL terminated string
+	DWARFFormString DWARFForm = 0x08
+	//0x09	DW_FORM_block	Block with ULEB128 length
+	DWARFFormBlock DWARFForm = 0x09
+	//0x0a	DW_FORM_block1	Block with 1 byte length
+	DWARFFormBlock1 DWARFForm = 0x0a
+	//0x0b	DW_FORM_data1	1 byte constant
+	DWARFFormData1 DWARFForm = 0x0b
+	//0x0c	DW_FORM_flag	1 byte flag
+	DWARFFormFlag DWARFForm = 0x0c
+	//0x0d	DW_FORM_sdata	SLEB128 constant
+	DWARFFormSData DWARFForm = 0x0d
+	//0x0e	DW_FORM_strp	Offset to .debug_str
+	DWARFFormStrp DWARFForm = 0x0e
+	//0x0f	DW_FORM_udata	ULEB128 constant
+	DWARFFormUData DWARFForm = 0x0f
+	//0x10	DW_FORM_ref_addr	Offset of DIE in .debug_info
+	DWARFFormRefAddr DWARFForm = 0x10
+	//0x11	DW_FORM_ref1	1 byte unit relative DIE offset
+	DWARFFormRef1 DWARFForm = 0x11
+	//0x12	DW_FORM_ref2	2 byte unit relative DIE offset
+	DWARFFormRef2 DWARFForm = 0x12
+	//0x13	DW_FORM_ref4	4 byte unit relative DIE offset
+	DWARFFormRef4 DWARFForm = 0x13
+	//0x14	DW_FORM_ref8	8 byte unit relative DIE offset
+	DWARFFormRef8 DWARFForm = 0x14
+	//0x15	DW_FORM_ref_udata	ULEB128 unit relative DIE offset
+	DWARFFormRefUData DWARFForm = 0x15
+	//0x16	DW_FORM_indirect	Form is given by ULEB128 preceding the value
+	DWARFFormIndirect DWARFForm = 0x16
+	//0x17	DW_FORM_sec_offset	Offset to other debug section
+	DWARFFormSecOffset DWARFForm = 0x17
+	//0x18	DW_FORM_exprloc	DWARF expression with ULEB128 length
+	DWARFFormExprLoc DWARFForm = 0x18
+	//0x19	DW_FORM_flag_present	Flag without value bytes
+	DWARFFormFlagPresent DWARFForm = 0x19
+	//0x1a	DW_FORM_strx	ULEB128 index to .debug_str_offsets
+	DWARFFormStrx DWARFForm = 0x1a
+	//0x1b	DW_FORM_addrx	ULEB128 index to .debug_addr
+	DWARFFormAddrx DWARFForm = 0x1b
+	//0x1c	DW_FORM_ref_sup4	4 byte offset of DIE in supplementary file
+	DWARFFormRefSup4 DWARFForm = 0x1c
+	//0x1d	DW_FORM_strp_sup	Offset to .debug_str of supplementary file
+	DWARFFormStrpSup DWARFForm = 0x1d
+	//0x1e	DW_FORM_data16	16 byte constant
+	DWARFFormData16 DWARFForm = 0x1e
+	//0x1f	DW_FORM_line_strp	Offset to .debug_line_str
+	DWARFFormLineStrp DWARFForm = 0x1f
+	//0x20	DW_FORM_ref_sig8	8 byte type signature
+	DWARFFormRefSig8 DWARFForm = 0x20
+	//0x21	DW_FORM_implicit_const	Constant stored in abbreviation
+	DWARFFormImplicitConst DWARFForm = 0x21
+	//0x22	DW_FORM_loclistx	ULEB128 index to .debug_loclists offsets
+	DWARFFormLocListx DWARFForm = 0x22
+	//0x23	DW_FORM_rnglistx	ULEB128 index to .debug_rnglists offsets
+	DWARFFormRngListx DWARFForm = 0x23
+	//0x24	DW_FORM_ref_sup8	8 byte offset of DIE in supplementary file
+	DWARFFormRefSup8 DWARFForm = 0x24
+	//0x25	DW_FORM_strx1	1 byte index to .debug_str_offsets
+	DWARFFormStrx1 DWARFForm = 0x25
+	//0x26	DW_FORM_strx2	2 byte index to .debug_str_offsets
+	DWARFFormStrx2 DWARFForm = 0x26
+	//0x27	DW_FORM_strx3	3 byte index to .debug_str_offsets
+	DWARFFormStrx3 DWARFForm = 0x27
+	//0x28	DW_FORM_strx4	4 byte index to .debug_str_offsets
+	DWARFFormStrx4 DWARFForm = 0x28
+	//0x29	DW_FORM_addrx1	1 byte index to .debug_addr
+	DWARFFormAddrx1 DWARFForm = 0x29
+	//0x2a	DW_FORM_addrx2	2 byte index to .debug_addr
+	DWARFFormAddrx2 DWARFForm = 0x2a
+	//0x2b	DW_FORM_addrx3	3 byte index to .debug_addr
+	DWARFFormAddrx3 DWARFForm = 0x2b
+	//0x2c	DW_FORM_addrx4	4 byte index to .debug_addr
+	DWARFFormAddrx4 DWARFForm = 0x2c
+	//0x1f01	DW_FORM_GNU_addr_index	Pre DWARF 5 split DWARF DW_FORM_addrx
+	DWARFFormGNUAddrIndex DWARFForm = 0x1f01
+	//0x1f02	DW_FORM_GNU_str_index	Pre DWARF 5 split DWARF DW_FORM_strx
+	DWARFFormGNUStrIndex DWARFForm = 0x1f02
+	//0x1f20	DW_FORM_GNU_ref_alt	Offset of DIE in .gnu_debugaltlink file
+	DWARFFormGNURefAlt DWARFForm = 0x1f20
+	//0x1f21	DW_FORM_GNU_strp_alt	Offset to .debug_str of .gnu_debugaltlink file
+	DWARFFormGNUStrpAlt DWARFForm = 0x1f21
+)
+
+var dwarfFormNames = newConstantNames("DWARFForm", []constantName{
+	{0x01, "DW_FORM_addr", "DWARFFormAddr", "addr"},
+	{0x03, "DW_FORM_block2", "DWARFFormBlock2", "block2"},
+	{0x04, "DW_FORM_block4", "DWARFFormBlock4", "block4"},
+	{0x05, "DW_FORM_data2", "DWARFFormData2", "data2"},
+	{0x06, "DW_FORM_data4", "DWARFFormData4", "data4"},
+	{0x07, "DW_FORM_data8", "DWARFFormData8", "data8"},
+	{0x08, "DW_FORM_string", "DWARFFormString", "string"},
+	{0x09, "DW_FORM_block", "DWARFFormBlock", "block"},
+	{0x0a, "DW_FORM_block1", "DWARFFormBlock1", "block1"},
+	{0x0b, "DW_FORM_data1", "DWARFFormData1", "data1"},
+	{0x0c, "DW_FORM_flag", "DWARFFormFlag", "flag"},
+	{0x0d, "DW_FORM_sdata", "DWARFFormSData", "sdata"},
+	{0x0e, "DW_FORM_strp", "DWARFFormStrp", "strp"},
+	{0x0f, "DW_FORM_udata", "DWARFFormUData", "udata"},
+	{0x10, "DW_FORM_ref_addr", "DWARFFormRefAddr", "ref_addr"},
+	{0x11, "DW_FORM_ref1", "DWARFFormRef1", "ref1"},
+	{0x12, "DW_FORM_ref2", "DWARFFormRef2", "ref2"},
+	{0x13, "DW_FORM_ref4", "DWARFFormRef4", "ref4"},
+	{0x14, "DW_FORM_ref8", "DWARFFormRef8", "ref8"},
+	{0x15, "DW_FORM_ref_udata", "DWARFFormRefUData", "ref_udata"},
+	{0x16, "DW_FORM_indirect", "DWARFFormIndirect", "indirect"},
+	{0x17, "DW_FORM_sec_offset", "DWARFFormSecOffset", "sec_offset"},
+	{0x18, "DW_FORM_exprloc", "DWARFFormExprLoc", "exprloc"},
+	{0x19, "DW_FORM_flag_present", "DWARFFormFlagPresent", "flag_present"},
+	{0x1a, "DW_FORM_strx", "DWARFFormStrx", "strx"},
+	{0x1b, "DW_FORM_addrx", "DWARFFormAddrx", "addrx"},
+	{0x1c, "DW_FORM_ref_sup4", "DWARFFormRefSup4", "ref_sup4"},
+	{0x1d, "DW_FORM_strp_sup", "DWARFFormStrpSup", "strp_sup"},
+	{0x1e, "DW_FORM_data16", "DWARFFormData16", "data16"},
+	{0x1f, "DW_FORM_line_strp", "DWARFFormLineStrp", "line_strp"},
+	{0x20, "DW_FORM_ref_sig8", "DWARFFormRefSig8", "ref_sig8"},
+	{0x21, "DW_FORM_implicit_const", "DWARFFormImplicitConst", "implicit_const"},
+	{0x22, "DW_FORM_loclistx", "DWARFFormLocListx", "loclistx"},
+	{0x23, "DW_FORM_rnglistx", "DWARFFormRngListx", "rnglistx"},
+	{0x24, "DW_FORM_ref_sup8", "DWARFFormRefSup8", "ref_sup8"},
+	{0x25, "DW_FORM_strx1", "DWARFFormStrx1", "strx1"},
+	{0x26, "DW_FORM_strx2", "DWARFFormStrx2", "strx2"},
+	{0x27, "DW_FORM_strx3", "DWARFFormStrx3", "strx3"},
+	{0x28, "DW_FORM_strx4", "DWARFFormStrx4", "strx4"},
+	{0x29, "DW_FORM_addrx1", "DWARFFormAddrx1", "addrx1"},
+	{0x2a, "DW_FORM_addrx2", "DWARFFormAddrx2", "addrx2"},
+	{0x2b, "DW_FORM_addrx3", "DWARFFormAddrx3", "addrx3"},
+	{0x2c, "DW_FORM_addrx4", "DWARFFormAddrx4", "addrx4"},
+	{0x1f01, "DW_FORM_GNU_addr_index", "DWARFFormGNUAddrIndex", "GNU_addr_index"},
+	{0x1f02, "DW_FORM_GNU_str_index", "DWARFFormGNUStrIndex", "GNU_str_index"},
+	{0x1f20, "DW_FORM_GNU_ref_alt", "DWARFFormGNURefAlt", "GNU_ref_alt"},
+	{0x1f21, "DW_FORM_GNU_strp_alt", "DWARFFormGNUStrpAlt", "GNU_strp_alt"},
+})
+
+func (df DWARFForm) String() string {
+	if text, ok := dwarfFormNames.text(uint64(df)); ok {
+		return text
+	}
+	return fmt.Sprintf("unknown: 0x%X", uint64(df))
+}
+
+func (df DWARFForm) GoString() string {
+	return dwarfFormNames.goString(uint64(df))
+}
+
+// ParseDWARFForm resolves specification (DW_FORM_strp) or Go (DWARFFormStrp) constant name
+func ParseDWARFForm(name string) (DWARFForm, error) {
+	value, err := dwarfFormNames.parse(name)
+	return DWARFForm(value), err
+}
+
+// legacyDWARFPrefix replaces .debug prefix in names of legacy compressed sections
+const legacyDWARFPrefix = ".zdebug"
+
+// DWARFSection returns content of DWARF section by its name (e.g. .debug_line), legacy .zdebug_ section is used if there is
+// no section of given name. Content is decompressed and in relocatable objects its relocations are applied.
+// Returns ErrNoDWARFSection if file has neither section
+func (f *File) DWARFSection(name string) ([]byte, error) {
+	section := f.Section(name)
+	if section == nil && strings.HasPrefix(name, ".debug") {
+		section = f.Section(legacyDWARFPrefix + strings.TrimPrefix(name, ".debug"))
+	}
+	if section == nil {
+		return nil, fmt.Errorf("%w: %v", ErrNoDWARFSection, name)
+	}
+	if f.ObjectType == ET_REL {
+		if relocationSection := f.RelocationSectionFor(section); relocationSection != nil {
+			return f.ApplyRelocations(section, relocationSection)
+		}
+	}
+	return section.Data()
+}
+
+// optionalDWARFSection returns content of DWARF section or nil if file has no such section
+func (f *File) optionalDWARFSection(name string) ([]byte, error) {
+	content, err := f.DWARFSection(name)
+	if errors.Is(err, ErrNoDWARFSection) {
+		return nil, nil
+	}
+	return content, err
+}
+
+// dwarfBuffer decodes DWARF data of single section. First error stops decoding, following reads return zero values
+// and the error is kept in err
+type dwarfBuffer struct {
+	section   string
+	data      []byte
+	offset    uint64
+	byteOrder binary.ByteOrder
+	err       error
+}
+
+func (b *dwarfBuffer) fail(format string, args ...interface{}) {
+	if b.err == nil {
+		b.err = fmt.Errorf("%w %v at 0x%x: %v", ErrInvalidDWARF, b.section, b.offset, fmt.Sprintf(format, args...))
+	}
+}
+
+func (b *dwarfBuffer) bytes(size uint64) []byte {
+	if b.err != nil {
+		return nil
+	}
+	if b.offset > uint64(len(b.data)) || size > uint64(len(b.data))-b.offset {
+		b.fail("%v bytes overflow section of %v bytes", size, len(b.data))
+		return nil
+	}
+	content := b.data[b.offset : b.offset+size]
+	b.offset += size
+	return content
+}
+
+func (b *dwarfBuffer) skip(size uint64) {
+	b.bytes(size)
+}
+
+func (b *dwarfBuffer) uint8() uint8 {
+	content := b.bytes(1)
+	if content == nil {
+		return 0
+	}
+	return content[0]
+}
+
+func (b *dwarfBuffer) uint16() uint16 {
+	content := b.bytes(2)
+	if content == nil {
+		return 0
+	}
+	return b.byteOrder.Uint16(content)
+}
+
+func (b *dwarfBuffer) uint32() uint32 {
+	content := b.bytes(4)
+	if content == nil {
+		return 0
+	}
+	return b.byteOrder.Uint32(content)
+}
+
+func (b *dwarfBuffer) uint64() uint64 {
+	content := b.bytes(8)
+	if content == nil {
+		return 0
+	}
+	return b.byteOrder.Uint64(content)
+}
+
+// uint reads unsigned value of 1 to 8 bytes
+func (b *dwarfBuffer) uint(size int) uint64 {
+	if size < 1 || size > 8 {
+		b.fail("unsupported value size %v", size)
+		return 0
+	}
+	content := b.bytes(uint64(size))
+	var value uint64
+	for i := range content {
+		if b.byteOrder == binary.BigEndian {
+			value = value<<8 | uint64(content[i])
+		} else {
+			value |= uint64(content[i]) << (8 * uint(i))
+		}
+	}
+	return value
+}
+
+func (b *dwarfBuffer) uleb() uint64 {
+	if b.err != nil {
+		return 0
+	}
+	value, size := readULEB128(b.data[minUint64(b.offset, uint64(len(b.data))):])
+	if size == 0 {
+		b.fail("truncated ULEB128")
+		return 0
+	}
+	b.offset += uint64(size)
+	return value
+}
+
+func (b *dwarfBuffer) sleb() int64 {
+	if b.err != nil {
+		return 0
+	}
+	var value int64
+	var shift uint
+	for {
+		c := b.uint8()
+		if b.err != nil {
+			return 0
+		}
+		if shift < 64 {
+			value |= int64(c&0x7f) << shift
+		}
+		shift += 7
+		if c&0x80 == 0 {
+			if shift < 64 && c&0x40 != 0 {
+				value |= -1 << shift
+			}
+			return value
+		}
+	}
+}
+
+func (b *dwarfBuffer) cstring() string {
+	if b.err != nil {
+		return ""
+	}
+	rest := b.data[minUint64(b.offset, uint64(len(b.data))):]
+	end := bytes.IndexByte(rest, 0)
+	if end < 0 {
+		b.fail("string is not terminated")
+		return ""
+	}
+	b.offset += uint64(end) + 1
+	return string(rest[:end])
+}
+
+// unitLength reads initial length field, reports 64 bit DWARF format if it's escaped with 0xffffffff
+func (b *dwarfBuffer) unitLength() (uint64, bool) {
+	length := uint64(b.uint32())
+	if length == 0xffffffff {
+		return b.uint64(), true
+	}
+	if length >= 0xfffffff0 {
+		b.fail("reserved unit length 0x%x", length)
+	}
+	return length, false
+}
+
+// sectionOffset reads offset to other section, 8 bytes long in 64 bit DWARF format
+func (b *dwarfBuffer) sectionOffset(dwarf64 bool) uint64 {
+	if dwarf64 {
+		return b.uint64()
+	}
+	return uint64(b.uint32())
+}
+
+// cstringAt returns NUL terminated string at offset of string section like .debug_str
+func cstringAt(section string, content []byte, offset uint64) (string, error) {
+	if offset >= uint64(len(content)) {
+		return "", fmt.Errorf("%w %v string offset 0x%x out of bounds: 0x%x", ErrInvalidDWARF, section, offset, len(content))
+	}
+	end := bytes.IndexByte(content[offset:], 0)
+	if end < 0 {
+		return "", fmt.Errorf("%w %v string at 0x%x is not terminated", ErrInvalidDWARF, section, offset)
+	}
+	return string(content[offset : offset+uint64(end)]), nil
+}
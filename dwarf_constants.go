@@ -0,0 +1,800 @@
+package elf
+
+import "fmt"
+
+// DWARFTag is DW_TAG_* kind of debugging information entry
+type DWARFTag uint64
+
+const (
+	//0x01	DW_TAG_array_type	Array type
+	DWARFTagArrayType DWARFTag = 0x01
+	//0x02	DW_TAG_class_type	Class type
+	DWARFTagClassType DWARFTag = 0x02
+	//0x03	DW_TAG_entry_point	Alternate entry point
+	DWARFTagEntryPoint DWARFTag = 0x03
+	//0x04	DW_TAG_enumeration_type	Enumeration type
+	DWARFTagEnumerationType DWARFTag = 0x04
+	//0x05	DW_TAG_formal_parameter	Formal parameter of subprogram
+	DWARFTagFormalParameter DWARFTag = 0x05
+	//0x08	DW_TAG_imported_declaration	Declaration imported from other scope
+	DWARFTagImportedDeclaration DWARFTag = 0x08
+	//0x0a	DW_TAG_label	Label in source
+	DWARFTagLabel DWARFTag = 0x0a
+	//0x0b	DW_TAG_lexical_block	Lexical block
+	DWARFTagLexicalBlock DWARFTag = 0x0b
+	//0x0d	DW_TAG_member	Member of structure, class or union
+	DWARFTagMember DWARFTag = 0x0d
+	//0x0f	DW_TAG_pointer_type	Pointer type
+	DWARFTagPointerType DWARFTag = 0x0f
+	//0x10	DW_TAG_reference_type	Reference type
+	DWARFTagReferenceType DWARFTag = 0x10
+	//0x11	DW_TAG_compile_unit	Full compilation unit
+	DWARFTagCompileUnit DWARFTag = 0x11
+	//0x12	DW_TAG_string_type	String type
+	DWARFTagStringType DWARFTag = 0x12
+	//0x13	DW_TAG_structure_type	Structure type
+	DWARFTagStructureType DWARFTag = 0x13
+	//0x15	DW_TAG_subroutine_type	Subroutine type
+	DWARFTagSubroutineType DWARFTag = 0x15
+	//0x16	DW_TAG_typedef	Type name alias
+	DWARFTagTypedef DWARFTag = 0x16
+	//0x17	DW_TAG_union_type	Union type
+	DWARFTagUnionType DWARFTag = 0x17
+	//0x18	DW_TAG_unspecified_parameters	Variable argument list
+	DWARFTagUnspecifiedParameters DWARFTag = 0x18
+	//0x19	DW_TAG_variant	Variant of variant record
+	DWARFTagVariant DWARFTag = 0x19
+	//0x1a	DW_TAG_common_block	Fortran common block
+	DWARFTagCommonBlock DWARFTag = 0x1a
+	//0x1b	DW_TAG_common_inclusion	Fortran common block inclusion
+	DWARFTagCommonInclusion DWARFTag = 0x1b
+	//0x1c	DW_TAG_inheritance	Base class of derived class
+	DWARFTagInheritance DWARFTag = 0x1c
+	//0x1d	DW_TAG_inlined_subroutine	Inlined instance of subprogram
+	DWARFTagInlinedSubroutine DWARFTag = 0x1d
+	//0x1e	DW_TAG_module	Module
+	DWARFTagModule DWARFTag = 0x1e
+	//0x1f	DW_TAG_ptr_to_member_type	Pointer to member type
+	DWARFTagPtrToMemberType DWARFTag = 0x1f
+	//0x20	DW_TAG_set_type	Set type
+	DWARFTagSetType DWARFTag = 0x20
+	//0x21	DW_TAG_subrange_type	Subrange type
+	DWARFTagSubrangeType DWARFTag = 0x21
+	//0x22	DW_TAG_with_stmt	Pascal with statement
+	DWARFTagWithStmt DWARFTag = 0x22
+	//0x23	DW_TAG_access_declaration	Access declaration
+	DWARFTagAccessDeclaration DWARFTag = 0x23
+	//0x24	DW_TAG_base_type	Base type
+	DWARFTagBaseType DWARFTag = 0x24
+	//0x25	DW_TAG_catch_block	Exception handler block
+	DWARFTagCatchBlock DWARFTag = 0x25
+	//0x26	DW_TAG_const_type	Const qualified type
+	DWARFTagConstType DWARFTag = 0x26
+	//0x27	DW_TAG_constant	Named constant
+	DWARFTagConstant DWARFTag = 0x27
+	//0x28	DW_TAG_enumerator	Enumeration literal
+	DWARFTagEnumerator DWARFTag = 0x28
+	//0x29	DW_TAG_file_type	Pascal file type
+	DWARFTagFileType DWARFTag = 0x29
+	//0x2a	DW_TAG_friend	Friend declaration
+	DWARFTagFriend DWARFTag = 0x2a
+	//0x2b	DW_TAG_namelist	Fortran namelist
+	DWARFTagNamelist DWARFTag = 0x2b
+	//0x2c	DW_TAG_namelist_item	Fortran namelist item
+	DWARFTagNamelistItem DWARFTag = 0x2c
+	//0x2d	DW_TAG_packed_type	Packed type
+	DWARFTagPackedType DWARFTag = 0x2d
+	//0x2e	DW_TAG_subprogram	Subprogram
+	DWARFTagSubprogram DWARFTag = 0x2e
+	//0x2f	DW_TAG_template_type_parameter	Template type parameter
+	DWARFTagTemplateTypeParameter DWARFTag = 0x2f
+	//0x30	DW_TAG_template_value_parameter	Template value parameter
+	DWARFTagTemplateValueParameter DWARFTag = 0x30
+	//0x31	DW_TAG_thrown_type	Type of thrown exception
+	DWARFTagThrownType DWARFTag = 0x31
+	//0x32	DW_TAG_try_block	Try block
+	DWARFTagTryBlock DWARFTag = 0x32
+	//0x33	DW_TAG_variant_part	Variant part of record
+	DWARFTagVariantPart DWARFTag = 0x33
+	//0x34	DW_TAG_variable	Variable
+	DWARFTagVariable DWARFTag = 0x34
+	//0x35	DW_TAG_volatile_type	Volatile qualified type
+	DWARFTagVolatileType DWARFTag = 0x35
+	//0x36	DW_TAG_dwarf_procedure	DWARF procedure
+	DWARFTagDWARFProcedure DWARFTag = 0x36
+	//0x37	DW_TAG_restrict_type	Restrict qualified type
+	DWARFTagRestrictType DWARFTag = 0x37
+	//0x38	DW_TAG_interface_type	Interface type
+	DWARFTagInterfaceType DWARFTag = 0x38
+	//0x39	DW_TAG_namespace	Namespace
+	DWARFTagNamespace DWARFTag = 0x39
+	//0x3a	DW_TAG_imported_module	Module imported to other scope
+	DWARFTagImportedModule DWARFTag = 0x3a
+	//0x3b	DW_TAG_unspecified_type	Unspecified type
+	DWARFTagUnspecifiedType DWARFTag = 0x3b
+	//0x3c	DW_TAG_partial_unit	Partial compilation unit
+	DWARFTagPartialUnit DWARFTag = 0x3c
+	//0x3d	DW_TAG_imported_unit	Unit imported by partial unit
+	DWARFTagImportedUnit DWARFTag = 0x3d
+	//0x3f	DW_TAG_condition	Fortran condition
+	DWARFTagCondition DWARFTag = 0x3f
+	//0x40	DW_TAG_shared_type	UPC shared type
+	DWARFTagSharedType DWARFTag = 0x40
+	//0x41	DW_TAG_type_unit	Type unit
+	DWARFTagTypeUnit DWARFTag = 0x41
+	//0x42	DW_TAG_rvalue_reference_type	Rvalue reference type
+	DWARFTagRvalueReferenceType DWARFTag = 0x42
+	//0x43	DW_TAG_template_alias	Template alias
+	DWARFTagTemplateAlias DWARFTag = 0x43
+	//0x44	DW_TAG_coarray_type	Fortran coarray type
+	DWARFTagCoarrayType DWARFTag = 0x44
+	//0x45	DW_TAG_generic_subrange	Generic subrange
+	DWARFTagGenericSubrange DWARFTag = 0x45
+	//0x46	DW_TAG_dynamic_type	Dynamic type
+	DWARFTagDynamicType DWARFTag = 0x46
+	//0x47	DW_TAG_atomic_type	Atomic qualified type
+	DWARFTagAtomicType DWARFTag = 0x47
+	//0x48	DW_TAG_call_site	Call site
+	DWARFTagCallSite DWARFTag = 0x48
+	//0x49	DW_TAG_call_site_parameter	Call site parameter
+	DWARFTagCallSiteParameter DWARFTag = 0x49
+	//0x4a	DW_TAG_skeleton_unit	Skeleton unit of split DWARF
+	DWARFTagSkeletonUnit DWARFTag = 0x4a
+	//0x4b	DW_TAG_immutable_type	Immutable qualified type
+	DWARFTagImmutableType DWARFTag = 0x4b
+	//0x4080	DW_TAG_lo_user	Start of user defined tags
+	DWARFTagLowUser DWARFTag = 0x4080
+	//0x4106	DW_TAG_GNU_template_template_param	GNU template template parameter
+	DWARFTagGNUTemplateTemplateParam DWARFTag = 0x4106
+	//0x4107	DW_TAG_GNU_template_parameter_pack	GNU template parameter pack
+	DWARFTagGNUTemplateParameterPack DWARFTag = 0x4107
+	//0x4108	DW_TAG_GNU_formal_parameter_pack	GNU formal parameter pack
+	DWARFTagGNUFormalParameterPack DWARFTag = 0x4108
+	//0x4109	DW_TAG_GNU_call_site	GNU call site, pre DWARF 5
+	DWARFTagGNUCallSite DWARFTag = 0x4109
+	//0x410a	DW_TAG_GNU_call_site_parameter	GNU call site parameter, pre DWARF 5
+	DWARFTagGNUCallSiteParameter DWARFTag = 0x410a
+	//0xffff	DW_TAG_hi_user	End of user defined tags
+	DWARFTagHighUser DWARFTag = 0xffff
+)
+
+var dwarfTagNames = newConstantNames("DWARFTag", []constantName{
+	{0x01, "DW_TAG_array_type", "DWARFTagArrayType", "array_type"},
+	{0x02, "DW_TAG_class_type", "DWARFTagClassType", "class_type"},
+	{0x03, "DW_TAG_entry_point", "DWARFTagEntryPoint", "entry_point"},
+	{0x04, "DW_TAG_enumeration_type", "DWARFTagEnumerationType", "enumeration_type"},
+	{0x05, "DW_TAG_formal_parameter", "DWARFTagFormalParameter", "formal_parameter"},
+	{0x08, "DW_TAG_imported_declaration", "DWARFTagImportedDeclaration", "imported_declaration"},
+	{0x0a, "DW_TAG_label", "DWARFTagLabel", "label"},
+	{0x0b, "DW_TAG_lexical_block", "DWARFTagLexicalBlock", "lexical_block"},
+	{0x0d, "DW_TAG_member", "DWARFTagMember", "member"},
+	{0x0f, "DW_TAG_pointer_type", "DWARFTagPointerType", "pointer_type"},
+	{0x10, "DW_TAG_reference_type", "DWARFTagReferenceType", "reference_type"},
+	{0x11, "DW_TAG_compile_unit", "DWARFTagCompileUnit", "compile_unit"},
+	{0x12, "DW_TAG_string_type", "DWARFTagStringType", "string_type"},
+	{0x13, "DW_TAG_structure_type", "DWARFTagStructureType", "structure_type"},
+	{0x15, "DW_TAG_subroutine_type", "DWARFTagSubroutineType", "subroutine_type"},
+	{0x16, "DW_TAG_typedef", "DWARFTagTypedef", "typedef"},
+	{0x17, "DW_TAG_union_type", "DWARFTagUnionType", "union_type"},
+	{0x18, "DW_TAG_unspecified_parameters", "DWARFTagUnspecifiedParameters", "unspecified_parameters"},
+	{0x19, "DW_TAG_variant", "DWARFTagVariant", "variant"},
+	{0x1a, "DW_TAG_common_block", "DWARFTagCommonBlock", "common_block"},
+	{0x1b, "DW_TAG_common_inclusion", "DWARFTagCommonInclusion", "common_inclusion"},
+	{0x1c, "DW_TAG_inheritance", "DWARFTagInheritance", "inheritance"},
+	{0x1d, "DW_TAG_inlined_subroutine", "DWARFTagInlinedSubroutine", "inlined_subroutine"},
+	{0x1e, "DW_TAG_module", "DWARFTagModule", "module"},
+	{0x1f, "DW_TAG_ptr_to_member_type", "DWARFTagPtrToMemberType", "ptr_to_member_type"},
+	{0x20, "DW_TAG_set_type", "DWARFTagSetType", "set_type"},
+	{0x21, "DW_TAG_subrange_type", "DWARFTagSubrangeType", "subrange_type"},
+	{0x22, "DW_TAG_with_stmt", "DWARFTagWithStmt", "with_stmt"},
+	{0x23, "DW_TAG_access_declaration", "DWARFTagAccessDeclaration", "access_declaration"},
+	{0x24, "DW_TAG_base_type", "DWARFTagBaseType", "base_type"},
+	{0x25, "DW_TAG_catch_block", "DWARFTagCatchBlock", "catch_block"},
+	{0x26, "DW_TAG_const_type", "DWARFTagConstType", "const_type"},
+	{0x27, "DW_TAG_constant", "DWARFTagConstant", "constant"},
+	{0x28, "DW_TAG_enumerator", "DWARFTagEnumerator", "enumerator"},
+	{0x29, "DW_TAG_file_type", "DWARFTagFileType", "file_type"},
+	{0x2a, "DW_TAG_friend", "DWARFTagFriend", "friend"},
+	{0x2b, "DW_TAG_namelist", "DWARFTagNamelist", "namelist"},
+	{0x2c, "DW_TAG_namelist_item", "DWARFTagNamelistItem", "namelist_item"},
+	{0x2d, "DW_TAG_packed_type", "DWARFTagPackedType", "packed_type"},
+	{0x2e, "DW_TAG_subprogram", "DWARFTagSubprogram", "subprogram"},
+	{0x2f, "DW_TAG_template_type_parameter", "DWARFTagTemplateTypeParameter", "template_type_parameter"},
+	{0x30, "DW_TAG_template_value_parameter", "DWARFTagTemplateValueParameter", "template_value_parameter"},
+	{0x31, "DW_TAG_thrown_type", "DWARFTagThrownType", "thrown_type"},
+	{0x32, "DW_TAG_try_block", "DWARFTagTryBlock", "try_block"},
+	{0x33, "DW_TAG_variant_part", "DWARFTagVariantPart", "variant_part"},
+	{0x34, "DW_TAG_variable", "DWARFTagVariable", "variable"},
+	{0x35, "DW_TAG_volatile_type", "DWARFTagVolatileType", "volatile_type"},
+	{0x36, "DW_TAG_dwarf_procedure", "DWARFTagDWARFProcedure", "dwarf_procedure"},
+	{0x37, "DW_TAG_restrict_type", "DWARFTagRestrictType", "restrict_type"},
+	{0x38, "DW_TAG_interface_type", "DWARFTagInterfaceType", "interface_type"},
+	{0x39, "DW_TAG_namespace", "DWARFTagNamespace", "namespace"},
+	{0x3a, "DW_TAG_imported_module", "DWARFTagImportedModule", "imported_module"},
+	{0x3b, "DW_TAG_unspecified_type", "DWARFTagUnspecifiedType", "unspecified_type"},
+	{0x3c, "DW_TAG_partial_unit", "DWARFTagPartialUnit", "partial_unit"},
+	{0x3d, "DW_TAG_imported_unit", "DWARFTagImportedUnit", "imported_unit"},
+	{0x3f, "DW_TAG_condition", "DWARFTagCondition", "condition"},
+	{0x40, "DW_TAG_shared_type", "DWARFTagSharedType", "shared_type"},
+	{0x41, "DW_TAG_type_unit", "DWARFTagTypeUnit", "type_unit"},
+	{0x42, "DW_TAG_rvalue_reference_type", "DWARFTagRvalueReferenceType", "rvalue_reference_type"},
+	{0x43, "DW_TAG_template_alias", "DWARFTagTemplateAlias", "template_alias"},
+	{0x44, "DW_TAG_coarray_type", "DWARFTagCoarrayType", "coarray_type"},
+	{0x45, "DW_TAG_generic_subrange", "DWARFTagGenericSubrange", "generic_subrange"},
+	{0x46, "DW_TAG_dynamic_type", "DWARFTagDynamicType", "dynamic_type"},
+	{0x47, "DW_TAG_atomic_type", "DWARFTagAtomicType", "atomic_type"},
+	{0x48, "DW_TAG_call_site", "DWARFTagCallSite", "call_site"},
+	{0x49, "DW_TAG_call_site_parameter", "DWARFTagCallSiteParameter", "call_site_parameter"},
+	{0x4a, "DW_TAG_skeleton_unit", "DWARFTagSkeletonUnit", "skeleton_unit"},
+	{0x4b, "DW_TAG_immutable_type", "DWARFTagImmutableType", "immutable_type"},
+	{0x4080, "DW_TAG_lo_user", "DWARFTagLowUser", ""},
+	{0x4106, "DW_TAG_GNU_template_template_param", "DWARFTagGNUTemplateTemplateParam", "GNU_template_template_param"},
+	{0x4107, "DW_TAG_GNU_template_parameter_pack", "DWARFTagGNUTemplateParameterPack", "GNU_template_parameter_pack"},
+	{0x4108, "DW_TAG_GNU_formal_parameter_pack", "DWARFTagGNUFormalParameterPack", "GNU_formal_parameter_pack"},
+	{0x4109, "DW_TAG_GNU_call_site", "DWARFTagGNUCallSite", "GNU_call_site"},
+	{0x410a, "DW_TAG_GNU_call_site_parameter", "DWARFTagGNUCallSiteParameter", "GNU_call_site_parameter"},
+	{0xffff, "DW_TAG_hi_user", "DWARFTagHighUser", ""},
+})
+
+func (dt DWARFTag) String() string {
+	if text, ok := dwarfTagNames.text(uint64(dt)); ok {
+		return text
+	}
+	if dt >= DWARFTagLowUser && dt <= DWARFTagHighUser {
+		return fmt.Sprintf("user specific: 0x%X", uint64(dt))
+	}
+	return fmt.Sprintf("unknown: 0x%X", uint64(dt))
+}
+
+func (dt DWARFTag) GoString() string {
+	return dwarfTagNames.goString(uint64(dt))
+}
+
+// ParseDWARFTag resolves specification (DW_TAG_subprogram) or Go (DWARFTagSubprogram) constant name
+func ParseDWARFTag(name string) (DWARFTag, error) {
+	value, err := dwarfTagNames.parse(name)
+	return DWARFTag(value), err
+}
+
+// DWARFAttribute is DW_AT_* name of debugging information entry attribute
+type DWARFAttribute uint64
+
+const (
+	//0x01	DW_AT_sibling	Next sibling DIE
+	DWARFAttrSibling DWARFAttribute = 0x01
+	//0x02	DW_AT_location	Data object location
+	DWARFAttrLocation DWARFAttribute = 0x02
+	//0x03	DW_AT_name	Name in source
+	DWARFAttrName DWARFAttribute = 0x03
+	//0x09	DW_AT_ordering	Array row or column major ordering
+	DWARFAttrOrdering DWARFAttribute = 0x09
+	//0x0b	DW_AT_byte_size	Size in bytes
+	DWARFAttrByteSize DWARFAttribute = 0x0b
+	//0x0c	DW_AT_bit_offset	Bit offset of bit field, DWARF 2 and 3
+	DWARFAttrBitOffset DWARFAttribute = 0x0c
+	//0x0d	DW_AT_bit_size	Size in bits
+	DWARFAttrBitSize DWARFAttribute = 0x0d
+	//0x10	DW_AT_stmt_list	Offset of line table
+	DWARFAttrStmtList DWARFAttribute = 0x10
+	//0x11	DW_AT_low_pc	Lowest code address
+	DWARFAttrLowPC DWARFAttribute = 0x11
+	//0x12	DW_AT_high_pc	Highest code address or size
+	DWARFAttrHighPC DWARFAttribute = 0x12
+	//0x13	DW_AT_language	Source language
+	DWARFAttrLanguage DWARFAttribute = 0x13
+	//0x15	DW_AT_discr	Discriminant of variant part
+	DWARFAttrDiscr DWARFAttribute = 0x15
+	//0x16	DW_AT_discr_value	Discriminant value
+	DWARFAttrDiscrValue DWARFAttribute = 0x16
+	//0x17	DW_AT_visibility	Visibility
+	DWARFAttrVisibility DWARFAttribute = 0x17
+	//0x18	DW_AT_import	Imported entity
+	DWARFAttrImport DWARFAttribute = 0x18
+	//0x19	DW_AT_string_length	String length location
+	DWARFAttrStringLength DWARFAttribute = 0x19
+	//0x1a	DW_AT_common_reference	Common block reference
+	DWARFAttrCommonReference DWARFAttribute = 0x1a
+	//0x1b	DW_AT_comp_dir	Compilation directory
+	DWARFAttrCompDir DWARFAttribute = 0x1b
+	//0x1c	DW_AT_const_value	Constant value
+	DWARFAttrConstValue DWARFAttribute = 0x1c
+	//0x1d	DW_AT_containing_type	Containing type
+	DWARFAttrContainingType DWARFAttribute = 0x1d
+	//0x1e	DW_AT_default_value	Default parameter value
+	DWARFAttrDefaultValue DWARFAttribute = 0x1e
+	//0x20	DW_AT_inline	Inline code
+	DWARFAttrInline DWARFAttribute = 0x20
+	//0x21	DW_AT_is_optional	Optional parameter
+	DWARFAttrIsOptional DWARFAttribute = 0x21
+	//0x22	DW_AT_lower_bound	Lower bound of subrange
+	DWARFAttrLowerBound DWARFAttribute = 0x22
+	//0x25	DW_AT_producer	Compiler identification
+	DWARFAttrProducer DWARFAttribute = 0x25
+	//0x27	DW_AT_prototyped	Prototyped subroutine
+	DWARFAttrPrototyped DWARFAttribute = 0x27
+	//0x2a	DW_AT_return_addr	Return address location
+	DWARFAttrReturnAddr DWARFAttribute = 0x2a
+	//0x2c	DW_AT_start_scope	Start of scope
+	DWARFAttrStartScope DWARFAttribute = 0x2c
+	//0x2e	DW_AT_bit_stride	Array element stride in bits
+	DWARFAttrBitStride DWARFAttribute = 0x2e
+	//0x2f	DW_AT_upper_bound	Upper bound of subrange
+	DWARFAttrUpperBound DWARFAttribute = 0x2f
+	//0x31	DW_AT_abstract_origin	Abstract instance of inlined or out of line entity
+	DWARFAttrAbstractOrigin DWARFAttribute = 0x31
+	//0x32	DW_AT_accessibility	Access of member
+	DWARFAttrAccessibility DWARFAttribute = 0x32
+	//0x33	DW_AT_address_class	Pointer address class
+	DWARFAttrAddressClass DWARFAttribute = 0x33
+	//0x34	DW_AT_artificial	Compiler generated entity
+	DWARFAttrArtificial DWARFAttribute = 0x34
+	//0x35	DW_AT_base_types	Primitive data types of compilation unit
+	DWARFAttrBaseTypes DWARFAttribute = 0x35
+	//0x36	DW_AT_calling_convention	Calling convention
+	DWARFAttrCallingConvention DWARFAttribute = 0x36
+	//0x37	DW_AT_count	Element count of subrange
+	DWARFAttrCount DWARFAttribute = 0x37
+	//0x38	DW_AT_data_member_location	Member location
+	DWARFAttrDataMemberLocation DWARFAttribute = 0x38
+	//0x39	DW_AT_decl_column	Declaration column
+	DWARFAttrDeclColumn DWARFAttribute = 0x39
+	//0x3a	DW_AT_decl_file	Declaration file
+	DWARFAttrDeclFile DWARFAttribute = 0x3a
+	//0x3b	DW_AT_decl_line	Declaration line
+	DWARFAttrDeclLine DWARFAttribute = 0x3b
+	//0x3c	DW_AT_declaration	Incomplete, non-defining declaration
+	DWARFAttrDeclaration DWARFAttribute = 0x3c
+	//0x3d	DW_AT_discr_list	Discriminant list
+	DWARFAttrDiscrList DWARFAttribute = 0x3d
+	//0x3e	DW_AT_encoding	Base type encoding
+	DWARFAttrEncoding DWARFAttribute = 0x3e
+	//0x3f	DW_AT_external	External visibility
+	DWARFAttrExternal DWARFAttribute = 0x3f
+	//0x40	DW_AT_frame_base	Subroutine frame base address
+	DWARFAttrFrameBase DWARFAttribute = 0x40
+	//0x41	DW_AT_friend	Friend relationship
+	DWARFAttrFriend DWARFAttribute = 0x41
+	//0x42	DW_AT_identifier_case	Identifier case rule
+	DWARFAttrIdentifierCase DWARFAttribute = 0x42
+	//0x43	DW_AT_macro_info	Macro information, before DWARF 5
+	DWARFAttrMacroInfo DWARFAttribute = 0x43
+	//0x44	DW_AT_namelist_item	Namelist item
+	DWARFAttrNamelistItem DWARFAttribute = 0x44
+	//0x45	DW_AT_priority	Module priority
+	DWARFAttrPriority DWARFAttribute = 0x45
+	//0x46	DW_AT_segment	Addressing information
+	DWARFAttrSegment DWARFAttribute = 0x46
+	//0x47	DW_AT_specification	Incomplete declaration completed by this entity
+	DWARFAttrSpecification DWARFAttribute = 0x47
+	//0x48	DW_AT_static_link	Location of containing subprogram frame
+	DWARFAttrStaticLink DWARFAttribute = 0x48
+	//0x49	DW_AT_type	Type of declaration
+	DWARFAttrType DWARFAttribute = 0x49
+	//0x4a	DW_AT_use_location	Member location for pointer to member type
+	DWARFAttrUseLocation DWARFAttribute = 0x4a
+	//0x4b	DW_AT_variable_parameter	Non-constant parameter flag
+	DWARFAttrVariableParameter DWARFAttribute = 0x4b
+	//0x4c	DW_AT_virtuality	Virtuality
+	DWARFAttrVirtuality DWARFAttribute = 0x4c
+	//0x4d	DW_AT_vtable_elem_location	Virtual function vtable slot
+	DWARFAttrVTableElemLocation DWARFAttribute = 0x4d
+	//0x4e	DW_AT_allocated	Allocation status
+	DWARFAttrAllocated DWARFAttribute = 0x4e
+	//0x4f	DW_AT_associated	Association status
+	DWARFAttrAssociated DWARFAttribute = 0x4f
+	//0x50	DW_AT_data_location	Data object location
+	DWARFAttrDataLocation DWARFAttribute = 0x50
+	//0x51	DW_AT_byte_stride	Array element stride in bytes
+	DWARFAttrByteStride DWARFAttribute = 0x51
+	//0x52	DW_AT_entry_pc	Entry address of subprogram
+	DWARFAttrEntryPC DWARFAttribute = 0x52
+	//0x53	DW_AT_use_UTF8	Strings are UTF-8
+	DWARFAttrUseUTF8 DWARFAttribute = 0x53
+	//0x54	DW_AT_extension	Previous namespace extension
+	DWARFAttrExtension DWARFAttribute = 0x54
+	//0x55	DW_AT_ranges	Non-contiguous address ranges
+	DWARFAttrRanges DWARFAttribute = 0x55
+	//0x56	DW_AT_trampoline	Target subroutine of trampoline
+	DWARFAttrTrampoline DWARFAttribute = 0x56
+	//0x57	DW_AT_call_column	Column of inlined subroutine call
+	DWARFAttrCallColumn DWARFAttribute = 0x57
+	//0x58	DW_AT_call_file	File of inlined subroutine call
+	DWARFAttrCallFile DWARFAttribute = 0x58
+	//0x59	DW_AT_call_line	Line of inlined subroutine call
+	DWARFAttrCallLine DWARFAttribute = 0x59
+	//0x5a	DW_AT_description	Artificial name or description
+	DWARFAttrDescription DWARFAttribute = 0x5a
+	//0x5b	DW_AT_binary_scale	Binary scale factor of fixed point type
+	DWARFAttrBinaryScale DWARFAttribute = 0x5b
+	//0x5c	DW_AT_decimal_scale	Decimal scale factor
+	DWARFAttrDecimalScale DWARFAttribute = 0x5c
+	//0x5d	DW_AT_small	Scale factor of fixed point type
+	DWARFAttrSmall DWARFAttribute = 0x5d
+	//0x5e	DW_AT_decimal_sign	Decimal sign representation
+	DWARFAttrDecimalSign DWARFAttribute = 0x5e
+	//0x5f	DW_AT_digit_count	Digit count of packed decimal or numeric string type
+	DWARFAttrDigitCount DWARFAttribute = 0x5f
+	//0x60	DW_AT_picture_string	Picture string
+	DWARFAttrPictureString DWARFAttribute = 0x60
+	//0x61	DW_AT_mutable	Mutable member
+	DWARFAttrMutable DWARFAttribute = 0x61
+	//0x62	DW_AT_threads_scaled	Array bound scaled by thread count
+	DWARFAttrThreadsScaled DWARFAttribute = 0x62
+	//0x63	DW_AT_explicit	Explicit property
+	DWARFAttrExplicit DWARFAttribute = 0x63
+	//0x64	DW_AT_object_pointer	Object implicit pointer parameter
+	DWARFAttrObjectPointer DWARFAttribute = 0x64
+	//0x65	DW_AT_endianity	Endianity of data
+	DWARFAttrEndianity DWARFAttribute = 0x65
+	//0x66	DW_AT_elemental	Elemental property
+	DWARFAttrElemental DWARFAttribute = 0x66
+	//0x67	DW_AT_pure	Pure property
+	DWARFAttrPure DWARFAttribute = 0x67
+	//0x68	DW_AT_recursive	Recursive property
+	DWARFAttrRecursive DWARFAttribute = 0x68
+	//0x69	DW_AT_signature	Type signature
+	DWARFAttrSignature DWARFAttribute = 0x69
+	//0x6a	DW_AT_main_subprogram	Main or starting subprogram
+	DWARFAttrMainSubprogram DWARFAttribute = 0x6a
+	//0x6b	DW_AT_data_bit_offset	Member bit offset
+	DWARFAttrDataBitOffset DWARFAttribute = 0x6b
+	//0x6c	DW_AT_const_expr	Compile time constant object
+	DWARFAttrConstExpr DWARFAttribute = 0x6c
+	//0x6d	DW_AT_enum_class	Type safe enumeration
+	DWARFAttrEnumClass DWARFAttribute = 0x6d
+	//0x6e	DW_AT_linkage_name	Object file linkage name
+	DWARFAttrLinkageName DWARFAttribute = 0x6e
+	//0x6f	DW_AT_string_length_bit_size	String length size in bits
+	DWARFAttrStringLengthBitSize DWARFAttribute = 0x6f
+	//0x70	DW_AT_string_length_byte_size	String length size in bytes
+	DWARFAttrStringLengthByteSize DWARFAttribute = 0x70
+	//0x71	DW_AT_rank	Dynamic array rank
+	DWARFAttrRank DWARFAttribute = 0x71
+	//0x72	DW_AT_str_offsets_base	Base of unit contribution to .debug_str_offsets
+	DWARFAttrStrOffsetsBase DWARFAttribute = 0x72
+	//0x73	DW_AT_addr_base	Base of unit contribution to .debug_addr
+	DWARFAttrAddrBase DWARFAttribute = 0x73
+	//0x74	DW_AT_rnglists_base	Base of unit contribution to .debug_rnglists
+	DWARFAttrRngListsBase DWARFAttribute = 0x74
+	//0x76	DW_AT_dwo_name	Name of split DWARF object file
+	DWARFAttrDWOName DWARFAttribute = 0x76
+	//0x77	DW_AT_reference	Reference qualified member function
+	DWARFAttrReference DWARFAttribute = 0x77
+	//0x78	DW_AT_rvalue_reference	Rvalue reference qualified member function
+	DWARFAttrRvalueReference DWARFAttribute = 0x78
+	//0x79	DW_AT_macros	Offset of macro information
+	DWARFAttrMacros DWARFAttribute = 0x79
+	//0x7a	DW_AT_call_all_calls	All tail and normal calls are described
+	DWARFAttrCallAllCalls DWARFAttribute = 0x7a
+	//0x7b	DW_AT_call_all_source_calls	All calls in source are described
+	DWARFAttrCallAllSourceCalls DWARFAttribute = 0x7b
+	//0x7c	DW_AT_call_all_tail_calls	All tail calls are described
+	DWARFAttrCallAllTailCalls DWARFAttribute = 0x7c
+	//0x7d	DW_AT_call_return_pc	Return address after call
+	DWARFAttrCallReturnPC DWARFAttribute = 0x7d
+	//0x7e	DW_AT_call_value	Value of call parameter
+	DWARFAttrCallValue DWARFAttribute = 0x7e
+	//0x7f	DW_AT_call_origin	Subprogram called
+	DWARFAttrCallOrigin DWARFAttribute = 0x7f
+	//0x80	DW_AT_call_parameter	Parameter entry
+	DWARFAttrCallParameter DWARFAttribute = 0x80
+	//0x81	DW_AT_call_pc	Address of call instruction
+	DWARFAttrCallPC DWARFAttribute = 0x81
+	//0x82	DW_AT_call_tail_call	Call is tail call
+	DWARFAttrCallTailCall DWARFAttribute = 0x82
+	//0x83	DW_AT_call_target	Address of called subroutine
+	DWARFAttrCallTarget DWARFAttribute = 0x83
+	//0x84	DW_AT_call_target_clobbered	Address of called subroutine, clobbered
+	DWARFAttrCallTargetClobbered DWARFAttribute = 0x84
+	//0x85	DW_AT_call_data_location	Location of referenced parameter data
+	DWARFAttrCallDataLocation DWARFAttribute = 0x85
+	//0x86	DW_AT_call_data_value	Value of referenced parameter data
+	DWARFAttrCallDataValue DWARFAttribute = 0x86
+	//0x87	DW_AT_noreturn	Subprogram does not return
+	DWARFAttrNoreturn DWARFAttribute = 0x87
+	//0x88	DW_AT_alignment	Non-default alignment of type or entity
+	DWARFAttrAlignment DWARFAttribute = 0x88
+	//0x89	DW_AT_export_symbols	Export symbols to containing scope
+	DWARFAttrExportSymbols DWARFAttribute = 0x89
+	//0x8a	DW_AT_deleted	Deleted member function
+	DWARFAttrDeleted DWARFAttribute = 0x8a
+	//0x8b	DW_AT_defaulted	Defaulted member function
+	DWARFAttrDefaulted DWARFAttribute = 0x8b
+	//0x8c	DW_AT_loclists_base	Base of unit contribution to .debug_loclists
+	DWARFAttrLocListsBase DWARFAttribute = 0x8c
+	//0x2000	DW_AT_lo_user	Start of user defined attributes
+	DWARFAttrLowUser DWARFAttribute = 0x2000
+	//0x2007	DW_AT_MIPS_linkage_name	Linkage name before DWARF 4
+	DWARFAttrMIPSLinkageName DWARFAttribute = 0x2007
+	//0x2116	DW_AT_GNU_all_tail_call_sites	All tail call sites are described
+	DWARFAttrGNUAllTailCallSites DWARFAttribute = 0x2116
+	//0x2117	DW_AT_GNU_all_call_sites	All call sites are described
+	DWARFAttrGNUAllCallSites DWARFAttribute = 0x2117
+	//0x2119	DW_AT_GNU_macros	Offset of GNU macro information
+	DWARFAttrGNUMacros DWARFAttribute = 0x2119
+	//0x211a	DW_AT_GNU_deleted	Deleted member function
+	DWARFAttrGNUDeleted DWARFAttribute = 0x211a
+	//0x2130	DW_AT_GNU_dwo_name	Name of split DWARF object file, pre DWARF 5
+	DWARFAttrGNUDWOName DWARFAttribute = 0x2130
+	//0x2131	DW_AT_GNU_dwo_id	Split DWARF unit identifier, pre DWARF 5
+	DWARFAttrGNUDWOID DWARFAttribute = 0x2131
+	//0x2132	DW_AT_GNU_ranges_base	Base of split unit ranges, pre DWARF 5
+	DWARFAttrGNURangesBase DWARFAttribute = 0x2132
+	//0x2133	DW_AT_GNU_addr_base	Base of unit contribution to .debug_addr, pre DWARF 5
+	DWARFAttrGNUAddrBase DWARFAttribute = 0x2133
+	//0x2134	DW_AT_GNU_pubnames	Unit has .debug_gnu_pubnames entries
+	DWARFAttrGNUPubnames DWARFAttribute = 0x2134
+	//0x2135	DW_AT_GNU_pubtypes	Unit has .debug_gnu_pubtypes entries
+	DWARFAttrGNUPubtypes DWARFAttribute = 0x2135
+	//0x2136	DW_AT_GNU_discriminator	Discriminator of lexical block
+	DWARFAttrGNUDiscriminator DWARFAttribute = 0x2136
+	//0x2137	DW_AT_GNU_locviews	Location view list
+	DWARFAttrGNULocViews DWARFAttribute = 0x2137
+	//0x2138	DW_AT_GNU_entry_view	Location view of entry point
+	DWARFAttrGNUEntryView DWARFAttribute = 0x2138
+	//0x3fff	DW_AT_hi_user	End of user defined attributes
+	DWARFAttrHighUser DWARFAttribute = 0x3fff
+)
+
+var dwarfAttributeNames = newConstantNames("DWARFAttribute", []constantName{
+	{0x01, "DW_AT_sibling", "DWARFAttrSibling", "sibling"},
+	{0x02, "DW_AT_location", "DWARFAttrLocation", "location"},
+	{0x03, "DW_AT_name", "DWARFAttrName", "name"},
+	{0x09, "DW_AT_ordering", "DWARFAttrOrdering", "ordering"},
+	{0x0b, "DW_AT_byte_size", "DWARFAttrByteSize", "byte_size"},
+	{0x0c, "DW_AT_bit_offset", "DWARFAttrBitOffset", "bit_offset"},
+	{0x0d, "DW_AT_bit_size", "DWARFAttrBitSize", "bit_size"},
+	{0x10, "DW_AT_stmt_list", "DWARFAttrStmtList", "stmt_list"},
+	{0x11, "DW_AT_low_pc", "DWARFAttrLowPC", "low_pc"},
+	{0x12, "DW_AT_high_pc", "DWARFAttrHighPC", "high_pc"},
+	{0x13, "DW_AT_language", "DWARFAttrLanguage", "language"},
+	{0x15, "DW_AT_discr", "DWARFAttrDiscr", "discr"},
+	{0x16, "DW_AT_discr_value", "DWARFAttrDiscrValue", "discr_value"},
+	{0x17, "DW_AT_visibility", "DWARFAttrVisibility", "visibility"},
+	{0x18, "DW_AT_import", "DWARFAttrImport", "import"},
+	{0x19, "DW_AT_string_length", "DWARFAttrStringLength", "string_length"},
+	{0x1a, "DW_AT_common_reference", "DWARFAttrCommonReference", "common_reference"},
+	{0x1b, "DW_AT_comp_dir", "DWARFAttrCompDir", "comp_dir"},
+	{0x1c, "DW_AT_const_value", "DWARFAttrConstValue", "const_value"},
+	{0x1d, "DW_AT_containing_type", "DWARFAttrContainingType", "containing_type"},
+	{0x1e, "DW_AT_default_value", "DWARFAttrDefaultValue", "default_value"},
+	{0x20, "DW_AT_inline", "DWARFAttrInline", "inline"},
+	{0x21, "DW_AT_is_optional", "DWARFAttrIsOptional", "is_optional"},
+	{0x22, "DW_AT_lower_bound", "DWARFAttrLowerBound", "lower_bound"},
+	{0x25, "DW_AT_producer", "DWARFAttrProducer", "producer"},
+	{0x27, "DW_AT_prototyped", "DWARFAttrPrototyped", "prototyped"},
+	{0x2a, "DW_AT_return_addr", "DWARFAttrReturnAddr", "return_addr"},
+	{0x2c, "DW_AT_start_scope", "DWARFAttrStartScope", "start_scope"},
+	{0x2e, "DW_AT_bit_stride", "DWARFAttrBitStride", "bit_stride"},
+	{0x2f, "DW_AT_upper_bound", "DWARFAttrUpperBound", "upper_bound"},
+	{0x31, "DW_AT_abstract_origin", "DWARFAttrAbstractOrigin", "abstract_origin"},
+	{0x32, "DW_AT_accessibility", "DWARFAttrAccessibility", "accessibility"},
+	{0x33, "DW_AT_address_class", "DWARFAttrAddressClass", "address_class"},
+	{0x34, "DW_AT_artificial", "DWARFAttrArtificial", "artificial"},
+	{0x35, "DW_AT_base_types", "DWARFAttrBaseTypes", "base_types"},
+	{0x36, "DW_AT_calling_convention", "DWARFAttrCallingConvention", "calling_convention"},
+	{0x37, "DW_AT_count", "DWARFAttrCount", "count"},
+	{0x38, "DW_AT_data_member_location", "DWARFAttrDataMemberLocation", "data_member_location"},
+	{0x39, "DW_AT_decl_column", "DWARFAttrDeclColumn", "decl_column"},
+	{0x3a, "DW_AT_decl_file", "DWARFAttrDeclFile", "decl_file"},
+	{0x3b, "DW_AT_decl_line", "DWARFAttrDeclLine", "decl_line"},
+	{0x3c, "DW_AT_declaration", "DWARFAttrDeclaration", "declaration"},
+	{0x3d, "DW_AT_discr_list", "DWARFAttrDiscrList", "discr_list"},
+	{0x3e, "DW_AT_encoding", "DWARFAttrEncoding", "encoding"},
+	{0x3f, "DW_AT_external", "DWARFAttrExternal", "external"},
+	{0x40, "DW_AT_frame_base", "DWARFAttrFrameBase", "frame_base"},
+	{0x41, "DW_AT_friend", "DWARFAttrFriend", "friend"},
+	{0x42, "DW_AT_identifier_case", "DWARFAttrIdentifierCase", "identifier_case"},
+	{0x43, "DW_AT_macro_info", "DWARFAttrMacroInfo", "macro_info"},
+	{0x44, "DW_AT_namelist_item", "DWARFAttrNamelistItem", "namelist_item"},
+	{0x45, "DW_AT_priority", "DWARFAttrPriority", "priority"},
+	{0x46, "DW_AT_segment", "DWARFAttrSegment", "segment"},
+	{0x47, "DW_AT_specification", "DWARFAttrSpecification", "specification"},
+	{0x48, "DW_AT_static_link", "DWARFAttrStaticLink", "static_link"},
+	{0x49, "DW_AT_type", "DWARFAttrType", "type"},
+	{0x4a, "DW_AT_use_location", "DWARFAttrUseLocation", "use_location"},
+	{0x4b, "DW_AT_variable_parameter", "DWARFAttrVariableParameter", "variable_parameter"},
+	{0x4c, "DW_AT_virtuality", "DWARFAttrVirtuality", "virtuality"},
+	{0x4d, "DW_AT_vtable_elem_location", "DWARFAttrVTableElemLocation", "vtable_elem_location"},
+	{0x4e, "DW_AT_allocated", "DWARFAttrAllocated", "allocated"},
+	{0x4f, "DW_AT_associated", "DWARFAttrAssociated", "associated"},
+	{0x50, "DW_AT_data_location", "DWARFAttrDataLocation", "data_location"},
+	{0x51, "DW_AT_byte_stride", "DWARFAttrByteStride", "byte_stride"},
+	{0x52, "DW_AT_entry_pc", "DWARFAttrEntryPC", "entry_pc"},
+	{0x53, "DW_AT_use_UTF8", "DWARFAttrUseUTF8", "use_UTF8"},
+	{0x54, "DW_AT_extension", "DWARFAttrExtension", "extension"},
+	{0x55, "DW_AT_ranges", "DWARFAttrRanges", "ranges"},
+	{0x56, "DW_AT_trampoline", "DWARFAttrTrampoline", "trampoline"},
+	{0x57, "DW_AT_call_column", "DWARFAttrCallColumn", "call_column"},
+	{0x58, "DW_AT_call_file", "DWARFAttrCallFile", "call_file"},
+	{0x59, "DW_AT_call_line", "DWARFAttrCallLine", "call_line"},
+	{0x5a, "DW_AT_description", "DWARFAttrDescription", "description"},
+	{0x5b, "DW_AT_binary_scale", "DWARFAttrBinaryScale", "binary_scale"},
+	{0x5c, "DW_AT_decimal_scale", "DWARFAttrDecimalScale", "decimal_scale"},
+	{0x5d, "DW_AT_small", "DWARFAttrSmall", "small"},
+	{0x5e, "DW_AT_decimal_sign", "DWARFAttrDecimalSign", "decimal_sign"},
+	{0x5f, "DW_AT_digit_count", "DWARFAttrDigitCount", "digit_count"},
+	{0x60, "DW_AT_picture_string", "DWARFAttrPictureString", "picture_string"},
+	{0x61, "DW_AT_mutable", "DWARFAttrMutable", "mutable"},
+	{0x62, "DW_AT_threads_scaled", "DWARFAttrThreadsScaled", "threads_scaled"},
+	{0x63, "DW_AT_explicit", "DWARFAttrExplicit", "explicit"},
+	{0x64, "DW_AT_object_pointer", "DWARFAttrObjectPointer", "object_pointer"},
+	{0x65, "DW_AT_endianity", "DWARFAttrEndianity", "endianity"},
+	{0x66, "DW_AT_elemental", "DWARFAttrElemental", "elemental"},
+	{0x67, "DW_AT_pure", "DWARFAttrPure", "pure"},
+	{0x68, "DW_AT_recursive", "DWARFAttrRecursive", "recursive"},
+	{0x69, "DW_AT_signature", "DWARFAttrSignature", "signature"},
+	{0x6a, "DW_AT_main_subprogram", "DWARFAttrMainSubprogram", "main_subprogram"},
+	{0x6b, "DW_AT_data_bit_offset", "DWARFAttrDataBitOffset", "data_bit_offset"},
+	{0x6c, "DW_AT_const_expr", "DWARFAttrConstExpr", "const_expr"},
+	{0x6d, "DW_AT_enum_class", "DWARFAttrEnumClass", "enum_class"},
+	{0x6e, "DW_AT_linkage_name", "DWARFAttrLinkageName", "linkage_name"},
+	{0x6f, "DW_AT_string_length_bit_size", "DWARFAttrStringLengthBitSize", "string_length_bit_size"},
+	{0x70, "DW_AT_string_length_byte_size", "DWARFAttrStringLengthByteSize", "string_length_byte_size"},
+	{0x71, "DW_AT_rank", "DWARFAttrRank", "rank"},
+	{0x72, "DW_AT_str_offsets_base", "DWARFAttrStrOffsetsBase", "str_offsets_base"},
+	{0x73, "DW_AT_addr_base", "DWARFAttrAddrBase", "addr_base"},
+	{0x74, "DW_AT_rnglists_base", "DWARFAttrRngListsBase", "rnglists_base"},
+	{0x76, "DW_AT_dwo_name", "DWARFAttrDWOName", "dwo_name"},
+	{0x77, "DW_AT_reference", "DWARFAttrReference", "reference"},
+	{0x78, "DW_AT_rvalue_reference", "DWARFAttrRvalueReference", "rvalue_reference"},
+	{0x79, "DW_AT_macros", "DWARFAttrMacros", "macros"},
+	{0x7a, "DW_AT_call_all_calls", "DWARFAttrCallAllCalls", "call_all_calls"},
+	{0x7b, "DW_AT_call_all_source_calls", "DWARFAttrCallAllSourceCalls", "call_all_source_calls"},
+	{0x7c, "DW_AT_call_all_tail_calls", "DWARFAttrCallAllTailCalls", "call_all_tail_calls"},
+	{0x7d, "DW_AT_call_return_pc", "DWARFAttrCallReturnPC", "call_return_pc"},
+	{0x7e, "DW_AT_call_value", "DWARFAttrCallValue", "call_value"},
+	{0x7f, "DW_AT_call_origin", "DWARFAttrCallOrigin", "call_origin"},
+	{0x80, "DW_AT_call_parameter", "DWARFAttrCallParameter", "call_parameter"},
+	{0x81, "DW_AT_call_pc", "DWARFAttrCallPC", "call_pc"},
+	{0x82, "DW_AT_call_tail_call", "DWARFAttrCallTailCall", "call_tail_call"},
+	{0x83, "DW_AT_call_target", "DWARFAttrCallTarget", "call_target"},
+	{0x84, "DW_AT_call_target_clobbered", "DWARFAttrCallTargetClobbered", "call_target_clobbered"},
+	{0x85, "DW_AT_call_data_location", "DWARFAttrCallDataLocation", "call_data_location"},
+	{0x86, "DW_AT_call_data_value", "DWARFAttrCallDataValue", "call_data_value"},
+	{0x87, "DW_AT_noreturn", "DWARFAttrNoreturn", "noreturn"},
+	{0x88, "DW_AT_alignment", "DWARFAttrAlignment", "alignment"},
+	{0x89, "DW_AT_export_symbols", "DWARFAttrExportSymbols", "export_symbols"},
+	{0x8a, "DW_AT_deleted", "DWARFAttrDeleted", "deleted"},
+	{0x8b, "DW_AT_defaulted", "DWARFAttrDefaulted", "defaulted"},
+	{0x8c, "DW_AT_loclists_base", "DWARFAttrLocListsBase", "loclists_base"},
+	{0x2000, "DW_AT_lo_user", "DWARFAttrLowUser", ""},
+	{0x2007, "DW_AT_MIPS_linkage_name", "DWARFAttrMIPSLinkageName", "MIPS_linkage_name"},
+	{0x2116, "DW_AT_GNU_all_tail_call_sites", "DWARFAttrGNUAllTailCallSites", "GNU_all_tail_call_sites"},
+	{0x2117, "DW_AT_GNU_all_call_sites", "DWARFAttrGNUAllCallSites", "GNU_all_call_sites"},
+	{0x2119, "DW_AT_GNU_macros", "DWARFAttrGNUMacros", "GNU_macros"},
+	{0x211a, "DW_AT_GNU_deleted", "DWARFAttrGNUDeleted", "GNU_deleted"},
+	{0x2130, "DW_AT_GNU_dwo_name", "DWARFAttrGNUDWOName", "GNU_dwo_name"},
+	{0x2131, "DW_AT_GNU_dwo_id", "DWARFAttrGNUDWOID", "GNU_dwo_id"},
+	{0x2132, "DW_AT_GNU_ranges_base", "DWARFAttrGNURangesBase", "GNU_ranges_base"},
+	{0x2133, "DW_AT_GNU_addr_base", "DWARFAttrGNUAddrBase", "GNU_addr_base"},
+	{0x2134, "DW_AT_GNU_pubnames", "DWARFAttrGNUPubnames", "GNU_pubnames"},
+	{0x2135, "DW_AT_GNU_pubtypes", "DWARFAttrGNUPubtypes", "GNU_pubtypes"},
+	{0x2136, "DW_AT_GNU_discriminator", "DWARFAttrGNUDiscriminator", "GNU_discriminator"},
+	{0x2137, "DW_AT_GNU_locviews", "DWARFAttrGNULocViews", "GNU_locviews"},
+	{0x2138, "DW_AT_GNU_entry_view", "DWARFAttrGNUEntryView", "GNU_entry_view"},
+	{0x3fff, "DW_AT_hi_user", "DWARFAttrHighUser", ""},
+})
+
+func (da DWARFAttribute) String() string {
+	if text, ok := dwarfAttributeNames.text(uint64(da)); ok {
+		return text
+	}
+	if da >= DWARFAttrLowUser && da <= DWARFAttrHighUser {
+		return fmt.Sprintf("user specific: 0x%X", uint64(da))
+	}
+	return fmt.Sprintf("unknown: 0x%X", uint64(da))
+}
+
+func (da DWARFAttribute) GoString() string {
+	return dwarfAttributeNames.goString(uint64(da))
+}
+
+// ParseDWARFAttribute resolves specification (DW_AT_name) or Go (DWARFAttrName) constant name
+func ParseDWARFAttribute(name string) (DWARFAttribute, error) {
+	value, err := dwarfAttributeNames.parse(name)
+	return DWARFAttribute(value), err
+}
+
+// DWARFUnitType is DW_UT_* type of unit declared in DWARF 5 unit header
+type DWARFUnitType uint8
+
+const (
+	//0x01	DW_UT_compile	Full compilation unit
+	DWARFUnitCompile DWARFUnitType = 0x01
+	//0x02	DW_UT_type	Type unit
+	DWARFUnitTypeUnit DWARFUnitType = 0x02
+	//0x03	DW_UT_partial	Partial unit imported by other units
+	DWARFUnitPartial DWARFUnitType = 0x03
+	//0x04	DW_UT_skeleton	Skeleton of split compilation unit
+	DWARFUnitSkeleton DWARFUnitType = 0x04
+	//0x05	DW_UT_split_compile	Split compilation unit in .dwo file
+	DWARFUnitSplitCompile DWARFUnitType = 0x05
+	//0x06	DW_UT_split_type	Split type unit in .dwo file
+	DWARFUnitSplitType DWARFUnitType = 0x06
+	//0x80	DW_UT_lo_user	Start of user defined unit types
+	DWARFUnitLowUser DWARFUnitType = 0x80
+	//0xff	DW_UT_hi_user	End of user defined unit types
+	DWARFUnitHighUser DWARFUnitType = 0xff
+)
+
+var dwarfUnitTypeNames = newConstantNames("DWARFUnitType", []constantName{
+	{0x01, "DW_UT_compile", "DWARFUnitCompile", "compile"},
+	{0x02, "DW_UT_type", "DWARFUnitTypeUnit", "type"},
+	{0x03, "DW_UT_partial", "DWARFUnitPartial", "partial"},
+	{0x04, "DW_UT_skeleton", "DWARFUnitSkeleton", "skeleton"},
+	{0x05, "DW_UT_split_compile", "DWARFUnitSplitCompile", "split_compile"},
+	{0x06, "DW_UT_split_type", "DWARFUnitSplitType", "split_type"},
+	{0x80, "DW_UT_lo_user", "DWARFUnitLowUser", ""},
+	{0xff, "DW_UT_hi_user", "DWARFUnitHighUser", ""},
+})
+
+func (dut DWARFUnitType) String() string {
+	if text, ok := dwarfUnitTypeNames.text(uint64(dut)); ok {
+		return text
+	}
+	if dut >= DWARFUnitLowUser {
+		return fmt.Sprintf("user specific: 0x%X", uint8(dut))
+	}
+	return fmt.Sprintf("unknown: 0x%X", uint8(dut))
+}
+
+func (dut DWARFUnitType) GoString() string {
+	return dwarfUnitTypeNames.goString(uint64(dut))
+}
+
+// ParseDWARFUnitType resolves specification (DW_UT_compile) or Go (DWARFUnitCompile) constant name
+func ParseDWARFUnitType(name string) (DWARFUnitType, error) {
+	value, err := dwarfUnitTypeNames.parse(name)
+	return DWARFUnitType(value), err
+}
+
+// DWARFNameIndexAttribute is DW_IDX_* attribute of .debug_names entry
+type DWARFNameIndexAttribute uint64
+
+const (
+	//0x01	DW_IDX_compile_unit	Index of compilation unit in name index
+	DWARFNameIndexCompileUnit DWARFNameIndexAttribute = 0x01
+	//0x02	DW_IDX_type_unit	Index of local or foreign type unit in name index
+	DWARFNameIndexTypeUnit DWARFNameIndexAttribute = 0x02
+	//0x03	DW_IDX_die_offset	Offset of entry relative to its unit
+	DWARFNameIndexDIEOffset DWARFNameIndexAttribute = 0x03
+	//0x04	DW_IDX_parent	Index entry of parent entry
+	DWARFNameIndexParent DWARFNameIndexAttribute = 0x04
+	//0x05	DW_IDX_type_hash	Hash of type entry
+	DWARFNameIndexTypeHash DWARFNameIndexAttribute = 0x05
+	//0x2000	DW_IDX_lo_user	Start of user defined attributes
+	DWARFNameIndexLowUser DWARFNameIndexAttribute = 0x2000
+	//0x3fff	DW_IDX_hi_user	End of user defined attributes
+	DWARFNameIndexHighUser DWARFNameIndexAttribute = 0x3fff
+)
+
+var dwarfNameIndexAttributeNames = newConstantNames("DWARFNameIndexAttribute", []constantName{
+	{0x01, "DW_IDX_compile_unit", "DWARFNameIndexCompileUnit", "compile_unit"},
+	{0x02, "DW_IDX_type_unit", "DWARFNameIndexTypeUnit", "type_unit"},
+	{0x03, "DW_IDX_die_offset", "DWARFNameIndexDIEOffset", "die_offset"},
+	{0x04, "DW_IDX_parent", "DWARFNameIndexParent", "parent"},
+	{0x05, "DW_IDX_type_hash", "DWARFNameIndexTypeHash", "type_hash"},
+	{0x2000, "DW_IDX_lo_user", "DWARFNameIndexLowUser", ""},
+	{0x3fff, "DW_IDX_hi_user", "DWARFNameIndexHighUser", ""},
+})
+
+func (dia DWARFNameIndexAttribute) String() string {
+	if text, ok := dwarfNameIndexAttributeNames.text(uint64(dia)); ok {
+		return text
+	}
+	if dia >= DWARFNameIndexLowUser && dia <= DWARFNameIndexHighUser {
+		return fmt.Sprintf("user specific: 0x%X", uint64(dia))
+	}
+	return fmt.Sprintf("unknown: 0x%X", uint64(dia))
+}
+
+func (dia DWARFNameIndexAttribute) GoString() string {
+	return dwarfNameIndexAttributeNames.goString(uint64(dia))
+}
+
+// ParseDWARFNameIndexAttribute resolves specification (DW_IDX_die_offset) or Go (DWARFNameIndexDIEOffset) constant name
+func ParseDWARFNameIndexAttribute(name string) (DWARFNameIndexAttribute, error) {
+	value, err := dwarfNameIndexAttributeNames.parse(name)
+	return DWARFNameIndexAttribute(value), err
+}
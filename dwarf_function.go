@@ -0,0 +1,206 @@
+package elf
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"path"
+)
+
+var ErrFunctionNotFound = errors.New("no function for address")
+
+// FunctionFrame is subprogram or inlined subroutine containing an address
+type FunctionFrame struct {
+	Entry       *DIE   // DW_TAG_subprogram or DW_TAG_inlined_subroutine
+	Name        string // taken from abstract origin or specification if entry itself has no name
+	LinkageName string // mangled name, empty if not declared
+	Inlined     bool
+	// CallFile, CallLine and CallColumn locate call of inlined subroutine in the enclosing frame
+	CallFile   string
+	CallLine   int
+	CallColumn int
+}
+
+// maxReferenceHops limits chains of DW_AT_abstract_origin and DW_AT_specification references
+const maxReferenceHops = 8
+
+// Constant returns value of constant class attribute, data forms are taken as unsigned
+func (d *DIE) Constant(attribute DWARFAttribute) (int64, bool) {
+	switch value := d.Value(attribute).(type) {
+	case uint64:
+		return int64(value), true
+	case int64:
+		return value, true
+	}
+	return 0, false
+}
+
+// FunctionsForAddress returns frames of code at address, see DWARFData.FunctionsForAddress
+func (f *File) FunctionsForAddress(address MemoryAddress) ([]FunctionFrame, error) {
+	data, err := f.DWARF()
+	if err != nil {
+		return nil, err
+	}
+	return data.FunctionsForAddress(address)
+}
+
+// FunctionsForAddress returns frames of code at address - innermost inlined subroutine first and the subprogram it was
+// inlined into last. Only units whose ranges contain the address are walked, split units of skeleton units are not,
+// see SplitDWARFResolver.FunctionsForAddress
+func (d *DWARFData) FunctionsForAddress(address MemoryAddress) ([]FunctionFrame, error) {
+	return d.functionsForAddress(address, nil)
+}
+
+// functionsForAddress walks units containing address, skeleton units are replaced by their split units if split is set
+func (d *DWARFData) functionsForAddress(address MemoryAddress, split func(*CompilationUnit) (*CompilationUnit, error)) ([]FunctionFrame, error) {
+	for _, unit := range d.units {
+		if unit.entry == nil {
+			continue
+		}
+		ranges, err := unit.entry.Ranges()
+		if err != nil {
+			return nil, fmt.Errorf("unit at 0x%x: %w", unit.Offset, err)
+		}
+		if ranges != nil && !rangesContain(ranges, address) {
+			continue
+		}
+		if split != nil && unit.IsSkeleton() {
+			skeleton := unit
+			if unit, err = split(skeleton); err != nil {
+				return nil, fmt.Errorf("unit at 0x%x: %w", skeleton.Offset, err)
+			}
+		}
+		frames, err := unit.functionsForAddress(address)
+		if err != nil {
+			return nil, fmt.Errorf("unit at 0x%x: %w", unit.Offset, err)
+		}
+		if frames != nil {
+			return frames, nil
+		}
+	}
+	return nil, fmt.Errorf("%w %v", ErrFunctionNotFound, address)
+}
+
+func rangesContain(ranges []AddressRange, address MemoryAddress) bool {
+	for _, addressRange := range ranges {
+		if addressRange.Contains(address) {
+			return true
+		}
+	}
+	return false
+}
+
+// functionsForAddress walks unit entries descending only into scopes containing address
+func (u *CompilationUnit) functionsForAddress(address MemoryAddress) ([]FunctionFrame, error) {
+	reader := u.Entries()
+	var frames []FunctionFrame
+	subprogramDepth := -1
+	for {
+		die, err := reader.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		depth := reader.Depth()
+		if subprogramDepth >= 0 && depth <= subprogramDepth {
+			break
+		}
+		if depth == 0 {
+			continue
+		}
+
+		ranges, err := die.Ranges()
+		if err != nil {
+			return nil, fmt.Errorf("entry at 0x%x: %w", die.Offset, err)
+		}
+		isFunction := die.Tag == DWARFTagSubprogram || die.Tag == DWARFTagInlinedSubroutine
+		// declarations and abstract instances of functions have no ranges, their children describe no code
+		if ranges != nil && !rangesContain(ranges, address) || isFunction && ranges == nil {
+			reader.SkipChildren()
+			continue
+		}
+		if !isFunction {
+			continue
+		}
+		frame, err := u.newFunctionFrame(die)
+		if err != nil {
+			return nil, fmt.Errorf("entry at 0x%x: %w", die.Offset, err)
+		}
+		frames = append(frames, frame)
+		if subprogramDepth < 0 {
+			subprogramDepth = depth
+		}
+	}
+
+	for i, j := 0, len(frames)-1; i < j; i, j = i+1, j-1 {
+		frames[i], frames[j] = frames[j], frames[i]
+	}
+	return frames, nil
+}
+
+func (u *CompilationUnit) newFunctionFrame(die *DIE) (FunctionFrame, error) {
+	frame := FunctionFrame{Entry: die, Inlined: die.Tag == DWARFTagInlinedSubroutine}
+	var err error
+	if frame.Name, frame.LinkageName, err = u.data.functionNames(die); err != nil {
+		return frame, err
+	}
+	if !frame.Inlined {
+		return frame, nil
+	}
+	line, _ := die.Constant(DWARFAttrCallLine)
+	column, _ := die.Constant(DWARFAttrCallColumn)
+	frame.CallLine, frame.CallColumn = int(line), int(column)
+	if index, ok := die.Constant(DWARFAttrCallFile); ok {
+		frame.CallFile, err = u.filePath(uint64(index))
+	}
+	return frame, err
+}
+
+// functionNames finds name and linkage name of function following its abstract origin and specification
+func (d *DWARFData) functionNames(die *DIE) (string, string, error) {
+	var name, linkageName string
+	for hops := 0; hops < maxReferenceHops; hops++ {
+		if name == "" {
+			name = die.Name()
+		}
+		if linkageName == "" {
+			linkageName, _ = die.Value(DWARFAttrLinkageName).(string)
+		}
+		if linkageName == "" {
+			linkageName, _ = die.Value(DWARFAttrMIPSLinkageName).(string)
+		}
+		if name != "" && linkageName != "" {
+			break
+		}
+		origin, ok := die.Value(DWARFAttrAbstractOrigin).(DIEOffset)
+		if !ok {
+			origin, ok = die.Value(DWARFAttrSpecification).(DIEOffset)
+		}
+		if !ok {
+			break
+		}
+		var err error
+		if die, err = d.EntryAt(origin); err != nil {
+			return "", "", err
+		}
+	}
+	return name, linkageName, nil
+}
+
+// filePath returns path of file from unit line table, relative paths are joined with compilation directory
+func (u *CompilationUnit) filePath(index uint64) (string, error) {
+	table, err := u.LineTable()
+	if err != nil || table == nil {
+		return "", err
+	}
+	if index >= uint64(len(table.Files)) {
+		return "", fmt.Errorf("%w file index %v out of bounds: %v", ErrInvalidDWARF, index, len(table.Files))
+	}
+	filePath := table.Files[index].Path()
+	if compDir, ok := u.entry.Value(DWARFAttrCompDir).(string); ok && filePath != "" && !path.IsAbs(filePath) {
+		filePath = path.Join(compDir, filePath)
+	}
+	return filePath, nil
+}
@@ -0,0 +1,629 @@
+package elf
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+)
+
+// DIEOffset is offset of debugging information entry in .debug_info section
+type DIEOffset uint64
+
+// DIE is single debugging information entry
+type DIE struct {
+	Offset     DIEOffset
+	Tag        DWARFTag
+	Children   bool // children follow the entry and end with null entry
+	Attributes []DIEAttribute
+	Unit       *CompilationUnit
+}
+
+// DIEAttribute is decoded attribute of entry. Type of Value depends on form:
+//
+//	MemoryAddress - addr, addrx and its sized variants resolved through .debug_addr
+//	uint64        - data1 to data8, udata, sec_offset, loclistx and rnglistx indexes, ref_sig8 and supplementary file references
+//	int64         - sdata, implicit_const
+//	bool          - flag, flag_present
+//	string        - string, strp, line_strp, strx and its sized variants resolved through .debug_str_offsets
+//	[]byte        - block forms, exprloc, data16
+//	DIEOffset     - references within .debug_info, unit relative ones are converted to section offsets
+type DIEAttribute struct {
+	Attribute DWARFAttribute
+	Form      DWARFForm
+	Value     interface{}
+}
+
+// Value returns value of attribute, nil if entry has no such attribute
+func (d *DIE) Value(attribute DWARFAttribute) interface{} {
+	if attr, ok := d.attribute(attribute); ok {
+		return attr.Value
+	}
+	return nil
+}
+
+// Name returns DW_AT_name of entry, empty if it has none
+func (d *DIE) Name() string {
+	name, _ := d.Value(DWARFAttrName).(string)
+	return name
+}
+
+func (d *DIE) attribute(attribute DWARFAttribute) (DIEAttribute, bool) {
+	for _, attr := range d.Attributes {
+		if attr.Attribute == attribute {
+			return attr, true
+		}
+	}
+	return DIEAttribute{}, false
+}
+
+// dwarfIndex is value of strx or addrx form waiting for unit bases, which may be declared later in the same unit entry
+type dwarfIndex uint64
+
+// CompilationUnit is single unit of .debug_info section
+type CompilationUnit struct {
+	Offset       uint64 // offset of unit header in .debug_info
+	Version      uint16
+	Type         DWARFUnitType // DW_UT_compile for units before DWARF 5 except skeleton and split units of GNU split DWARF
+	AddressSize  uint8
+	DWARF64      bool
+	AbbrevOffset uint64
+	ID           uint64 // DWO id of skeleton and split units, type signature of type units
+	TypeOffset   uint64 // offset of type entry relative to type unit
+	// bases of unit contributions to index sections, declared by unit entry, implied by split unit or taken from its
+	// skeleton. RngListsBase of GNU split DWARF units is DW_AT_GNU_ranges_base applied to ranges of split unit
+	StrOffsetsBase uint64
+	AddrBase       uint64
+	RngListsBase   uint64
+	LocListsBase   uint64
+	BaseAddress    MemoryAddress // DW_AT_low_pc of unit entry, base of range and location lists
+
+	data          *DWARFData
+	abbrevs       abbrevTable
+	entriesOffset uint64
+	end           uint64
+	entry         *DIE
+
+	lineTableOnce sync.Once
+	lineTable     *LineTable
+	lineTableErr  error
+}
+
+// DWARFData is units of .debug_info section together with contents of sections their entries refer to
+type DWARFData struct {
+	file      *File
+	byteOrder binary.ByteOrder
+	sections  dwarfSections
+	units     []*CompilationUnit
+	// skeleton and line sections are set for split units of .dwo file or .dwp package, see File.SplitUnit
+	skeleton     *CompilationUnit
+	lineSections *lineSections
+}
+
+// dwarfSections are contents of DWARF sections used by entries, sections missing in file are nil
+type dwarfSections struct {
+	info       []byte
+	abbrev     []byte
+	str        []byte
+	lineStr    []byte
+	strOffsets []byte
+	addr       []byte
+	ranges     []byte
+	rngLists   []byte
+	loc        []byte
+	locLists   []byte
+}
+
+// abbrevAttribute is attribute specification of abbreviation
+type abbrevAttribute struct {
+	attribute     DWARFAttribute
+	form          DWARFForm
+	implicitConst int64
+}
+
+type abbrev struct {
+	tag        DWARFTag
+	children   bool
+	attributes []abbrevAttribute
+}
+
+// abbrevTable is abbreviations of single .debug_abbrev table by their codes
+type abbrevTable map[uint64]*abbrev
+
+// DWARF decodes unit headers of .debug_info section together with their unit entries. Entries are decoded only when
+// requested through DIEReader. Units are decoded once per File
+func (f *File) DWARF() (*DWARFData, error) {
+	f.dwarfOnce.Do(func() {
+		f.dwarfData, f.dwarfErr = f.readDWARF()
+	})
+	return f.dwarfData, f.dwarfErr
+}
+
+func (f *File) readDWARF() (*DWARFData, error) {
+	data := &DWARFData{file: f, byteOrder: f.ByteOrder()}
+	var err error
+	if data.sections.info, err = f.DWARFSection(".debug_info"); err != nil {
+		return nil, err
+	}
+	if data.sections.abbrev, err = f.DWARFSection(".debug_abbrev"); err != nil {
+		return nil, err
+	}
+	for name, content := range map[string]*[]byte{
+		".debug_str":         &data.sections.str,
+		".debug_line_str":    &data.sections.lineStr,
+		".debug_str_offsets": &data.sections.strOffsets,
+		".debug_addr":        &data.sections.addr,
+		".debug_ranges":      &data.sections.ranges,
+		".debug_rnglists":    &data.sections.rngLists,
+		".debug_loc":         &data.sections.loc,
+		".debug_loclists":    &data.sections.locLists,
+	} {
+		if *content, err = f.optionalDWARFSection(name); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := data.readUnits(); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (d *DWARFData) readUnits() error {
+	abbrevs := map[uint64]abbrevTable{}
+	for offset := uint64(0); offset < uint64(len(d.sections.info)); {
+		unit, err := d.readUnit(offset, abbrevs)
+		if err != nil {
+			return err
+		}
+		d.units = append(d.units, unit)
+		offset = unit.end
+	}
+	return nil
+}
+
+// Units returns all units of .debug_info in section order
+func (d *DWARFData) Units() []*CompilationUnit {
+	return append([]*CompilationUnit(nil), d.units...)
+}
+
+// UnitAt returns unit containing given offset of .debug_info, nil if there is none
+func (d *DWARFData) UnitAt(offset uint64) *CompilationUnit {
+	index := sort.Search(len(d.units), func(i int) bool {
+		return d.units[i].end > offset
+	})
+	if index == len(d.units) || d.units[index].Offset > offset {
+		return nil
+	}
+	return d.units[index]
+}
+
+// EntryAt decodes entry at given offset of .debug_info
+func (d *DWARFData) EntryAt(offset DIEOffset) (*DIE, error) {
+	unit := d.UnitAt(uint64(offset))
+	if unit == nil || uint64(offset) < unit.entriesOffset {
+		return nil, fmt.Errorf("%w .debug_info offset 0x%x is not within unit entries", ErrInvalidDWARF, offset)
+	}
+	buf := unit.newBuffer(uint64(offset))
+	die, err := unit.readEntry(buf)
+	if err != nil {
+		return nil, err
+	}
+	if die == nil {
+		return nil, fmt.Errorf("%w .debug_info offset 0x%x is null entry", ErrInvalidDWARF, offset)
+	}
+	if err := unit.resolveEntry(die); err != nil {
+		return nil, err
+	}
+	return die, nil
+}
+
+func (d *DWARFData) readUnit(offset uint64, abbrevs map[uint64]abbrevTable) (*CompilationUnit, error) {
+	buf := &dwarfBuffer{section: ".debug_info", data: d.sections.info, offset: offset, byteOrder: d.byteOrder}
+	unit := &CompilationUnit{Offset: offset, Type: DWARFUnitCompile, data: d}
+
+	length, dwarf64 := buf.unitLength()
+	if buf.err != nil {
+		return nil, buf.err
+	}
+	if length > uint64(len(buf.data))-buf.offset {
+		return nil, fmt.Errorf("%w .debug_info unit at 0x%x length 0x%x overflows section", ErrInvalidDWARF, offset, length)
+	}
+	unit.DWARF64 = dwarf64
+	unit.end = buf.offset + length
+	buf.data = d.sections.info[:unit.end]
+
+	unit.Version = buf.uint16()
+	if buf.err == nil && (unit.Version < 2 || unit.Version > 5) {
+		return nil, fmt.Errorf("%w .debug_info unit at 0x%x has unsupported version %v", ErrInvalidDWARF, offset, unit.Version)
+	}
+	if unit.Version >= 5 {
+		unit.Type = DWARFUnitType(buf.uint8())
+		unit.AddressSize = buf.uint8()
+		unit.AbbrevOffset = buf.sectionOffset(dwarf64)
+		switch unit.Type {
+		case DWARFUnitSkeleton, DWARFUnitSplitCompile:
+			unit.ID = buf.uint64()
+		case DWARFUnitTypeUnit, DWARFUnitSplitType:
+			unit.ID = buf.uint64()
+			unit.TypeOffset = buf.sectionOffset(dwarf64)
+		}
+	} else {
+		unit.AbbrevOffset = buf.sectionOffset(dwarf64)
+		unit.AddressSize = buf.uint8()
+	}
+	if buf.err != nil {
+		return nil, buf.err
+	}
+	if unit.AddressSize == 0 || unit.AddressSize > 8 {
+		return nil, fmt.Errorf("%w .debug_info unit at 0x%x has unsupported address size %v", ErrInvalidDWARF, offset, unit.AddressSize)
+	}
+
+	table, ok := abbrevs[unit.AbbrevOffset]
+	if !ok {
+		var err error
+		if table, err = d.readAbbrevTable(unit.AbbrevOffset); err != nil {
+			return nil, err
+		}
+		abbrevs[unit.AbbrevOffset] = table
+	}
+	unit.abbrevs = table
+	unit.entriesOffset = buf.offset
+
+	entry, err := unit.readEntry(buf)
+	if err != nil {
+		return nil, err
+	}
+	if entry != nil {
+		// GNU split DWARF declares DWO id of units before DWARF 5 in unit entry
+		if id, ok := entry.Value(DWARFAttrGNUDWOID).(uint64); ok && unit.Version < 5 {
+			unit.ID = id
+			unit.Type = DWARFUnitSkeleton
+			if d.skeleton != nil {
+				unit.Type = DWARFUnitSplitCompile
+			}
+		}
+		unit.setBases(entry)
+		if err := unit.resolveEntry(entry); err != nil {
+			return nil, err
+		}
+		var ok bool
+		if unit.BaseAddress, ok = entry.Value(DWARFAttrLowPC).(MemoryAddress); !ok && d.skeleton != nil {
+			unit.BaseAddress = d.skeleton.BaseAddress
+		}
+	}
+	unit.entry = entry
+	return unit, nil
+}
+
+func (d *DWARFData) readAbbrevTable(offset uint64) (abbrevTable, error) {
+	buf := &dwarfBuffer{section: ".debug_abbrev", data: d.sections.abbrev, offset: offset, byteOrder: d.byteOrder}
+	table := abbrevTable{}
+	for {
+		code := buf.uleb()
+		if code == 0 || buf.err != nil {
+			return table, buf.err
+		}
+		entry := &abbrev{tag: DWARFTag(buf.uleb()), children: buf.uint8() != 0}
+		for buf.err == nil {
+			spec := abbrevAttribute{attribute: DWARFAttribute(buf.uleb()), form: DWARFForm(buf.uleb())}
+			if spec.attribute == 0 && spec.form == 0 {
+				break
+			}
+			if spec.form == DWARFFormImplicitConst {
+				spec.implicitConst = buf.sleb()
+			}
+			entry.attributes = append(entry.attributes, spec)
+		}
+		if _, ok := table[code]; ok && buf.err == nil {
+			buf.fail("duplicate abbreviation code %v", code)
+		}
+		table[code] = entry
+	}
+}
+
+// setBases takes bases of index sections from unit entry, split units without them use the first entry after header
+// and addresses of their skeleton
+func (u *CompilationUnit) setBases(entry *DIE) {
+	split := u.Version >= 5 && (u.Type == DWARFUnitSplitCompile || u.Type == DWARFUnitSplitType)
+	offsetSize := uint64(u.offsetSize())
+	base := func(attributes []DWARFAttribute, headerSize uint64) uint64 {
+		for _, attribute := range attributes {
+			if value, ok := entry.Value(attribute).(uint64); ok {
+				return value
+			}
+		}
+		if split {
+			return headerSize
+		}
+		return 0
+	}
+	// header of .debug_str_offsets is length and version with padding, lists have also address, segment and offset count
+	u.StrOffsetsBase = base([]DWARFAttribute{DWARFAttrStrOffsetsBase}, 2*offsetSize)
+	u.AddrBase = base([]DWARFAttribute{DWARFAttrAddrBase, DWARFAttrGNUAddrBase}, 0)
+	u.RngListsBase = base([]DWARFAttribute{DWARFAttrRngListsBase, DWARFAttrGNURangesBase}, offsetSize+8)
+	u.LocListsBase = base([]DWARFAttribute{DWARFAttrLocListsBase}, offsetSize+8)
+	if skeleton := u.data.skeleton; skeleton != nil {
+		u.AddrBase = skeleton.AddrBase
+		// GNU split units refer to .debug_ranges of skeleton file
+		if u.Version < 5 {
+			u.RngListsBase = skeleton.RngListsBase
+		}
+	}
+}
+
+// Entry returns unit entry like DW_TAG_compile_unit, nil if unit has no entries
+func (u *CompilationUnit) Entry() *DIE {
+	return u.entry
+}
+
+// Entries returns reader of unit entries starting with unit entry
+func (u *CompilationUnit) Entries() *DIEReader {
+	return &DIEReader{unit: u, buf: u.newBuffer(u.entriesOffset)}
+}
+
+// EntriesAt returns reader starting with entry at given offset of .debug_info, depths are relative to that entry
+func (u *CompilationUnit) EntriesAt(offset DIEOffset) *DIEReader {
+	buf := u.newBuffer(uint64(offset))
+	if uint64(offset) < u.entriesOffset || uint64(offset) > u.end {
+		buf.fail("offset 0x%x is not within entries of unit at 0x%x", offset, u.Offset)
+	}
+	return &DIEReader{unit: u, buf: buf}
+}
+
+// LineTable returns line table referenced by DW_AT_stmt_list of unit entry, nil if unit has none. Split compilation
+// units use line table of their skeleton
+func (u *CompilationUnit) LineTable() (*LineTable, error) {
+	u.lineTableOnce.Do(func() {
+		if u.entry == nil {
+			return
+		}
+		offset, ok := u.entry.Value(DWARFAttrStmtList).(uint64)
+		switch {
+		case ok && u.data.lineSections != nil:
+			// split type units refer to file tables of .debug_line.dwo
+			u.lineTable, _, u.lineTableErr = u.data.file.readLineTable(*u.data.lineSections, offset)
+		case ok:
+			u.lineTable, u.lineTableErr = u.data.file.LineTableAt(offset)
+		case u.data.skeleton != nil && u.Type == DWARFUnitSplitCompile:
+			u.lineTable, u.lineTableErr = u.data.skeleton.LineTable()
+		}
+	})
+	return u.lineTable, u.lineTableErr
+}
+
+func (u *CompilationUnit) newBuffer(offset uint64) *dwarfBuffer {
+	return &dwarfBuffer{section: ".debug_info", data: u.data.sections.info[:u.end], offset: offset, byteOrder: u.data.byteOrder}
+}
+
+// offsetSize is size of section offsets, 8 bytes in 64 bit DWARF format
+func (u *CompilationUnit) offsetSize() int {
+	if u.DWARF64 {
+		return 8
+	}
+	return 4
+}
+
+// readEntry decodes entry at buffer offset without resolving index forms, returns nil for null entry
+func (u *CompilationUnit) readEntry(buf *dwarfBuffer) (*DIE, error) {
+	offset := buf.offset
+	code := buf.uleb()
+	if code == 0 || buf.err != nil {
+		return nil, buf.err
+	}
+	entry, ok := u.abbrevs[code]
+	if !ok {
+		buf.fail("unknown abbreviation code %v", code)
+		return nil, buf.err
+	}
+	die := &DIE{
+		Offset:     DIEOffset(offset),
+		Tag:        entry.tag,
+		Children:   entry.children,
+		Attributes: make([]DIEAttribute, 0, len(entry.attributes)),
+		Unit:       u,
+	}
+	for _, spec := range entry.attributes {
+		die.Attributes = append(die.Attributes, u.readAttribute(buf, spec))
+	}
+	if buf.err != nil {
+		return nil, buf.err
+	}
+	return die, nil
+}
+
+var dwarfFormSizes = map[DWARFForm]int{
+	DWARFFormData1: 1, DWARFFormData2: 2, DWARFFormData4: 4, DWARFFormData8: 8,
+	DWARFFormRef1: 1, DWARFFormRef2: 2, DWARFFormRef4: 4, DWARFFormRef8: 8,
+	DWARFFormStrx1: 1, DWARFFormStrx2: 2, DWARFFormStrx3: 3, DWARFFormStrx4: 4,
+	DWARFFormAddrx1: 1, DWARFFormAddrx2: 2, DWARFFormAddrx3: 3, DWARFFormAddrx4: 4,
+	DWARFFormBlock1: 1, DWARFFormBlock2: 2, DWARFFormBlock4: 4,
+	DWARFFormRefSup4: 4, DWARFFormRefSup8: 8,
+}
+
+func (u *CompilationUnit) readAttribute(buf *dwarfBuffer, spec abbrevAttribute) DIEAttribute {
+	attr := DIEAttribute{Attribute: spec.attribute, Form: spec.form}
+	for attr.Form == DWARFFormIndirect && buf.err == nil {
+		attr.Form = DWARFForm(buf.uleb())
+	}
+	dwarf64 := u.DWARF64
+	readString := func(section string, content []byte) string {
+		offset := buf.sectionOffset(dwarf64)
+		if buf.err != nil {
+			return ""
+		}
+		value, err := cstringAt(section, content, offset)
+		if err != nil && buf.err == nil {
+			buf.err = err
+		}
+		return value
+	}
+
+	switch form := attr.Form; form {
+	case DWARFFormAddr:
+		attr.Value = MemoryAddress(buf.uint(int(u.AddressSize)))
+	case DWARFFormAddrx, DWARFFormGNUAddrIndex, DWARFFormStrx, DWARFFormGNUStrIndex:
+		attr.Value = dwarfIndex(buf.uleb())
+	case DWARFFormAddrx1, DWARFFormAddrx2, DWARFFormAddrx3, DWARFFormAddrx4,
+		DWARFFormStrx1, DWARFFormStrx2, DWARFFormStrx3, DWARFFormStrx4:
+		attr.Value = dwarfIndex(buf.uint(dwarfFormSizes[form]))
+	case DWARFFormData1, DWARFFormData2, DWARFFormData4, DWARFFormData8, DWARFFormRefSup4, DWARFFormRefSup8:
+		attr.Value = buf.uint(dwarfFormSizes[form])
+	case DWARFFormUData, DWARFFormLocListx, DWARFFormRngListx:
+		attr.Value = buf.uleb()
+	case DWARFFormSData:
+		attr.Value = buf.sleb()
+	case DWARFFormImplicitConst:
+		attr.Value = spec.implicitConst
+	case DWARFFormData16:
+		attr.Value = buf.bytes(16)
+	case DWARFFormFlag:
+		attr.Value = buf.uint8() != 0
+	case DWARFFormFlagPresent:
+		attr.Value = true
+	case DWARFFormString:
+		attr.Value = buf.cstring()
+	case DWARFFormStrp:
+		attr.Value = readString(".debug_str", u.data.sections.str)
+	case DWARFFormLineStrp:
+		attr.Value = readString(".debug_line_str", u.data.sections.lineStr)
+	case DWARFFormSecOffset, DWARFFormStrpSup, DWARFFormGNUStrpAlt, DWARFFormGNURefAlt:
+		attr.Value = buf.sectionOffset(dwarf64)
+	case DWARFFormBlock1, DWARFFormBlock2, DWARFFormBlock4:
+		attr.Value = buf.bytes(buf.uint(dwarfFormSizes[form]))
+	case DWARFFormBlock, DWARFFormExprLoc:
+		attr.Value = buf.bytes(buf.uleb())
+	case DWARFFormRef1, DWARFFormRef2, DWARFFormRef4, DWARFFormRef8:
+		attr.Value = DIEOffset(u.Offset + buf.uint(dwarfFormSizes[form]))
+	case DWARFFormRefUData:
+		attr.Value = DIEOffset(u.Offset + buf.uleb())
+	case DWARFFormRefAddr:
+		// DWARF 2 declared reference to other unit as address sized
+		if u.Version == 2 {
+			attr.Value = DIEOffset(buf.uint(int(u.AddressSize)))
+		} else {
+			attr.Value = DIEOffset(buf.sectionOffset(dwarf64))
+		}
+	case DWARFFormRefSig8:
+		attr.Value = buf.uint64()
+	default:
+		buf.fail("unsupported form %v of attribute %v", form, spec.attribute)
+	}
+	return attr
+}
+
+// resolveEntry replaces strx and addrx indexes with values from .debug_str_offsets and .debug_addr
+func (u *CompilationUnit) resolveEntry(die *DIE) error {
+	for i, attr := range die.Attributes {
+		index, ok := attr.Value.(dwarfIndex)
+		if !ok {
+			continue
+		}
+		var err error
+		switch attr.Form {
+		case DWARFFormStrx, DWARFFormStrx1, DWARFFormStrx2, DWARFFormStrx3, DWARFFormStrx4, DWARFFormGNUStrIndex:
+			die.Attributes[i].Value, err = u.indexedString(uint64(index))
+		default:
+			die.Attributes[i].Value, err = u.indexedAddress(uint64(index))
+		}
+		if err != nil {
+			return fmt.Errorf("entry at 0x%x attribute %v: %w", die.Offset, attr.Attribute, err)
+		}
+	}
+	return nil
+}
+
+func (u *CompilationUnit) indexedString(index uint64) (string, error) {
+	size := u.offsetSize()
+	offset, err := u.data.indexedValue(".debug_str_offsets", u.data.sections.strOffsets, u.StrOffsetsBase, index, size)
+	if err != nil {
+		return "", err
+	}
+	return cstringAt(".debug_str", u.data.sections.str, offset)
+}
+
+func (u *CompilationUnit) indexedAddress(index uint64) (MemoryAddress, error) {
+	address, err := u.data.indexedValue(".debug_addr", u.data.sections.addr, u.AddrBase, index, int(u.AddressSize))
+	return MemoryAddress(address), err
+}
+
+// indexedValue reads entry of given size at index of array starting at base of section
+func (d *DWARFData) indexedValue(section string, content []byte, base, index uint64, size int) (uint64, error) {
+	if content == nil {
+		return 0, fmt.Errorf("%w: %v", ErrNoDWARFSection, section)
+	}
+	if base > uint64(len(content)) || index >= (uint64(len(content))-base)/uint64(size) {
+		return 0, fmt.Errorf("%w %v index %v with base 0x%x out of bounds: 0x%x", ErrInvalidDWARF, section, index, base, len(content))
+	}
+	buf := &dwarfBuffer{section: section, data: content, offset: base + index*uint64(size), byteOrder: d.byteOrder}
+	value := buf.uint(size)
+	return value, buf.err
+}
+
+// DIEReader walks entries of single unit in depth-first order, entries are decoded only when requested
+type DIEReader struct {
+	unit      *CompilationUnit
+	buf       *dwarfBuffer
+	depth     int // depth of the next entry
+	last      *DIE
+	lastDepth int
+}
+
+// Next returns next entry of unit or io.EOF at its end. Null entries closing lists of children are skipped, nesting
+// of returned entry is reported by Depth
+func (r *DIEReader) Next() (*DIE, error) {
+	for r.buf.err == nil && r.buf.offset < uint64(len(r.buf.data)) {
+		die, err := r.unit.readEntry(r.buf)
+		if err != nil {
+			return nil, err
+		}
+		if die == nil {
+			if r.depth > 0 {
+				r.depth--
+			}
+			continue
+		}
+		if err := r.unit.resolveEntry(die); err != nil {
+			return nil, err
+		}
+		r.last, r.lastDepth = die, r.depth
+		if die.Children {
+			r.depth++
+		}
+		return die, nil
+	}
+	if r.buf.err != nil {
+		return nil, r.buf.err
+	}
+	return nil, io.EOF
+}
+
+// Depth returns nesting level of entry returned by the last Next call, the first entry read has depth 0
+func (r *DIEReader) Depth() int {
+	return r.lastDepth
+}
+
+// SkipChildren makes the next Next call return sibling of entry returned by the last Next call, DW_AT_sibling is
+// followed if present, otherwise children are decoded and dropped
+func (r *DIEReader) SkipChildren() {
+	last := r.last
+	if last == nil || !last.Children {
+		return
+	}
+	r.last = nil
+	if sibling, ok := last.Value(DWARFAttrSibling).(DIEOffset); ok && sibling > last.Offset && uint64(sibling) <= r.unit.end {
+		r.buf.offset = uint64(sibling)
+		r.depth = r.lastDepth
+		return
+	}
+	for r.depth > r.lastDepth && r.buf.err == nil && r.buf.offset < uint64(len(r.buf.data)) {
+		die, _ := r.unit.readEntry(r.buf)
+		switch {
+		case die == nil:
+			r.depth--
+		case die.Children:
+			r.depth++
+		}
+	}
+}
@@ -0,0 +1,365 @@
+package elf
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDWARFUnits(t *testing.T) {
+	tcs := []struct {
+		filename string
+		version  uint16
+		entries  int
+	}{
+		{"inline_linux_amd64", 5, 70},
+		{"inline_dwarf4_linux_amd64", 4, 70},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.filename, func(t *testing.T) {
+			file, err := Open(filepath.Join("testdata", tc.filename))
+			assert.NoError(t, err)
+			defer file.Close()
+
+			data, err := file.DWARF()
+			assert.NoError(t, err)
+			units := data.Units()
+			assert.Len(t, units, 1)
+			unit := units[0]
+			assert.Equal(t, tc.version, unit.Version)
+			assert.Equal(t, DWARFUnitCompile, unit.Type)
+			assert.Equal(t, uint8(8), unit.AddressSize)
+			assert.False(t, unit.DWARF64)
+			assert.Same(t, unit, data.UnitAt(unit.Offset+0x100))
+			assert.Nil(t, data.UnitAt(1<<20))
+
+			entry := unit.Entry()
+			assert.Equal(t, DWARFTagCompileUnit, entry.Tag)
+			assert.Equal(t, "inline.c", entry.Name())
+			assert.Equal(t, "/root/module/testdata/src", entry.Value(DWARFAttrCompDir))
+			assert.Nil(t, entry.Value(DWARFAttrLocation))
+			// compare with llvm-dwarfdump --debug-info
+			ranges, err := entry.Ranges()
+			assert.NoError(t, err)
+			assert.Equal(t, []AddressRange{{0x1180, 0x11c4}, {0x1060, 0x108f}}, ranges)
+
+			table, err := unit.LineTable()
+			assert.NoError(t, err)
+			assert.Equal(t, tc.version, table.Version)
+
+			reader := unit.Entries()
+			count := 0
+			var compute *DIE
+			for {
+				die, err := reader.Next()
+				if err == io.EOF {
+					break
+				}
+				if !assert.NoError(t, err) {
+					return
+				}
+				count++
+				if die.Tag == DWARFTagSubprogram && die.Name() == "compute" {
+					compute = die
+					assert.Equal(t, 1, reader.Depth())
+				}
+			}
+			assert.Equal(t, tc.entries, count)
+
+			same, err := data.EntryAt(compute.Offset)
+			assert.NoError(t, err)
+			assert.Equal(t, compute, same)
+			ranges, err = compute.Ranges()
+			assert.NoError(t, err)
+			assert.Equal(t, []AddressRange{{0x1180, 0x11c4}}, ranges)
+			line, ok := compute.Constant(DWARFAttrDeclLine)
+			assert.True(t, ok)
+			assert.Equal(t, int64(17), line)
+		})
+	}
+}
+
+func TestDIEReaderSkipChildren(t *testing.T) {
+	file, err := Open(filepath.Join("testdata", "inline_linux_amd64"))
+	assert.NoError(t, err)
+	defer file.Close()
+	data, err := file.DWARF()
+	assert.NoError(t, err)
+
+	// top level entries only, both with and without DW_AT_sibling
+	reader := data.Units()[0].Entries()
+	var names []string
+	for {
+		die, err := reader.Next()
+		if err == io.EOF {
+			break
+		}
+		assert.NoError(t, err)
+		if reader.Depth() == 1 && die.Tag == DWARFTagSubprogram {
+			names = append(names, die.Name())
+		}
+		if reader.Depth() > 0 {
+			assert.Equal(t, 1, reader.Depth(), "entry at 0x%x", die.Offset)
+			reader.SkipChildren()
+		}
+	}
+	assert.Equal(t, []string{"strtol", "printf", "main", "compute", "sum_of_squares", "square", "atoi"}, names)
+}
+
+func TestDWARFLocations(t *testing.T) {
+	for _, filename := range []string{"inline_linux_amd64", "inline_dwarf4_linux_amd64"} {
+		file, err := Open(filepath.Join("testdata", filename))
+		assert.NoError(t, err)
+		defer file.Close()
+		data, err := file.DWARF()
+		assert.NoError(t, err)
+
+		reader := data.Units()[0].Entries()
+		parameters := map[string][]LocationEntry{}
+		for {
+			die, err := reader.Next()
+			if err == io.EOF {
+				break
+			}
+			assert.NoError(t, err)
+			if die.Tag == DWARFTagSubprogram && die.Name() != "compute" {
+				reader.SkipChildren()
+			}
+			if die.Tag == DWARFTagFormalParameter && die.Name() != "" {
+				parameters[die.Name()], err = die.Locations(DWARFAttrLocation)
+				assert.NoError(t, err)
+			}
+		}
+
+		// compare with llvm-dwarfdump --debug-info
+		assert.Equal(t, []LocationEntry{{Default: true, Expression: []byte{0x55}}}, parameters["a"], filename)
+		b := parameters["b"]
+		assert.Len(t, b, 3, filename)
+		assert.Equal(t, LocationEntry{AddressRange: AddressRange{0x1180, 0x1194}, Expression: []byte{0x54}}, b[0], filename)
+		assert.Equal(t, AddressRange{0x1194, 0x119c}, b[1].AddressRange, filename)
+		assert.Equal(t, AddressRange{0x119c, 0x11c4}, b[2].AddressRange, filename)
+	}
+}
+
+func TestNoDWARF(t *testing.T) {
+	file, err := Open(filepath.Join("testdata", "libsample_minidebuginfo.so"))
+	assert.NoError(t, err)
+	defer file.Close()
+
+	_, err = file.DWARF()
+	assert.True(t, errors.Is(err, ErrNoDWARFSection))
+	_, err = file.FunctionsForAddress(0x789)
+	assert.True(t, errors.Is(err, ErrNoDWARFSection))
+}
+
+// dwarf64Writer writes little endian values of synthetic 64 bit DWARF sections
+type dwarf64Writer struct {
+	bytes.Buffer
+}
+
+// uleb128 is value written as ULEB128
+type uleb128 uint64
+
+func (w *dwarf64Writer) values(values ...interface{}) *dwarf64Writer {
+	for _, value := range values {
+		if number, ok := value.(uleb128); ok {
+			for ; number >= 0x80; number >>= 7 {
+				w.WriteByte(byte(number) | 0x80)
+			}
+			w.WriteByte(byte(number))
+			continue
+		}
+		binary.Write(w, binary.LittleEndian, value)
+	}
+	return w
+}
+
+// header writes 64 bit initial length of content following the header and its version
+func (w *dwarf64Writer) header(content []byte, version uint16, rest ...interface{}) []byte {
+	var body dwarf64Writer
+	body.values(version).values(rest...).Write(content)
+	w.values(uint32(0xffffffff), uint64(body.Len()))
+	w.Write(body.Bytes())
+	return w.Bytes()
+}
+
+func TestDWARFIndexForms(t *testing.T) {
+	var abbrev dwarf64Writer
+	abbrev.values(
+		uint8(1), uint8(DWARFTagCompileUnit), uint8(1),
+		uint8(DWARFAttrName), uint8(DWARFFormStrx1),
+		// bases are declared after name which depends on them
+		uint8(DWARFAttrStrOffsetsBase), uint8(DWARFFormSecOffset),
+		uint8(DWARFAttrAddrBase), uint8(DWARFFormSecOffset),
+		uint8(DWARFAttrRngListsBase), uint8(DWARFFormSecOffset),
+		uleb128(DWARFAttrLocListsBase), uint8(DWARFFormSecOffset),
+		uint8(DWARFAttrLowPC), uint8(DWARFFormAddrx),
+		uint8(DWARFAttrRanges), uint8(DWARFFormRngListx),
+		uint8(0), uint8(0),
+		uint8(2), uint8(DWARFTagSubprogram), uint8(0),
+		uint8(DWARFAttrName), uint8(DWARFFormStrx),
+		uint8(DWARFAttrLowPC), uint8(DWARFFormAddrx1),
+		uint8(DWARFAttrHighPC), uint8(DWARFFormIndirect),
+		uint8(DWARFAttrLocation), uint8(DWARFFormLocListx),
+		uint8(DWARFAttrConstValue), uint8(DWARFFormData16),
+		uint8(DWARFAttrDeclFile), uint8(DWARFFormImplicitConst), uint8(0x7d), // SLEB128 -3
+		uint8(DWARFAttrType), uint8(DWARFFormRefUData),
+		uint8(0), uint8(0),
+		uint8(0))
+
+	var info dwarf64Writer
+	constValue := []byte("0123456789abcdef")
+	info.values(
+		uint8(1), uint8(0), uint64(16), uint64(16), uint64(20), uint64(20), uint8(0), uint8(0),
+		uint8(2), uint8(1), uint8(2), uint8(DWARFFormUData), uint8(0x30), uint8(0), constValue, uint8(24),
+		uint8(0))
+
+	sections := dwarfSections{
+		abbrev:     abbrev.Bytes(),
+		str:        []byte("\x00unit.c\x00func\x00"),
+		info:       new(dwarf64Writer).header(info.Bytes(), 5, uint8(DWARFUnitCompile), uint8(8), uint64(0)),
+		strOffsets: new(dwarf64Writer).header(new(dwarf64Writer).values(uint64(1), uint64(8)).Bytes(), 5, uint16(0)),
+		addr:       new(dwarf64Writer).header(new(dwarf64Writer).values(uint64(0x1000), uint64(0x1100), uint64(0x1200)).Bytes(), 5, uint8(8), uint8(0)),
+		rngLists: new(dwarf64Writer).header(new(dwarf64Writer).values(uint64(8),
+			uint8(rangeListBaseAddressx), uint8(1),
+			uint8(rangeListOffsetPair), uint8(0x10), uint8(0x20),
+			uint8(rangeListStartxLength), uint8(0), uint8(0x10),
+			uint8(rangeListEndOfList)).Bytes(), 5, uint8(8), uint8(0), uint32(1)),
+		locLists: new(dwarf64Writer).header(new(dwarf64Writer).values(uint64(8),
+			uint8(locationListStartxEndx), uint8(0), uint8(1), uint8(1), uint8(0x50),
+			uint8(locationListDefaultLocation), uint8(1), uint8(0x51),
+			uint8(locationListEndOfList)).Bytes(), 5, uint8(8), uint8(0), uint32(1)),
+	}
+	data := &DWARFData{byteOrder: binary.LittleEndian, sections: sections}
+	assert.NoError(t, data.readUnits())
+
+	unit := data.Units()[0]
+	assert.True(t, unit.DWARF64)
+	assert.Equal(t, uint64(16), unit.StrOffsetsBase)
+	assert.Equal(t, uint64(16), unit.AddrBase)
+	assert.Equal(t, MemoryAddress(0x1000), unit.BaseAddress)
+	entry := unit.Entry()
+	assert.Equal(t, "unit.c", entry.Name())
+	ranges, err := entry.Ranges()
+	assert.NoError(t, err)
+	assert.Equal(t, []AddressRange{{0x1110, 0x1120}, {0x1000, 0x1010}}, ranges)
+
+	reader := unit.Entries()
+	_, err = reader.Next()
+	assert.NoError(t, err)
+	die, err := reader.Next()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, reader.Depth())
+	assert.Equal(t, DWARFTagSubprogram, die.Tag)
+	assert.Equal(t, "func", die.Name())
+	assert.Equal(t, MemoryAddress(0x1200), die.Value(DWARFAttrLowPC))
+	assert.Equal(t, DIEAttribute{Attribute: DWARFAttrHighPC, Form: DWARFFormUData, Value: uint64(0x30)}, die.Attributes[2])
+	assert.Equal(t, constValue, die.Value(DWARFAttrConstValue))
+	assert.Equal(t, int64(-3), die.Value(DWARFAttrDeclFile))
+	assert.Equal(t, entry.Offset, die.Value(DWARFAttrType))
+	ranges, err = die.Ranges()
+	assert.NoError(t, err)
+	assert.Equal(t, []AddressRange{{0x1200, 0x1230}}, ranges)
+	locations, err := die.Locations(DWARFAttrLocation)
+	assert.NoError(t, err)
+	assert.Equal(t, []LocationEntry{
+		{AddressRange: AddressRange{0x1000, 0x1100}, Expression: []byte{0x50}},
+		{Default: true, Expression: []byte{0x51}},
+	}, locations)
+	_, err = reader.Next()
+	assert.Equal(t, io.EOF, err)
+
+	// index forms can't be resolved without their sections
+	sections.strOffsets = nil
+	data = &DWARFData{byteOrder: binary.LittleEndian, sections: sections}
+	assert.True(t, errors.Is(data.readUnits(), ErrNoDWARFSection))
+
+	sections.info[len(sections.info)-1] = 7
+	sections.strOffsets = new(dwarf64Writer).header(new(dwarf64Writer).values(uint64(1), uint64(8)).Bytes(), 5, uint16(0))
+	data = &DWARFData{byteOrder: binary.LittleEndian, sections: sections}
+	assert.NoError(t, data.readUnits())
+	reader = data.Units()[0].Entries()
+	reader.Next()
+	reader.Next()
+	_, err = reader.Next()
+	assert.True(t, errors.Is(err, ErrInvalidDWARF))
+}
+
+func TestFunctionsForAddress(t *testing.T) {
+	// compare with addr2line -f -i and llvm-dwarfdump --debug-info
+	type frame struct {
+		name     string
+		inlined  bool
+		callLine int
+		column   int
+	}
+	tcs := []struct {
+		address MemoryAddress
+		frames  []frame
+	}{
+		{0x1182, []frame{{"square", true, 12, 15}, {"sum_of_squares", true, 18, 15}, {"compute", false, 0, 0}}},
+		{0x118b, []frame{{"square", true, 13, 12}, {"sum_of_squares", true, 18, 15}, {"compute", false, 0, 0}}},
+		{0x11b0, []frame{{"square", true, 13, 12}, {"sum_of_squares", true, 20, 13}, {"compute", false, 0, 0}}},
+		{0x11c1, []frame{{"compute", false, 0, 0}}},
+		{0x1060, []frame{{"main", false, 0, 0}}},
+	}
+	for _, filename := range []string{"inline_linux_amd64", "inline_dwarf4_linux_amd64"} {
+		file, err := Open(filepath.Join("testdata", filename))
+		assert.NoError(t, err)
+		defer file.Close()
+
+		for _, tc := range tcs {
+			frames, err := file.FunctionsForAddress(tc.address)
+			assert.NoError(t, err)
+			var actual []frame
+			for _, f := range frames {
+				actual = append(actual, frame{f.Name, f.Inlined, f.CallLine, f.CallColumn})
+				if f.Inlined {
+					assert.Equal(t, "/root/module/testdata/src/inline.c", f.CallFile)
+					assert.Equal(t, DWARFTagInlinedSubroutine, f.Entry.Tag)
+				}
+			}
+			assert.Equal(t, tc.frames, actual, "%v %v", filename, tc.address)
+		}
+
+		_, err = file.FunctionsForAddress(0x11c4)
+		assert.True(t, errors.Is(err, ErrFunctionNotFound))
+	}
+}
+
+func TestDWARFConstantStrings(t *testing.T) {
+	assert.Equal(t, "subprogram", DWARFTagSubprogram.String())
+	assert.Equal(t, "elf.DWARFTagInlinedSubroutine", DWARFTagInlinedSubroutine.GoString())
+	assert.Equal(t, "user specific: 0x4200", DWARFTag(0x4200).String())
+	assert.Equal(t, "high_pc", DWARFAttrHighPC.String())
+	assert.Equal(t, "GNU_addr_base", DWARFAttrGNUAddrBase.String())
+	assert.Equal(t, "unknown: 0x8D", DWARFAttribute(0x8d).String())
+	assert.Equal(t, "split_compile", DWARFUnitSplitCompile.String())
+
+	attribute, err := ParseDWARFAttribute("DW_AT_call_file")
+	assert.NoError(t, err)
+	assert.Equal(t, DWARFAttrCallFile, attribute)
+	tag, err := ParseDWARFTag("DWARFTagLexicalBlock")
+	assert.NoError(t, err)
+	assert.Equal(t, DWARFTagLexicalBlock, tag)
+	unitType, err := ParseDWARFUnitType("DW_UT_skeleton")
+	assert.NoError(t, err)
+	assert.Equal(t, DWARFUnitSkeleton, unitType)
+}
+
+func TestFunctionsForAddressCompressed(t *testing.T) {
+	file, err := Open(filepath.Join("testdata", "helloworld_linux_amd64"))
+	assert.NoError(t, err)
+	defer file.Close()
+
+	frames, err := file.FunctionsForAddress(0x491410)
+	assert.NoError(t, err)
+	assert.Len(t, frames, 1)
+	assert.Equal(t, "main.main", frames[0].Name)
+	assert.False(t, frames[0].Inlined)
+}
@@ -0,0 +1,542 @@
+package elf
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"sort"
+)
+
+var ErrLineNotFound = errors.New("no line for address")
+
+// LineFile is single file entry of line table
+type LineFile struct {
+	Name             string
+	Directory        string // resolved directory entry, empty for directory 0 of line tables before DWARF 5
+	DirectoryIndex   uint64
+	ModificationTime uint64 // zero if unknown
+	Length           uint64 // zero if unknown
+	MD5              []byte // DWARF 5 only, nil if not present
+}
+
+// Path returns file name joined with its directory unless the name is absolute
+func (lf LineFile) Path() string {
+	if lf.Directory == "" || path.IsAbs(lf.Name) {
+		return lf.Name
+	}
+	return path.Join(lf.Directory, lf.Name)
+}
+
+// LineTable is header of single line number program of .debug_line section
+type LineTable struct {
+	Offset                uint64 // offset of the table in .debug_line, referenced by DW_AT_stmt_list
+	Version               uint16
+	AddressSize           uint8 // DWARF 5 only, zero if not declared
+	MinInstructionLength  uint8
+	MaxOpsPerInstruction  uint8
+	DefaultIsStmt         bool
+	LineBase              int8
+	LineRange             uint8
+	OpcodeBase            uint8
+	StandardOpcodeLengths []uint8 // argument counts of standard opcodes starting with opcode 1
+	Directories           []string
+	// Files are indexed by file register value, for versions before DWARF 5 they start with 1, so the first entry is empty
+	Files []LineFile
+
+	program   []byte
+	byteOrder binary.ByteOrder
+}
+
+// LineRow is single row of line number matrix
+type LineRow struct {
+	Address       MemoryAddress
+	OpIndex       uint64
+	FileIndex     uint64
+	File          *LineFile // nil if file register is out of file table
+	Line          int
+	Column        int
+	IsStmt        bool
+	BasicBlock    bool
+	EndSequence   bool // first address past the end of sequence, other fields are meaningless
+	PrologueEnd   bool
+	EpilogueBegin bool
+	ISA           uint64
+	Discriminator uint64
+}
+
+// standard opcodes
+const (
+	lineOpCopy             = 1
+	lineOpAdvancePC        = 2
+	lineOpAdvanceLine      = 3
+	lineOpSetFile          = 4
+	lineOpSetColumn        = 5
+	lineOpNegateStmt       = 6
+	lineOpSetBasicBlock    = 7
+	lineOpConstAddPC       = 8
+	lineOpFixedAdvancePC   = 9
+	lineOpSetPrologueEnd   = 10
+	lineOpSetEpilogueBegin = 11
+	lineOpSetISA           = 12
+)
+
+// extended opcodes
+const (
+	lineExtEndSequence      = 1
+	lineExtSetAddress       = 2
+	lineExtDefineFile       = 3
+	lineExtSetDiscriminator = 4
+)
+
+// DWARF 5 entry format content types
+const (
+	lineContentPath           = 1
+	lineContentDirectoryIndex = 2
+	lineContentTimestamp      = 3
+	lineContentSize           = 4
+	lineContentMD5            = 5
+)
+
+// LineTables decodes headers of all line number programs in .debug_line section
+func (f *File) LineTables() ([]*LineTable, error) {
+	sections, err := f.lineSections()
+	if err != nil {
+		return nil, err
+	}
+	var tables []*LineTable
+	for offset := uint64(0); offset < uint64(len(sections.line)); {
+		table, next, err := f.readLineTable(sections, offset)
+		if err != nil {
+			return nil, err
+		}
+		tables = append(tables, table)
+		offset = next
+	}
+	return tables, nil
+}
+
+// LineTableAt decodes header of line number program at given offset of .debug_line section
+func (f *File) LineTableAt(offset uint64) (*LineTable, error) {
+	sections, err := f.lineSections()
+	if err != nil {
+		return nil, err
+	}
+	table, _, err := f.readLineTable(sections, offset)
+	return table, err
+}
+
+// lineSections are contents of sections referenced by line tables
+type lineSections struct {
+	line    []byte
+	str     []byte
+	lineStr []byte
+}
+
+// lineSections are loaded once, so tables of all units share single relocated copy of .debug_line
+func (f *File) lineSections() (lineSections, error) {
+	f.lineSectionsOnce.Do(func() {
+		f.lineSectionsCache, f.lineSectionsErr = f.readLineSections()
+	})
+	return f.lineSectionsCache, f.lineSectionsErr
+}
+
+func (f *File) readLineSections() (lineSections, error) {
+	var sections lineSections
+	var err error
+	if sections.line, err = f.DWARFSection(".debug_line"); err != nil {
+		return sections, err
+	}
+	if sections.str, err = f.optionalDWARFSection(".debug_str"); err != nil {
+		return sections, err
+	}
+	if sections.lineStr, err = f.optionalDWARFSection(".debug_line_str"); err != nil {
+		return sections, err
+	}
+	return sections, nil
+}
+
+// readLineTable decodes line table header at offset and returns offset of the next table
+func (f *File) readLineTable(sections lineSections, offset uint64) (*LineTable, uint64, error) {
+	buf := &dwarfBuffer{section: ".debug_line", data: sections.line, offset: offset, byteOrder: f.ByteOrder()}
+	table := &LineTable{Offset: offset, byteOrder: f.ByteOrder()}
+
+	length, dwarf64 := buf.unitLength()
+	if buf.err != nil {
+		return nil, 0, buf.err
+	}
+	if length > uint64(len(sections.line))-buf.offset {
+		return nil, 0, fmt.Errorf("%w .debug_line table at 0x%x length 0x%x overflows section", ErrInvalidDWARF, offset, length)
+	}
+	end := buf.offset + length
+	// decoding is limited to the table, so its content can't be mistaken for the next one
+	buf.data = sections.line[:end]
+
+	table.Version = buf.uint16()
+	if buf.err == nil && (table.Version < 2 || table.Version > 5) {
+		return nil, 0, fmt.Errorf("%w .debug_line table at 0x%x has unsupported version %v", ErrInvalidDWARF, offset, table.Version)
+	}
+	if table.Version >= 5 {
+		table.AddressSize = buf.uint8()
+		if segmentSelectorSize := buf.uint8(); segmentSelectorSize != 0 {
+			buf.fail("unsupported segment selector size %v", segmentSelectorSize)
+		}
+	}
+	headerLength := buf.sectionOffset(dwarf64)
+	programOffset := buf.offset + headerLength
+	table.MinInstructionLength = buf.uint8()
+	table.MaxOpsPerInstruction = 1
+	if table.Version >= 4 {
+		table.MaxOpsPerInstruction = buf.uint8()
+	}
+	table.DefaultIsStmt = buf.uint8() != 0
+	table.LineBase = int8(buf.uint8())
+	table.LineRange = buf.uint8()
+	table.OpcodeBase = buf.uint8()
+	if buf.err == nil && (table.LineRange == 0 || table.MaxOpsPerInstruction == 0 || table.OpcodeBase == 0) {
+		buf.fail("line range %v, maximum operations %v and opcode base %v must not be zero", table.LineRange, table.MaxOpsPerInstruction, table.OpcodeBase)
+	}
+	if table.OpcodeBase > 0 {
+		table.StandardOpcodeLengths = append([]uint8(nil), buf.bytes(uint64(table.OpcodeBase)-1)...)
+	}
+
+	if table.Version >= 5 {
+		f.readLineEntriesV5(buf, table, sections, dwarf64)
+	} else {
+		readLineEntries(buf, table)
+	}
+	if buf.err != nil {
+		return nil, 0, buf.err
+	}
+	if programOffset > end {
+		return nil, 0, fmt.Errorf("%w .debug_line table at 0x%x header length 0x%x overflows table", ErrInvalidDWARF, offset, headerLength)
+	}
+	table.program = sections.line[programOffset:end]
+	return table, end, nil
+}
+
+// readLineEntries decodes include_directories and file_names of line tables before DWARF 5
+func readLineEntries(buf *dwarfBuffer, table *LineTable) {
+	// directory 0 is compilation directory which is not part of the table
+	table.Directories = []string{""}
+	for buf.err == nil {
+		directory := buf.cstring()
+		if directory == "" {
+			break
+		}
+		table.Directories = append(table.Directories, directory)
+	}
+	table.Files = []LineFile{{}}
+	for buf.err == nil {
+		name := buf.cstring()
+		if name == "" {
+			break
+		}
+		table.Files = append(table.Files, table.newLineFile(name, buf.uleb(), buf.uleb(), buf.uleb()))
+	}
+}
+
+func (lt *LineTable) newLineFile(name string, directoryIndex, modificationTime, length uint64) LineFile {
+	file := LineFile{Name: name, DirectoryIndex: directoryIndex, ModificationTime: modificationTime, Length: length}
+	if directoryIndex < uint64(len(lt.Directories)) {
+		file.Directory = lt.Directories[directoryIndex]
+	}
+	return file
+}
+
+// lineEntryFormat is single content type and form pair of DWARF 5 directory or file entry format
+type lineEntryFormat struct {
+	contentType uint64
+	form        DWARFForm
+}
+
+func (f *File) readLineEntriesV5(buf *dwarfBuffer, table *LineTable, sections lineSections, dwarf64 bool) {
+	directories := f.readLineEntryList(buf, table, sections, dwarf64)
+	for _, directory := range directories {
+		table.Directories = append(table.Directories, directory.Name)
+	}
+	for _, file := range f.readLineEntryList(buf, table, sections, dwarf64) {
+		table.Files = append(table.Files, table.newLineFile(file.Name, file.DirectoryIndex, file.ModificationTime, file.Length))
+		table.Files[len(table.Files)-1].MD5 = file.MD5
+	}
+}
+
+// readLineEntryList decodes entry format followed by entries, directories are returned as files with Name only
+func (f *File) readLineEntryList(buf *dwarfBuffer, table *LineTable, sections lineSections, dwarf64 bool) []LineFile {
+	formatCount := buf.uint8()
+	formats := make([]lineEntryFormat, 0, formatCount)
+	for i := uint8(0); i < formatCount && buf.err == nil; i++ {
+		formats = append(formats, lineEntryFormat{contentType: buf.uleb(), form: DWARFForm(buf.uleb())})
+	}
+	count := buf.uleb()
+	var entries []LineFile
+	for i := uint64(0); i < count && buf.err == nil; i++ {
+		var entry LineFile
+		for _, format := range formats {
+			value, data := f.readLineEntryValue(buf, format.form, sections, dwarf64, table.AddressSize)
+			switch format.contentType {
+			case lineContentPath:
+				entry.Name = string(data)
+			case lineContentDirectoryIndex:
+				entry.DirectoryIndex = value
+			case lineContentTimestamp:
+				entry.ModificationTime = value
+			case lineContentSize:
+				entry.Length = value
+			case lineContentMD5:
+				entry.MD5 = append([]byte(nil), data...)
+			}
+		}
+		entries = append(entries, entry)
+	}
+	return entries
+}
+
+// readLineEntryValue reads value of DWARF 5 entry field, strings and blocks are returned as data
+func (f *File) readLineEntryValue(buf *dwarfBuffer, form DWARFForm, sections lineSections, dwarf64 bool, addressSize uint8) (uint64, []byte) {
+	readString := func(section string, content []byte) []byte {
+		offset := buf.sectionOffset(dwarf64)
+		if buf.err != nil {
+			return nil
+		}
+		value, err := cstringAt(section, content, offset)
+		if err != nil && buf.err == nil {
+			buf.err = err
+		}
+		return []byte(value)
+	}
+	switch form {
+	case DWARFFormString:
+		return 0, []byte(buf.cstring())
+	case DWARFFormLineStrp:
+		return 0, readString(".debug_line_str", sections.lineStr)
+	case DWARFFormStrp:
+		return 0, readString(".debug_str", sections.str)
+	case DWARFFormUData:
+		return buf.uleb(), nil
+	case DWARFFormData1, DWARFFormData2, DWARFFormData4, DWARFFormData8:
+		size := map[DWARFForm]int{DWARFFormData1: 1, DWARFFormData2: 2, DWARFFormData4: 4, DWARFFormData8: 8}[form]
+		return buf.uint(size), nil
+	case DWARFFormData16:
+		return 0, buf.bytes(16)
+	case DWARFFormBlock:
+		return 0, buf.bytes(buf.uleb())
+	}
+	buf.fail("unsupported line table entry form %v", form)
+	return 0, nil
+}
+
+// Rows returns iterator over rows of line number matrix
+func (lt *LineTable) Rows() *LineRowReader {
+	reader := &LineRowReader{
+		table: lt,
+		buf:   &dwarfBuffer{section: ".debug_line", data: lt.program, byteOrder: lt.byteOrder},
+		files: append([]LineFile(nil), lt.Files...),
+	}
+	reader.reset()
+	return reader
+}
+
+// LineRowReader executes line number program of single table
+type LineRowReader struct {
+	table *LineTable
+	buf   *dwarfBuffer
+	files []LineFile // table files extended by DW_LNE_define_file
+	row   LineRow
+}
+
+func (r *LineRowReader) reset() {
+	r.row = LineRow{FileIndex: 1, Line: 1, IsStmt: r.table.DefaultIsStmt}
+}
+
+// Next returns next row of line number matrix or io.EOF when program is finished
+func (r *LineRowReader) Next() (LineRow, error) {
+	buf, table := r.buf, r.table
+	for buf.offset < uint64(len(buf.data)) {
+		opcode := buf.uint8()
+		switch {
+		case opcode >= table.OpcodeBase:
+			adjusted := opcode - table.OpcodeBase
+			r.advance(uint64(adjusted / table.LineRange))
+			r.row.Line += int(table.LineBase) + int(adjusted%table.LineRange)
+			return r.emit(), nil
+		case opcode == 0:
+			length := buf.uleb()
+			start := buf.offset
+			switch {
+			case buf.err != nil:
+			case length == 0:
+				buf.fail("empty extended opcode")
+			case length > uint64(len(buf.data))-start:
+				buf.fail("extended opcode of length 0x%x overflows line number program", length)
+			}
+			if buf.err != nil {
+				return LineRow{}, buf.err
+			}
+			switch buf.uint8() {
+			case lineExtEndSequence:
+				r.row.EndSequence = true
+				row := r.emit()
+				r.reset()
+				buf.offset = start + length
+				return row, buf.err
+			case lineExtSetAddress:
+				r.row.Address = MemoryAddress(buf.uint(int(length - 1)))
+				r.row.OpIndex = 0
+			case lineExtDefineFile:
+				name := buf.cstring()
+				r.files = append(r.files, table.newLineFile(name, buf.uleb(), buf.uleb(), buf.uleb()))
+			case lineExtSetDiscriminator:
+				r.row.Discriminator = buf.uleb()
+			}
+			// unknown extended opcodes are skipped by their length
+			buf.offset = start + length
+		case opcode == lineOpCopy:
+			return r.emit(), nil
+		case opcode == lineOpAdvancePC:
+			r.advance(buf.uleb())
+		case opcode == lineOpAdvanceLine:
+			r.row.Line += int(buf.sleb())
+		case opcode == lineOpSetFile:
+			r.row.FileIndex = buf.uleb()
+		case opcode == lineOpSetColumn:
+			r.row.Column = int(buf.uleb())
+		case opcode == lineOpNegateStmt:
+			r.row.IsStmt = !r.row.IsStmt
+		case opcode == lineOpSetBasicBlock:
+			r.row.BasicBlock = true
+		case opcode == lineOpConstAddPC:
+			r.advance(uint64((255 - table.OpcodeBase) / table.LineRange))
+		case opcode == lineOpFixedAdvancePC:
+			r.row.Address += MemoryAddress(buf.uint16())
+			r.row.OpIndex = 0
+		case opcode == lineOpSetPrologueEnd:
+			r.row.PrologueEnd = true
+		case opcode == lineOpSetEpilogueBegin:
+			r.row.EpilogueBegin = true
+		case opcode == lineOpSetISA:
+			r.row.ISA = buf.uleb()
+		default:
+			// unknown standard opcodes are skipped by their declared ULEB128 argument count
+			for i := uint8(0); i < table.StandardOpcodeLengths[opcode-1]; i++ {
+				buf.uleb()
+			}
+		}
+		if buf.err != nil {
+			return LineRow{}, buf.err
+		}
+	}
+	if buf.err != nil {
+		return LineRow{}, buf.err
+	}
+	return LineRow{}, io.EOF
+}
+
+// advance applies operation advance to address and op_index registers
+func (r *LineRowReader) advance(operationAdvance uint64) {
+	table := r.table
+	maxOps := uint64(table.MaxOpsPerInstruction)
+	r.row.Address += MemoryAddress(uint64(table.MinInstructionLength) * ((r.row.OpIndex + operationAdvance) / maxOps))
+	r.row.OpIndex = (r.row.OpIndex + operationAdvance) % maxOps
+}
+
+// emit returns current row and resets registers which apply to single row only
+func (r *LineRowReader) emit() LineRow {
+	row := r.row
+	if row.FileIndex < uint64(len(r.files)) {
+		file := r.files[row.FileIndex]
+		row.File = &file
+	}
+	r.row.BasicBlock = false
+	r.row.PrologueEnd = false
+	r.row.EpilogueBegin = false
+	r.row.Discriminator = 0
+	return row
+}
+
+// lineSequence is address ordered rows of single sequence, last row is end of sequence
+type lineSequence struct {
+	rows []LineRow
+}
+
+func (ls lineSequence) low() MemoryAddress {
+	return ls.rows[0].Address
+}
+
+func (ls lineSequence) high() MemoryAddress {
+	return ls.rows[len(ls.rows)-1].Address
+}
+
+// LineForAddress returns source file path, line and column of instruction at given address. For relocatable objects
+// addresses are offsets within their sections, so the first matching sequence wins. Line tables are indexed once per File
+func (f *File) LineForAddress(address MemoryAddress) (string, int, int, error) {
+	sequences, err := f.lineSequences()
+	if err != nil {
+		return "", 0, 0, err
+	}
+	// sequences are sorted by low address, candidates are all sequences starting at or below address
+	candidates := sort.Search(len(sequences), func(i int) bool {
+		return sequences[i].low() > address
+	})
+	for i := 0; i < candidates; i++ {
+		sequence := sequences[i]
+		if address >= sequence.high() {
+			continue
+		}
+		index := sort.Search(len(sequence.rows), func(j int) bool {
+			return sequence.rows[j].Address > address
+		}) - 1
+		row := sequence.rows[index]
+		file := ""
+		if row.File != nil {
+			file = row.File.Path()
+		}
+		return file, row.Line, row.Column, nil
+	}
+	return "", 0, 0, fmt.Errorf("%w %v", ErrLineNotFound, address)
+}
+
+func (f *File) lineSequences() ([]lineSequence, error) {
+	f.lineSequencesOnce.Do(func() {
+		f.lineSequencesCache, f.lineSequencesErr = f.readLineSequences()
+	})
+	return f.lineSequencesCache, f.lineSequencesErr
+}
+
+func (f *File) readLineSequences() ([]lineSequence, error) {
+	tables, err := f.LineTables()
+	if err != nil {
+		return nil, err
+	}
+	var sequences []lineSequence
+	for _, table := range tables {
+		rows := table.Rows()
+		var current []LineRow
+		for {
+			row, err := rows.Next()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				return nil, fmt.Errorf("line table at 0x%x: %w", table.Offset, err)
+			}
+			current = append(current, row)
+			if !row.EndSequence {
+				continue
+			}
+			// empty sequences cover no addresses
+			if len(current) > 1 && current[0].Address < row.Address {
+				sort.SliceStable(current, func(i, j int) bool {
+					return current[i].Address < current[j].Address
+				})
+				sequences = append(sequences, lineSequence{rows: current})
+			}
+			current = nil
+		}
+	}
+	sort.SliceStable(sequences, func(i, j int) bool {
+		return sequences[i].low() < sequences[j].low()
+	})
+	return sequences, nil
+}
@@ -0,0 +1,188 @@
+package elf
+
+import (
+	"errors"
+	"io"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLineTables(t *testing.T) {
+	tcs := []struct {
+		filename    string
+		version     uint16
+		directories []string
+		files       []string
+	}{
+		{"object_dwarf2_linux_amd64.o", 2, []string{""}, []string{"", "object.c"}},
+		{"object_dwarf4_linux_amd64.o", 4, []string{""}, []string{"", "object.c"}},
+		{"object_linux_386.o", 5, []string{"/root/module/testdata/src"}, []string{"/root/module/testdata/src/object.c", "/root/module/testdata/src/object.c"}},
+		{"libsample.so", 5, []string{"/root/module/testdata/src", "/usr/include"},
+			[]string{"/root/module/testdata/src/libsample.c", "/root/module/testdata/src/libsample.c", "/usr/include/string.h", "/usr/include/stdlib.h"}},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.filename, func(t *testing.T) {
+			file, err := Open(filepath.Join("testdata", tc.filename))
+			assert.NoError(t, err)
+			defer file.Close()
+
+			tables, err := file.LineTables()
+			assert.NoError(t, err)
+			assert.Len(t, tables, 1)
+			table := tables[0]
+			assert.Equal(t, tc.version, table.Version)
+			assert.Equal(t, tc.directories, table.Directories)
+			var files []string
+			for _, lineFile := range table.Files {
+				files = append(files, lineFile.Path())
+			}
+			assert.Equal(t, tc.files, files)
+
+			same, err := file.LineTableAt(table.Offset)
+			assert.NoError(t, err)
+			assert.Equal(t, table, same)
+		})
+	}
+}
+
+func TestLineRows(t *testing.T) {
+	file, err := Open(filepath.Join("testdata", "object_dwarf4_linux_amd64.o"))
+	assert.NoError(t, err)
+	defer file.Close()
+
+	tables, err := file.LineTables()
+	assert.NoError(t, err)
+	rows := readLineRows(t, tables[0])
+	// compare with readelf --debug-dump=decodedline
+	expected := []struct {
+		address MemoryAddress
+		line    int
+		column  int
+		isStmt  bool
+	}{
+		{0x0, 6, 20, true},
+		{0x0, 7, 2, true},
+		{0x0, 7, 9, false},
+		{0xf, 8, 2, true},
+		{0xf, 8, 15, false},
+		{0x15, 8, 32, false},
+		{0x17, 9, 1, false},
+	}
+	assert.Len(t, rows, len(expected)+1)
+	for i, row := range expected {
+		assert.Equal(t, row.address, rows[i].Address, "row %v", i)
+		assert.Equal(t, row.line, rows[i].Line, "row %v", i)
+		assert.Equal(t, row.column, rows[i].Column, "row %v", i)
+		assert.Equal(t, row.isStmt, rows[i].IsStmt, "row %v", i)
+		assert.Equal(t, "object.c", rows[i].File.Name)
+	}
+	last := rows[len(rows)-1]
+	assert.True(t, last.EndSequence)
+	assert.Equal(t, MemoryAddress(0x18), last.Address)
+}
+
+func TestMalformedLineProgram(t *testing.T) {
+	file, err := Open(filepath.Join("testdata", "object_dwarf4_linux_amd64.o"))
+	assert.NoError(t, err)
+	defer file.Close()
+	tables, err := file.LineTables()
+	assert.NoError(t, err)
+
+	var wrapping, overrunning dwarf64Writer
+	// length wraps start of opcode back to its length byte
+	wrapping.values(uint8(lineOpCopy), uint8(0), uleb128(1<<64-11), uint8(lineExtSetDiscriminator), uint8(1))
+	overrunning.values(uint8(0), uleb128(10), uint8(lineExtSetAddress), uint64(0x1000))
+	for _, program := range [][]byte{wrapping.Bytes(), overrunning.Bytes()} {
+		table := *tables[0]
+		table.program = program
+		reader := table.Rows()
+		for {
+			_, err = reader.Next()
+			if err != nil {
+				break
+			}
+		}
+		assert.True(t, errors.Is(err, ErrInvalidDWARF), "%v", err)
+	}
+}
+
+func TestCompressedLineTable(t *testing.T) {
+	plain, err := Open(filepath.Join("testdata", "object_linux_386.o"))
+	assert.NoError(t, err)
+	defer plain.Close()
+	plainTables, err := plain.LineTables()
+	assert.NoError(t, err)
+
+	for _, filename := range []string{"object_zlib_linux_386.o", "object_zdebug_linux_386.o"} {
+		file, err := Open(filepath.Join("testdata", filename))
+		assert.NoError(t, err)
+		defer file.Close()
+
+		tables, err := file.LineTables()
+		assert.NoError(t, err)
+		assert.Equal(t, readLineRows(t, plainTables[0]), readLineRows(t, tables[0]), filename)
+	}
+}
+
+func TestLineForAddress(t *testing.T) {
+	// compare with addr2line
+	tcs := []struct {
+		filename string
+		address  MemoryAddress
+		path     string
+		line     int
+		column   int
+	}{
+		{"object_dwarf2_linux_amd64.o", 0x5, "object.c", 7, 2},
+		{"object_dwarf4_linux_amd64.o", 0x0, "object.c", 7, 9},
+		{"object_dwarf4_linux_amd64.o", 0x10, "object.c", 8, 15},
+		{"object_dwarf4_linux_amd64.o", 0x17, "object.c", 9, 1},
+		{"object_linux_386.o", 0x0, "/root/module/testdata/src/object.c", 6, 20},
+		{"libsample.so", 0x1119, "/root/module/testdata/src/libsample.c", 6, 15},
+		{"libsample.so", 0x111c, "/root/module/testdata/src/libsample.c", 7, 1},
+		{"libsample.so", 0x1142, "/root/module/testdata/src/libsample.c", 15, 24},
+		{"helloworld_linux_amd64", 0x491410, "/Users/tadovas/work/fun/go-elf/cmd/samples/hellworld/main.go", 5, 0},
+	}
+	for _, tc := range tcs {
+		file, err := Open(filepath.Join("testdata", tc.filename))
+		assert.NoError(t, err)
+		defer file.Close()
+
+		path, line, column, err := file.LineForAddress(tc.address)
+		assert.NoError(t, err, tc.filename)
+		assert.Equal(t, tc.path, path, tc.filename)
+		assert.Equal(t, tc.line, line, "%v %v", tc.filename, tc.address)
+		assert.Equal(t, tc.column, column, "%v %v", tc.filename, tc.address)
+	}
+}
+
+func TestLineForAddressErrors(t *testing.T) {
+	file, err := Open(filepath.Join("testdata", "libsample.so"))
+	assert.NoError(t, err)
+	defer file.Close()
+	_, _, _, err = file.LineForAddress(0x114a)
+	assert.True(t, errors.Is(err, ErrLineNotFound))
+
+	stripped, err := Open(filepath.Join("testdata", "libsample_minidebuginfo.so"))
+	assert.NoError(t, err)
+	defer stripped.Close()
+	_, _, _, err = stripped.LineForAddress(0x1119)
+	assert.True(t, errors.Is(err, ErrNoDWARFSection))
+}
+
+func readLineRows(t *testing.T, table *LineTable) []LineRow {
+	var rows []LineRow
+	reader := table.Rows()
+	for {
+		row, err := reader.Next()
+		if err == io.EOF {
+			return rows
+		}
+		if !assert.NoError(t, err) {
+			return rows
+		}
+		rows = append(rows, row)
+	}
+}
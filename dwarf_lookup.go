@@ -0,0 +1,225 @@
+package elf
+
+import (
+	"errors"
+	"fmt"
+	"io"
+)
+
+// anonymousNamespace qualifies names declared in namespace without name, the same way GDB does
+const anonymousNamespace = "(anonymous namespace)"
+
+// lookupNameTags are tags of entries found by name lookups
+var lookupNameTags = map[DWARFTag]bool{
+	DWARFTagSubprogram:      true,
+	DWARFTagVariable:        true,
+	DWARFTagConstant:        true,
+	DWARFTagBaseType:        true,
+	DWARFTagStructureType:   true,
+	DWARFTagClassType:       true,
+	DWARFTagUnionType:       true,
+	DWARFTagEnumerationType: true,
+	DWARFTagTypedef:         true,
+	DWARFTagNamespace:       true,
+}
+
+// lookupScopeTags are tags of entries which qualify names of their children
+var lookupScopeTags = map[DWARFTag]bool{
+	DWARFTagNamespace:     true,
+	DWARFTagStructureType: true,
+	DWARFTagClassType:     true,
+	DWARFTagUnionType:     true,
+}
+
+// LookupName returns entries of name, see DWARFData.LookupName
+func (f *File) LookupName(name string) ([]*DIE, error) {
+	data, err := f.DWARF()
+	if err != nil {
+		return nil, err
+	}
+	return data.LookupName(name)
+}
+
+// LookupName returns definitions of functions, variables, types and namespaces of name in unit order. C++ names are
+// qualified by enclosing namespaces and types like ns::Class::method, without parameters of functions. Only units
+// listed for the name by .debug_names or, if file has none, by .gdb_index are walked. Units not covered by either
+// index are always walked. Entries within functions and entries of split units are not looked up
+func (d *DWARFData) LookupName(name string) ([]*DIE, error) {
+	units, err := d.unitsOfName(name)
+	if err != nil {
+		return nil, err
+	}
+	var found []*DIE
+	for _, unit := range units {
+		entries, err := unit.lookupName(name)
+		if err != nil {
+			return nil, fmt.Errorf("unit at 0x%x: %w", unit.Offset, err)
+		}
+		found = append(found, entries...)
+	}
+	return found, nil
+}
+
+// unitsOfName lists units which may define name according to accelerator tables of file
+func (d *DWARFData) unitsOfName(name string) ([]*CompilationUnit, error) {
+	candidates, covered, err := d.file.nameIndexUnits(name)
+	if errors.Is(err, ErrNoDWARFSection) {
+		candidates, covered, err = d.file.gdbIndexUnits(name)
+	}
+	if errors.Is(err, ErrNoDWARFSection) {
+		return d.units, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var units []*CompilationUnit
+	for _, unit := range d.units {
+		if candidates[unit.Offset] || !covered[unit.Offset] {
+			units = append(units, unit)
+		}
+	}
+	return units, nil
+}
+
+// nameIndexUnits returns offsets of units with entries of unqualified name in .debug_names and offsets of all units
+// covered by its indexes
+func (f *File) nameIndexUnits(name string) (map[uint64]bool, map[uint64]bool, error) {
+	indexes, err := f.NameIndexes()
+	if err != nil {
+		return nil, nil, err
+	}
+	candidates, covered := map[uint64]bool{}, map[uint64]bool{}
+	for _, index := range indexes {
+		for _, offset := range index.CompileUnits {
+			covered[offset] = true
+		}
+		for _, offset := range index.LocalTypeUnits {
+			covered[offset] = true
+		}
+		entries, err := index.Lookup(unqualifiedName(name))
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, entry := range entries {
+			if !entry.IsForeign() {
+				candidates[entry.Unit] = true
+			}
+		}
+	}
+	return candidates, covered, nil
+}
+
+// gdbIndexUnits returns offsets of units of name in .gdb_index and offsets of all units it covers
+func (f *File) gdbIndexUnits(name string) (map[uint64]bool, map[uint64]bool, error) {
+	index, err := f.GDBIndex()
+	if err != nil {
+		return nil, nil, err
+	}
+	symbols, err := index.Lookup(name)
+	if err != nil {
+		return nil, nil, err
+	}
+	candidates, covered := map[uint64]bool{}, map[uint64]bool{}
+	for _, unit := range index.CompileUnits {
+		covered[unit.Offset] = true
+	}
+	// type units of .debug_types are not read, so they are never candidates
+	for _, symbol := range symbols {
+		if symbol.Unit < uint32(len(index.CompileUnits)) {
+			candidates[index.CompileUnits[symbol.Unit].Offset] = true
+		}
+	}
+	return candidates, covered, nil
+}
+
+// lookupName walks unit entries outside of functions, names of entries are qualified by enclosing scopes
+func (u *CompilationUnit) lookupName(name string) ([]*DIE, error) {
+	reader := u.Entries()
+	scopes := []string{""} // qualifiers of children of entries by depth
+	// qualified names of functions and variables, definitions out of their scope refer to them
+	referenced := map[DIEOffset]string{}
+	var found []*DIE
+	for {
+		die, err := reader.Next()
+		if err == io.EOF {
+			return found, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		depth := reader.Depth()
+		if depth == 0 {
+			continue
+		}
+
+		qualified := qualifiedName(scopes[depth-1], die.Name())
+		if die.Name() == "" && (die.Tag == DWARFTagSubprogram || die.Tag == DWARFTagVariable) {
+			if qualified, err = u.referencedName(die, scopes[depth-1], referenced); err != nil {
+				return nil, fmt.Errorf("entry at 0x%x: %w", die.Offset, err)
+			}
+		}
+		if die.Tag == DWARFTagSubprogram || die.Tag == DWARFTagVariable {
+			referenced[die.Offset] = qualified
+		}
+		if declaration, _ := die.Value(DWARFAttrDeclaration).(bool); lookupNameTags[die.Tag] && !declaration && qualified == name {
+			found = append(found, die)
+		}
+
+		if !die.Children || !lookupScopeTags[die.Tag] || die.Name() == "" && die.Tag != DWARFTagNamespace {
+			reader.SkipChildren()
+			continue
+		}
+		if die.Name() == "" {
+			qualified = qualifiedName(scopes[depth-1], anonymousNamespace)
+		}
+		scopes = append(scopes[:depth], qualified)
+	}
+}
+
+// referencedName finds qualified name of definition or concrete instance through its specification or abstract origin
+func (u *CompilationUnit) referencedName(die *DIE, qualifier string, referenced map[DIEOffset]string) (string, error) {
+	origin, ok := die.Value(DWARFAttrSpecification).(DIEOffset)
+	if !ok {
+		origin, ok = die.Value(DWARFAttrAbstractOrigin).(DIEOffset)
+	}
+	if !ok {
+		return "", nil
+	}
+	if name, ok := referenced[origin]; ok {
+		return name, nil
+	}
+	// entries of other units are qualified by scope of the definition
+	name, _, err := u.data.functionNames(die)
+	if err != nil || name == "" {
+		return "", err
+	}
+	return qualifiedName(qualifier, name), nil
+}
+
+func qualifiedName(qualifier, name string) string {
+	if qualifier == "" || name == "" {
+		return name
+	}
+	return qualifier + "::" + name
+}
+
+// unqualifiedName strips qualifiers of name, qualified names within template arguments and parameters are kept
+func unqualifiedName(name string) string {
+	start, nesting := 0, 0
+	for i := 0; i < len(name); i++ {
+		switch name[i] {
+		case '<', '(':
+			nesting++
+		case '>', ')':
+			if nesting > 0 {
+				nesting--
+			}
+		case ':':
+			if nesting == 0 && i+1 < len(name) && name[i+1] == ':' {
+				start = i + 2
+				i++
+			}
+		}
+	}
+	return name[start:]
+}
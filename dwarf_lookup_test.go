@@ -0,0 +1,111 @@
+package elf
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLookupName(t *testing.T) {
+	file, err := Open(filepath.Join("testdata", "names_linux_amd64"))
+	assert.NoError(t, err)
+	defer file.Close()
+	data, err := file.DWARF()
+	assert.NoError(t, err)
+
+	tcs := []struct {
+		name    string
+		units   []uint64 // units walked, unit of layout.c at 0xe8 is not covered by .debug_names
+		offsets []DIEOffset
+		tag     DWARFTag
+	}{
+		{"twice", []uint64{0, 0x7f, 0xe8}, []DIEOffset{0x56, 0xce}, DWARFTagSubprogram},
+		{"helper", []uint64{0x7f, 0xe8}, []DIEOffset{0xa6}, DWARFTagSubprogram},
+		{"point_t", []uint64{0, 0xe8}, []DIEOffset{0x32}, DWARFTagTypedef},
+		{"origin", []uint64{0, 0xe8}, []DIEOffset{0x27}, DWARFTagVariable},
+		{"padded", []uint64{0xe8}, []DIEOffset{0x167}, DWARFTagStructureType},
+		{"int", []uint64{0, 0x7f, 0xe8}, []DIEOffset{0x52, 0xca, 0x13c}, DWARFTagBaseType},
+		// static variable of function and members are not looked up
+		{"calls", []uint64{0x7f, 0xe8}, nil, 0},
+		{"point::x", []uint64{0xe8}, nil, 0},
+	}
+	for _, tc := range tcs {
+		units, err := data.unitsOfName(tc.name)
+		assert.NoError(t, err)
+		var offsets []uint64
+		for _, unit := range units {
+			offsets = append(offsets, unit.Offset)
+		}
+		assert.Equal(t, tc.units, offsets, tc.name)
+
+		entries, err := file.LookupName(tc.name)
+		assert.NoError(t, err)
+		assert.Len(t, entries, len(tc.offsets), tc.name)
+		for i, entry := range entries {
+			assert.Equal(t, tc.offsets[i], entry.Offset, tc.name)
+			assert.Equal(t, tc.tag, entry.Tag, tc.name)
+		}
+	}
+}
+
+func TestLookupNameCPlusPlus(t *testing.T) {
+	// names of the same unit found through .gdb_index and by walking all units of file without index
+	tcs := []struct {
+		name string
+		tag  DWARFTag
+	}{
+		{"Padded", DWARFTagStructureType},
+		{"twice<int>", DWARFTagSubprogram},
+		{"std::__cxx11", DWARFTagNamespace},
+		{"std::runtime_error::runtime_error", DWARFTagSubprogram},
+		{"std::__cxx11::basic_string<char, std::char_traits<char>, std::allocator<char> >::size", DWARFTagSubprogram},
+		{"std::allocator<char>", DWARFTagClassType},
+	}
+	indexed, err := Open(filepath.Join("testdata", "gdb_index_linux_amd64"))
+	assert.NoError(t, err)
+	defer indexed.Close()
+	plain, err := Open(filepath.Join("testdata", "sample_linux_amd64"))
+	assert.NoError(t, err)
+	defer plain.Close()
+	indexedData, err := indexed.DWARF()
+	assert.NoError(t, err)
+
+	for _, tc := range tcs {
+		units, err := indexedData.unitsOfName(tc.name)
+		assert.NoError(t, err)
+		assert.Len(t, units, 1, tc.name)
+		assert.Zero(t, units[0].Offset, tc.name)
+
+		for _, file := range []*File{indexed, plain} {
+			entries, err := file.LookupName(tc.name)
+			assert.NoError(t, err)
+			assert.NotEmpty(t, entries, tc.name)
+			for _, entry := range entries {
+				assert.Equal(t, tc.tag, entry.Tag, tc.name)
+				declaration, _ := entry.Value(DWARFAttrDeclaration).(bool)
+				assert.False(t, declaration, tc.name)
+			}
+		}
+	}
+
+	// function of C unit, declarations of C++ unit are skipped
+	entries, err := indexed.LookupName("sample_name")
+	assert.NoError(t, err)
+	assert.Len(t, entries, 1)
+	assert.Equal(t, uint64(0x991c), entries[0].Unit.Offset)
+	entries, err = plain.LookupName("sample_name")
+	assert.NoError(t, err)
+	assert.Empty(t, entries)
+	entries, err = plain.LookupName("runtime_error")
+	assert.NoError(t, err)
+	assert.Empty(t, entries)
+}
+
+func TestUnqualifiedName(t *testing.T) {
+	assert.Equal(t, "size", unqualifiedName("std::vector<std::string>::size"))
+	assert.Equal(t, "vector<std::string>", unqualifiedName("std::vector<std::string>"))
+	assert.Equal(t, "operator()", unqualifiedName("ns::Functor::operator()"))
+	assert.Equal(t, "twice", unqualifiedName("twice"))
+	assert.Equal(t, "call<void (*)(ns::Type)>", unqualifiedName("call<void (*)(ns::Type)>"))
+}
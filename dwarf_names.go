@@ -0,0 +1,365 @@
+package elf
+
+import (
+	"encoding/binary"
+	"fmt"
+	"unicode"
+	"unicode/utf8"
+)
+
+// NameIndexSectionName is DWARF 5 section of name indexes
+const NameIndexSectionName = ".debug_names"
+
+// NameIndex is single name index of .debug_names section. Linkers concatenate indexes of linked objects, so section of
+// executable usually holds index per object file
+type NameIndex struct {
+	Offset           uint64 // offset of index header in .debug_names
+	Version          uint16
+	DWARF64          bool
+	CompileUnits     []uint64 // offsets of compilation units in .debug_info
+	LocalTypeUnits   []uint64 // offsets of type units in .debug_info
+	ForeignTypeUnits []uint64 // signatures of type units kept in .dwo files
+	Augmentation     string   // producer specific string, e.g. LLVM0700
+
+	byteOrder     binary.ByteOrder
+	str           []byte
+	buckets       []uint32 // one-based indexes of the first names of buckets, zero for empty bucket
+	hashes        []uint32
+	stringOffsets []uint64
+	entryOffsets  []uint64
+	abbrevs       map[uint64]*nameAbbrev
+	entryPool     []byte
+}
+
+type nameAbbrev struct {
+	tag        DWARFTag
+	attributes []nameAbbrevAttribute
+}
+
+type nameAbbrevAttribute struct {
+	index DWARFNameIndexAttribute
+	form  DWARFForm
+}
+
+// NameIndexAttribute is attribute of name index entry, flag_present value is 1 and references are offsets in entry
+// pool of the index
+type NameIndexAttribute struct {
+	Index DWARFNameIndexAttribute
+	Form  DWARFForm
+	Value uint64
+}
+
+// NameIndexEntry is single entry of indexed name, it locates debugging information entry of the name
+type NameIndexEntry struct {
+	Name       string
+	Tag        DWARFTag
+	Attributes []NameIndexAttribute
+	// Unit is offset of compilation unit or local type unit in .debug_info, zero for entries of foreign type units
+	Unit          uint64
+	TypeSignature uint64 // signature of foreign type unit, zero for other entries
+	// DIEOffset is offset of entry in .debug_info, for entries of foreign type units it is relative to the type unit
+	DIEOffset DIEOffset
+}
+
+// IsForeign reports if entry describes type of foreign type unit kept in .dwo file
+func (e NameIndexEntry) IsForeign() bool {
+	return e.TypeSignature != 0
+}
+
+// NameIndexes decodes all name indexes of .debug_names section in section order
+func (f *File) NameIndexes() ([]*NameIndex, error) {
+	f.nameIndexesOnce.Do(func() {
+		f.nameIndexes, f.nameIndexesErr = f.readNameIndexes()
+	})
+	return f.nameIndexes, f.nameIndexesErr
+}
+
+func (f *File) readNameIndexes() ([]*NameIndex, error) {
+	content, err := f.DWARFSection(NameIndexSectionName)
+	if err != nil {
+		return nil, err
+	}
+	str, err := f.optionalDWARFSection(".debug_str")
+	if err != nil {
+		return nil, err
+	}
+	return readNameIndexes(content, str, f.ByteOrder())
+}
+
+func readNameIndexes(content, str []byte, byteOrder binary.ByteOrder) ([]*NameIndex, error) {
+	var indexes []*NameIndex
+	for offset := uint64(0); offset < uint64(len(content)); {
+		index, end, err := readNameIndex(content, offset, byteOrder)
+		if err != nil {
+			return nil, err
+		}
+		index.str = str
+		indexes = append(indexes, index)
+		offset = end
+	}
+	return indexes, nil
+}
+
+// readNameIndex decodes index at offset of .debug_names, returns offset of the following index
+func readNameIndex(content []byte, offset uint64, byteOrder binary.ByteOrder) (*NameIndex, uint64, error) {
+	buf := &dwarfBuffer{section: NameIndexSectionName, data: content, offset: offset, byteOrder: byteOrder}
+	index := &NameIndex{Offset: offset, byteOrder: byteOrder}
+	length, dwarf64 := buf.unitLength()
+	if buf.err != nil {
+		return nil, 0, buf.err
+	}
+	if length > uint64(len(content))-buf.offset {
+		return nil, 0, fmt.Errorf("%w %v index at 0x%x length 0x%x overflows section", ErrInvalidDWARF, NameIndexSectionName, offset, length)
+	}
+	end := buf.offset + length
+	buf.data = content[:end]
+	index.DWARF64 = dwarf64
+
+	index.Version = buf.uint16()
+	if buf.err == nil && index.Version != 5 {
+		return nil, 0, fmt.Errorf("%w %v index at 0x%x has unsupported version %v", ErrInvalidDWARF, NameIndexSectionName, offset, index.Version)
+	}
+	buf.skip(2) // padding
+	compileUnitCount, localTypeUnitCount, foreignTypeUnitCount := buf.uint32(), buf.uint32(), buf.uint32()
+	bucketCount, nameCount, abbrevTableSize := buf.uint32(), buf.uint32(), buf.uint32()
+	index.Augmentation = string(trimNulls(buf.bytes(uint64(buf.uint32()))))
+	if buf.err != nil {
+		return nil, 0, buf.err
+	}
+	// unit lists, hash table and name table must fit
+	offsetSize := uint64(4)
+	if dwarf64 {
+		offsetSize = 8
+	}
+	size := (uint64(compileUnitCount)+uint64(localTypeUnitCount))*offsetSize + uint64(foreignTypeUnitCount)*8 +
+		uint64(bucketCount)*4 + uint64(nameCount)*(2*offsetSize+4) + uint64(abbrevTableSize)
+	if size > end-buf.offset {
+		return nil, 0, fmt.Errorf("%w %v index at 0x%x tables of size 0x%x overflow index", ErrInvalidDWARF, NameIndexSectionName, offset, size)
+	}
+
+	index.CompileUnits = make([]uint64, compileUnitCount)
+	for i := range index.CompileUnits {
+		index.CompileUnits[i] = buf.sectionOffset(dwarf64)
+	}
+	index.LocalTypeUnits = make([]uint64, localTypeUnitCount)
+	for i := range index.LocalTypeUnits {
+		index.LocalTypeUnits[i] = buf.sectionOffset(dwarf64)
+	}
+	index.ForeignTypeUnits = make([]uint64, foreignTypeUnitCount)
+	for i := range index.ForeignTypeUnits {
+		index.ForeignTypeUnits[i] = buf.uint64()
+	}
+	index.buckets = make([]uint32, bucketCount)
+	for i := range index.buckets {
+		if index.buckets[i] = buf.uint32(); index.buckets[i] > nameCount {
+			buf.fail("bucket %v refers to name %v out of %v", i, index.buckets[i], nameCount)
+		}
+	}
+	// hash table is optional, names are then searched sequentially
+	if bucketCount > 0 {
+		index.hashes = make([]uint32, nameCount)
+		for i := range index.hashes {
+			index.hashes[i] = buf.uint32()
+		}
+	}
+	index.stringOffsets = make([]uint64, nameCount)
+	for i := range index.stringOffsets {
+		index.stringOffsets[i] = buf.sectionOffset(dwarf64)
+	}
+	index.entryOffsets = make([]uint64, nameCount)
+	for i := range index.entryOffsets {
+		index.entryOffsets[i] = buf.sectionOffset(dwarf64)
+	}
+	if buf.err != nil {
+		return nil, 0, buf.err
+	}
+	abbrevTableEnd := buf.offset + uint64(abbrevTableSize)
+	var err error
+	abbrevs := &dwarfBuffer{section: NameIndexSectionName, data: content[:abbrevTableEnd], offset: buf.offset, byteOrder: byteOrder}
+	if index.abbrevs, err = readNameAbbrevs(abbrevs); err != nil {
+		return nil, 0, err
+	}
+	index.entryPool = content[abbrevTableEnd:end]
+	return index, end, nil
+}
+
+// readNameAbbrevs decodes abbreviation table terminated by zero code
+func readNameAbbrevs(buf *dwarfBuffer) (map[uint64]*nameAbbrev, error) {
+	abbrevs := map[uint64]*nameAbbrev{}
+	for {
+		code := buf.uleb()
+		if buf.err != nil {
+			return nil, buf.err
+		}
+		if code == 0 {
+			return abbrevs, nil
+		}
+		abbrev := &nameAbbrev{tag: DWARFTag(buf.uleb())}
+		for {
+			index, form := DWARFNameIndexAttribute(buf.uleb()), DWARFForm(buf.uleb())
+			if buf.err != nil {
+				return nil, buf.err
+			}
+			if index == 0 && form == 0 {
+				break
+			}
+			abbrev.attributes = append(abbrev.attributes, nameAbbrevAttribute{index: index, form: form})
+		}
+		abbrevs[code] = abbrev
+	}
+}
+
+func trimNulls(content []byte) []byte {
+	for len(content) > 0 && content[len(content)-1] == 0 {
+		content = content[:len(content)-1]
+	}
+	return content
+}
+
+// nameIndexHash is DJB hash of case folded name used by hash tables of .debug_names
+func nameIndexHash(name string) uint32 {
+	hash := uint32(5381)
+	var encoded [utf8.UTFMax]byte
+	for i := 0; i < len(name); {
+		if c := name[i]; c < utf8.RuneSelf {
+			if 'A' <= c && c <= 'Z' {
+				c += 'a' - 'A'
+			}
+			hash = hash*33 + uint32(c)
+			i++
+			continue
+		}
+		r, size := utf8.DecodeRuneInString(name[i:])
+		for _, c := range encoded[:utf8.EncodeRune(encoded[:], unicode.ToLower(r))] {
+			hash = hash*33 + uint32(c)
+		}
+		i += size
+	}
+	return hash
+}
+
+// Lookup returns entries of name, nil if index has no such name. Names are compared case sensitively although hash
+// table is case insensitive
+func (i *NameIndex) Lookup(name string) ([]NameIndexEntry, error) {
+	if len(i.buckets) == 0 {
+		for position := range i.stringOffsets {
+			if entries, err := i.entriesIfNamed(position, name); entries != nil || err != nil {
+				return entries, err
+			}
+		}
+		return nil, nil
+	}
+	hash := nameIndexHash(name)
+	bucket := hash % uint32(len(i.buckets))
+	if i.buckets[bucket] == 0 {
+		return nil, nil
+	}
+	for position := int(i.buckets[bucket] - 1); position < len(i.hashes); position++ {
+		if i.hashes[position]%uint32(len(i.buckets)) != bucket {
+			break
+		}
+		if i.hashes[position] != hash {
+			continue
+		}
+		if entries, err := i.entriesIfNamed(position, name); entries != nil || err != nil {
+			return entries, err
+		}
+	}
+	return nil, nil
+}
+
+// entriesIfNamed decodes entries of name at position of name table if it is the given name
+func (i *NameIndex) entriesIfNamed(position int, name string) ([]NameIndexEntry, error) {
+	indexed, err := cstringAt(".debug_str", i.str, i.stringOffsets[position])
+	if err != nil || indexed != name {
+		return nil, err
+	}
+	return i.entries(name, i.entryOffsets[position])
+}
+
+// entries decodes entry list of name at offset of entry pool
+func (i *NameIndex) entries(name string, offset uint64) ([]NameIndexEntry, error) {
+	buf := &dwarfBuffer{section: NameIndexSectionName, data: i.entryPool, offset: offset, byteOrder: i.byteOrder}
+	entries := []NameIndexEntry{}
+	for {
+		code := buf.uleb()
+		if buf.err != nil {
+			return nil, buf.err
+		}
+		if code == 0 {
+			return entries, nil
+		}
+		abbrev, ok := i.abbrevs[code]
+		if !ok {
+			return nil, fmt.Errorf("%w %v index at 0x%x: unknown abbreviation code %v", ErrInvalidDWARF, NameIndexSectionName, i.Offset, code)
+		}
+		entry := NameIndexEntry{Name: name, Tag: abbrev.tag, Attributes: make([]NameIndexAttribute, len(abbrev.attributes))}
+		for j, spec := range abbrev.attributes {
+			entry.Attributes[j] = NameIndexAttribute{Index: spec.index, Form: spec.form, Value: i.readValue(buf, spec.form)}
+		}
+		if buf.err != nil {
+			return nil, buf.err
+		}
+		if err := i.resolveEntry(&entry); err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+}
+
+func (i *NameIndex) readValue(buf *dwarfBuffer, form DWARFForm) uint64 {
+	switch form {
+	case DWARFFormData1, DWARFFormRef1, DWARFFormFlag:
+		return uint64(buf.uint8())
+	case DWARFFormData2, DWARFFormRef2:
+		return uint64(buf.uint16())
+	case DWARFFormData4, DWARFFormRef4:
+		return uint64(buf.uint32())
+	case DWARFFormData8, DWARFFormRef8, DWARFFormRefSig8:
+		return buf.uint64()
+	case DWARFFormUData, DWARFFormRefUData:
+		return buf.uleb()
+	case DWARFFormSData:
+		return uint64(buf.sleb())
+	case DWARFFormFlagPresent:
+		return 1
+	}
+	buf.fail("unsupported form %v", form)
+	return 0
+}
+
+// resolveEntry locates unit and debugging information entry of index entry. Unit index may be omitted by indexes of
+// single compilation unit
+func (i *NameIndex) resolveEntry(entry *NameIndexEntry) error {
+	unit, typeUnit := uint64(0), uint64(0)
+	var hasUnit, hasTypeUnit bool
+	for _, attr := range entry.Attributes {
+		switch attr.Index {
+		case DWARFNameIndexCompileUnit:
+			unit, hasUnit = attr.Value, true
+		case DWARFNameIndexTypeUnit:
+			typeUnit, hasTypeUnit = attr.Value, true
+		case DWARFNameIndexDIEOffset:
+			entry.DIEOffset = DIEOffset(attr.Value)
+		}
+	}
+	switch {
+	case hasTypeUnit && typeUnit < uint64(len(i.LocalTypeUnits)):
+		entry.Unit = i.LocalTypeUnits[typeUnit]
+	case hasTypeUnit && typeUnit-uint64(len(i.LocalTypeUnits)) < uint64(len(i.ForeignTypeUnits)):
+		entry.TypeSignature = i.ForeignTypeUnits[typeUnit-uint64(len(i.LocalTypeUnits))]
+		return nil
+	case hasTypeUnit:
+		return fmt.Errorf("%w %v index at 0x%x: type unit %v out of bounds", ErrInvalidDWARF, NameIndexSectionName, i.Offset, typeUnit)
+	case hasUnit && unit < uint64(len(i.CompileUnits)):
+		entry.Unit = i.CompileUnits[unit]
+	case hasUnit:
+		return fmt.Errorf("%w %v index at 0x%x: compilation unit %v out of bounds", ErrInvalidDWARF, NameIndexSectionName, i.Offset, unit)
+	case len(i.CompileUnits) == 1:
+		entry.Unit = i.CompileUnits[0]
+	default:
+		return fmt.Errorf("%w %v index at 0x%x: entry of %v units has no unit", ErrInvalidDWARF, NameIndexSectionName, i.Offset, len(i.CompileUnits))
+	}
+	entry.DIEOffset += DIEOffset(entry.Unit)
+	return nil
+}
@@ -0,0 +1,158 @@
+package elf
+
+import (
+	"encoding/binary"
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNameIndexes(t *testing.T) {
+	file, err := Open(filepath.Join("testdata", "names_linux_amd64"))
+	assert.NoError(t, err)
+	defer file.Close()
+	data, err := file.DWARF()
+	assert.NoError(t, err)
+
+	// compare with llvm-dwarfdump --debug-names, llc emits index per unit and unit of layout.c compiled by gcc has none
+	indexes, err := file.NameIndexes()
+	assert.NoError(t, err)
+	assert.Len(t, indexes, 2)
+	names, helper := indexes[0], indexes[1]
+	assert.Equal(t, uint16(5), names.Version)
+	assert.False(t, names.DWARF64)
+	assert.Equal(t, "LLVM0700", names.Augmentation)
+	assert.Equal(t, []uint64{0}, names.CompileUnits)
+	assert.Equal(t, []uint64{0x7f}, helper.CompileUnits)
+	assert.Empty(t, helper.LocalTypeUnits)
+	assert.Empty(t, helper.ForeignTypeUnits)
+
+	entries, err := names.Lookup("point")
+	assert.NoError(t, err)
+	assert.Equal(t, []NameIndexEntry{{
+		Name:       "point",
+		Tag:        DWARFTagStructureType,
+		Attributes: []NameIndexAttribute{{DWARFNameIndexDIEOffset, DWARFFormRef4, 0x3a}},
+		DIEOffset:  0x3a,
+	}}, entries)
+	// static functions of both units
+	for _, index := range indexes {
+		entries, err = index.Lookup("twice")
+		assert.NoError(t, err)
+		assert.Len(t, entries, 1)
+		assert.Equal(t, DWARFTagSubprogram, entries[0].Tag)
+		assert.Equal(t, index.CompileUnits[0], entries[0].Unit)
+		assert.False(t, entries[0].IsForeign())
+		die, err := data.EntryAt(entries[0].DIEOffset)
+		assert.NoError(t, err)
+		assert.Equal(t, "twice", die.Name())
+	}
+	entries, err = helper.Lookup("calls")
+	assert.NoError(t, err)
+	assert.Equal(t, DIEOffset(0xb5), entries[0].DIEOffset)
+
+	for _, name := range []string{"TWICE", "main2", "", "padded"} {
+		entries, err = names.Lookup(name)
+		assert.NoError(t, err)
+		assert.Nil(t, entries, name)
+	}
+}
+
+func TestNameIndexHash(t *testing.T) {
+	// compare with hashes of llvm-dwarfdump --debug-names
+	assert.Equal(t, uint32(0x10752781), nameIndexHash("twice"))
+	assert.Equal(t, uint32(0x10752781), nameIndexHash("TWICE"))
+	assert.Equal(t, uint32(5381), nameIndexHash(""))
+	assert.Equal(t, nameIndexHash("ärger"), nameIndexHash("ÄRGER"))
+	assert.NotEqual(t, nameIndexHash("ärger"), nameIndexHash("arger"))
+}
+
+func TestNameIndexWithoutHashTable(t *testing.T) {
+	var abbrevs dwarf64Writer
+	abbrevs.values(
+		uleb128(1), uleb128(DWARFTagVariable),
+		uleb128(DWARFNameIndexCompileUnit), uleb128(DWARFFormData1),
+		uleb128(DWARFNameIndexDIEOffset), uleb128(DWARFFormRefUData),
+		uleb128(DWARFNameIndexParent), uleb128(DWARFFormFlagPresent),
+		uleb128(0), uleb128(0),
+		uleb128(2), uleb128(DWARFTagStructureType),
+		uleb128(DWARFNameIndexTypeUnit), uleb128(DWARFFormUData),
+		uleb128(DWARFNameIndexDIEOffset), uleb128(DWARFFormRef4),
+		uleb128(0), uleb128(0),
+		uleb128(0))
+	var entries dwarf64Writer
+	entries.values(
+		// alpha is variable of the second unit and structure of local type unit
+		uleb128(1), uint8(1), uleb128(0x20),
+		uleb128(2), uleb128(0), uint32(0x30),
+		uleb128(0),
+		// beta is structure of foreign type unit
+		uleb128(2), uleb128(1), uint32(0x18),
+		uleb128(0),
+		// gamma refers to unit out of bounds
+		uleb128(1), uint8(2), uleb128(0x20),
+		uleb128(0))
+	var tables dwarf64Writer
+	tables.values(
+		uint32(2), uint32(1), uint32(1), uint32(0), uint32(3), uint32(abbrevs.Len()), uint32(4), []byte("ABC\x00"),
+		uint64(0x10), uint64(0x200), uint64(0x400), uint64(0xfeedface),
+		uint64(0), uint64(6), uint64(11),
+		uint64(0), uint64(10), uint64(17),
+	)
+	tables.Write(abbrevs.Bytes())
+	tables.Write(entries.Bytes())
+	var w dwarf64Writer
+	content := w.header(tables.Bytes(), 5, uint16(0))
+	str := []byte("alpha\x00beta\x00gamma\x00")
+
+	indexes, err := readNameIndexes(content, str, binary.LittleEndian)
+	assert.NoError(t, err)
+	assert.Len(t, indexes, 1)
+	index := indexes[0]
+	assert.True(t, index.DWARF64)
+	assert.Equal(t, "ABC", index.Augmentation)
+	assert.Equal(t, []uint64{0x10, 0x200}, index.CompileUnits)
+	assert.Equal(t, []uint64{0x400}, index.LocalTypeUnits)
+	assert.Equal(t, []uint64{0xfeedface}, index.ForeignTypeUnits)
+
+	alpha, err := index.Lookup("alpha")
+	assert.NoError(t, err)
+	assert.Len(t, alpha, 2)
+	assert.Equal(t, uint64(0x200), alpha[0].Unit)
+	assert.Equal(t, DIEOffset(0x220), alpha[0].DIEOffset)
+	assert.Equal(t, NameIndexAttribute{DWARFNameIndexParent, DWARFFormFlagPresent, 1}, alpha[0].Attributes[2])
+	assert.Equal(t, DWARFTagStructureType, alpha[1].Tag)
+	assert.Equal(t, uint64(0x400), alpha[1].Unit)
+	assert.Equal(t, DIEOffset(0x430), alpha[1].DIEOffset)
+
+	beta, err := index.Lookup("beta")
+	assert.NoError(t, err)
+	assert.True(t, beta[0].IsForeign())
+	assert.Equal(t, uint64(0xfeedface), beta[0].TypeSignature)
+	assert.Zero(t, beta[0].Unit)
+	assert.Equal(t, DIEOffset(0x18), beta[0].DIEOffset)
+
+	_, err = index.Lookup("gamma")
+	assert.True(t, errors.Is(err, ErrInvalidDWARF))
+	missing, err := index.Lookup("delta")
+	assert.NoError(t, err)
+	assert.Nil(t, missing)
+
+	_, err = readNameIndexes(content[:len(content)-1], str, binary.LittleEndian)
+	assert.True(t, errors.Is(err, ErrInvalidDWARF))
+	invalid := append([]byte(nil), content...)
+	invalid[12] = 4 // version
+	_, err = readNameIndexes(invalid, str, binary.LittleEndian)
+	assert.True(t, errors.Is(err, ErrInvalidDWARF))
+}
+
+func TestDWARFNameIndexAttribute(t *testing.T) {
+	assert.Equal(t, "die_offset", DWARFNameIndexDIEOffset.String())
+	assert.Equal(t, "user specific: 0x2001", DWARFNameIndexAttribute(0x2001).String())
+	assert.Equal(t, "elf.DWARFNameIndexParent", DWARFNameIndexParent.GoString())
+	attribute, err := ParseDWARFNameIndexAttribute("DW_IDX_type_hash")
+	assert.NoError(t, err)
+	assert.Equal(t, DWARFNameIndexTypeHash, attribute)
+}
@@ -0,0 +1,352 @@
+package elf
+
+import "fmt"
+
+// AddressRange is half-open range of addresses from Low up to High
+type AddressRange struct {
+	Low  MemoryAddress
+	High MemoryAddress
+}
+
+// Contains reports if address is within range
+func (ar AddressRange) Contains(address MemoryAddress) bool {
+	return address >= ar.Low && address < ar.High
+}
+
+// LocationEntry is location description of single entry of location list
+type LocationEntry struct {
+	AddressRange
+	// Default is set for DW_LLE_default_location used by addresses not covered by other entries and for location
+	// described by single expression valid at any address
+	Default    bool
+	Expression []byte
+}
+
+// DWARF 5 range list entries
+const (
+	rangeListEndOfList    = 0x00
+	rangeListBaseAddressx = 0x01
+	rangeListStartxEndx   = 0x02
+	rangeListStartxLength = 0x03
+	rangeListOffsetPair   = 0x04
+	rangeListBaseAddress  = 0x05
+	rangeListStartEnd     = 0x06
+	rangeListStartLength  = 0x07
+)
+
+// DWARF 5 location list entries
+const (
+	locationListEndOfList       = 0x00
+	locationListBaseAddressx    = 0x01
+	locationListStartxEndx      = 0x02
+	locationListStartxLength    = 0x03
+	locationListOffsetPair      = 0x04
+	locationListDefaultLocation = 0x05
+	locationListBaseAddress     = 0x06
+	locationListStartEnd        = 0x07
+	locationListStartLength     = 0x08
+	locationListGNUViewPair     = 0x09
+)
+
+// location list entries of GNU split DWARF .debug_loc.dwo
+const (
+	locationListGNUEndOfList            = 0x00
+	locationListGNUBaseAddressSelection = 0x01
+	locationListGNUStartEnd             = 0x02
+	locationListGNUStartLength          = 0x03
+)
+
+// Ranges returns addresses covered by entry from DW_AT_ranges or DW_AT_low_pc with DW_AT_high_pc, nil if entry has
+// neither
+func (d *DIE) Ranges() ([]AddressRange, error) {
+	if ranges, ok := d.attribute(DWARFAttrRanges); ok {
+		offset, ok := ranges.Value.(uint64)
+		if !ok {
+			return nil, fmt.Errorf("%w entry at 0x%x has %v with unsupported form %v", ErrInvalidDWARF, d.Offset, ranges.Attribute, ranges.Form)
+		}
+		return d.Unit.rangeList(ranges.Form, offset)
+	}
+	low, ok := d.Value(DWARFAttrLowPC).(MemoryAddress)
+	if !ok {
+		return nil, nil
+	}
+	switch high := d.Value(DWARFAttrHighPC).(type) {
+	case MemoryAddress:
+		return []AddressRange{{Low: low, High: high}}, nil
+	case uint64:
+		return []AddressRange{{Low: low, High: low + MemoryAddress(high)}}, nil
+	case int64:
+		return []AddressRange{{Low: low, High: low + MemoryAddress(high)}}, nil
+	}
+	return nil, nil
+}
+
+// Locations returns location list of attribute like DW_AT_location or DW_AT_frame_base, nil if entry has no such
+// attribute. Location described by single expression is returned as one Default entry
+func (d *DIE) Locations(attribute DWARFAttribute) ([]LocationEntry, error) {
+	attr, ok := d.attribute(attribute)
+	if !ok {
+		return nil, nil
+	}
+	switch value := attr.Value.(type) {
+	case []byte:
+		return []LocationEntry{{Default: true, Expression: value}}, nil
+	case uint64:
+		return d.Unit.locationList(attr.Form, value)
+	}
+	return nil, fmt.Errorf("%w entry at 0x%x has %v with unsupported form %v", ErrInvalidDWARF, d.Offset, attr.Attribute, attr.Form)
+}
+
+// listOffset resolves rnglistx or loclistx index through offsets array following list section header
+func (u *CompilationUnit) listOffset(section string, content []byte, base, index uint64) (uint64, error) {
+	offset, err := u.data.indexedValue(section, content, base, index, u.offsetSize())
+	return base + offset, err
+}
+
+func (u *CompilationUnit) rangeList(form DWARFForm, value uint64) ([]AddressRange, error) {
+	sections := u.data.sections
+	if u.Version < 5 {
+		return u.readRanges(value)
+	}
+	offset := value
+	if form == DWARFFormRngListx {
+		var err error
+		if offset, err = u.listOffset(".debug_rnglists", sections.rngLists, u.RngListsBase, value); err != nil {
+			return nil, err
+		}
+	}
+	if sections.rngLists == nil {
+		return nil, fmt.Errorf("%w: .debug_rnglists", ErrNoDWARFSection)
+	}
+
+	buf := &dwarfBuffer{section: ".debug_rnglists", data: sections.rngLists, offset: offset, byteOrder: u.data.byteOrder}
+	var ranges []AddressRange
+	base := u.BaseAddress
+	var err error
+	address := func() MemoryAddress {
+		index := buf.uleb()
+		if buf.err != nil || err != nil {
+			return 0
+		}
+		var address MemoryAddress
+		address, err = u.indexedAddress(index)
+		return address
+	}
+	for buf.err == nil && err == nil {
+		switch kind := buf.uint8(); kind {
+		case rangeListEndOfList:
+			return ranges, buf.err
+		case rangeListBaseAddressx:
+			base = address()
+		case rangeListStartxEndx:
+			low := address()
+			ranges = append(ranges, AddressRange{Low: low, High: address()})
+		case rangeListStartxLength:
+			low := address()
+			ranges = append(ranges, AddressRange{Low: low, High: low + MemoryAddress(buf.uleb())})
+		case rangeListOffsetPair:
+			low := base + MemoryAddress(buf.uleb())
+			ranges = append(ranges, AddressRange{Low: low, High: base + MemoryAddress(buf.uleb())})
+		case rangeListBaseAddress:
+			base = MemoryAddress(buf.uint(int(u.AddressSize)))
+		case rangeListStartEnd:
+			low := MemoryAddress(buf.uint(int(u.AddressSize)))
+			ranges = append(ranges, AddressRange{Low: low, High: MemoryAddress(buf.uint(int(u.AddressSize)))})
+		case rangeListStartLength:
+			low := MemoryAddress(buf.uint(int(u.AddressSize)))
+			ranges = append(ranges, AddressRange{Low: low, High: low + MemoryAddress(buf.uleb())})
+		default:
+			buf.fail("unknown range list entry 0x%x", kind)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return nil, buf.err
+}
+
+// readRanges decodes .debug_ranges list of units before DWARF 5
+func (u *CompilationUnit) readRanges(offset uint64) ([]AddressRange, error) {
+	if u.data.sections.ranges == nil {
+		return nil, fmt.Errorf("%w: .debug_ranges", ErrNoDWARFSection)
+	}
+	if u.isGNUSplit() {
+		offset += u.RngListsBase
+	}
+	buf := &dwarfBuffer{section: ".debug_ranges", data: u.data.sections.ranges, offset: offset, byteOrder: u.data.byteOrder}
+	var ranges []AddressRange
+	base := u.BaseAddress
+	for {
+		low, high, ok := u.readAddressPair(buf, &base)
+		if buf.err != nil {
+			return nil, buf.err
+		}
+		if !ok {
+			return ranges, nil
+		}
+		if low != nil {
+			ranges = append(ranges, AddressRange{Low: *low, High: *high})
+		}
+	}
+}
+
+// readAddressPair reads entry of .debug_ranges or .debug_loc list. Base address selection entries update base and
+// return nil addresses, end of list or error returns false
+func (u *CompilationUnit) readAddressPair(buf *dwarfBuffer, base *MemoryAddress) (*MemoryAddress, *MemoryAddress, bool) {
+	size := int(u.AddressSize)
+	start, end := buf.uint(size), buf.uint(size)
+	maxAddress := uint64(1)<<(8*uint(size)) - 1
+	switch {
+	case buf.err != nil || start == 0 && end == 0:
+		return nil, nil, false
+	case start == maxAddress:
+		*base = MemoryAddress(end)
+		return nil, nil, true
+	}
+	low, high := *base+MemoryAddress(start), *base+MemoryAddress(end)
+	return &low, &high, true
+}
+
+func (u *CompilationUnit) locationList(form DWARFForm, value uint64) ([]LocationEntry, error) {
+	sections := u.data.sections
+	if u.Version < 5 {
+		return u.readLoc(value)
+	}
+	offset := value
+	if form == DWARFFormLocListx {
+		var err error
+		if offset, err = u.listOffset(".debug_loclists", sections.locLists, u.LocListsBase, value); err != nil {
+			return nil, err
+		}
+	}
+	if sections.locLists == nil {
+		return nil, fmt.Errorf("%w: .debug_loclists", ErrNoDWARFSection)
+	}
+
+	buf := &dwarfBuffer{section: ".debug_loclists", data: sections.locLists, offset: offset, byteOrder: u.data.byteOrder}
+	var entries []LocationEntry
+	base := u.BaseAddress
+	var err error
+	address := func() MemoryAddress {
+		index := buf.uleb()
+		if buf.err != nil || err != nil {
+			return 0
+		}
+		var address MemoryAddress
+		address, err = u.indexedAddress(index)
+		return address
+	}
+	add := func(entry LocationEntry) {
+		entry.Expression = buf.bytes(buf.uleb())
+		entries = append(entries, entry)
+	}
+	for buf.err == nil && err == nil {
+		switch kind := buf.uint8(); kind {
+		case locationListEndOfList:
+			return entries, buf.err
+		case locationListBaseAddressx:
+			base = address()
+		case locationListStartxEndx:
+			low := address()
+			add(LocationEntry{AddressRange: AddressRange{Low: low, High: address()}})
+		case locationListStartxLength:
+			low := address()
+			add(LocationEntry{AddressRange: AddressRange{Low: low, High: low + MemoryAddress(buf.uleb())}})
+		case locationListOffsetPair:
+			low := base + MemoryAddress(buf.uleb())
+			add(LocationEntry{AddressRange: AddressRange{Low: low, High: base + MemoryAddress(buf.uleb())}})
+		case locationListDefaultLocation:
+			add(LocationEntry{Default: true})
+		case locationListBaseAddress:
+			base = MemoryAddress(buf.uint(int(u.AddressSize)))
+		case locationListStartEnd:
+			low := MemoryAddress(buf.uint(int(u.AddressSize)))
+			add(LocationEntry{AddressRange: AddressRange{Low: low, High: MemoryAddress(buf.uint(int(u.AddressSize)))}})
+		case locationListStartLength:
+			low := MemoryAddress(buf.uint(int(u.AddressSize)))
+			add(LocationEntry{AddressRange: AddressRange{Low: low, High: low + MemoryAddress(buf.uleb())}})
+		case locationListGNUViewPair:
+			// location view numbers of the following entry are not exposed
+			buf.uleb()
+			buf.uleb()
+		default:
+			buf.fail("unknown location list entry 0x%x", kind)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return nil, buf.err
+}
+
+// readLoc decodes .debug_loc list of units before DWARF 5
+func (u *CompilationUnit) readLoc(offset uint64) ([]LocationEntry, error) {
+	if u.data.sections.loc == nil {
+		return nil, fmt.Errorf("%w: .debug_loc", ErrNoDWARFSection)
+	}
+	if u.isGNUSplit() {
+		return u.readGNULoc(offset)
+	}
+	buf := &dwarfBuffer{section: ".debug_loc", data: u.data.sections.loc, offset: offset, byteOrder: u.data.byteOrder}
+	var entries []LocationEntry
+	base := u.BaseAddress
+	for {
+		low, high, ok := u.readAddressPair(buf, &base)
+		if buf.err != nil {
+			return nil, buf.err
+		}
+		if !ok {
+			return entries, nil
+		}
+		if low != nil {
+			expression := buf.bytes(uint64(buf.uint16()))
+			entries = append(entries, LocationEntry{AddressRange: AddressRange{Low: *low, High: *high}, Expression: expression})
+		}
+	}
+}
+
+// isGNUSplit reports if unit is split unit of GNU split DWARF extension of DWARF 4
+func (u *CompilationUnit) isGNUSplit() bool {
+	return u.Version < 5 && u.Type == DWARFUnitSplitCompile
+}
+
+// readGNULoc decodes .debug_loc.dwo list of GNU split unit, whose addresses are indexes of skeleton .debug_addr
+func (u *CompilationUnit) readGNULoc(offset uint64) ([]LocationEntry, error) {
+	buf := &dwarfBuffer{section: ".debug_loc.dwo", data: u.data.sections.loc, offset: offset, byteOrder: u.data.byteOrder}
+	var entries []LocationEntry
+	var err error
+	address := func() MemoryAddress {
+		index := buf.uleb()
+		if buf.err != nil || err != nil {
+			return 0
+		}
+		var address MemoryAddress
+		address, err = u.indexedAddress(index)
+		return address
+	}
+	add := func(entry LocationEntry) {
+		entry.Expression = buf.bytes(uint64(buf.uint16()))
+		entries = append(entries, entry)
+	}
+	for buf.err == nil && err == nil {
+		switch kind := buf.uint8(); kind {
+		case locationListGNUEndOfList:
+			return entries, buf.err
+		case locationListGNUBaseAddressSelection:
+			// entries of GNU lists hold absolute addresses only
+			address()
+		case locationListGNUStartEnd:
+			low := address()
+			add(LocationEntry{AddressRange: AddressRange{Low: low, High: address()}})
+		case locationListGNUStartLength:
+			low := address()
+			add(LocationEntry{AddressRange: AddressRange{Low: low, High: low + MemoryAddress(buf.uint32())}})
+		default:
+			buf.fail("unknown location list entry 0x%x", kind)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return nil, buf.err
+}
@@ -0,0 +1,433 @@
+package elf
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// DWARF package index sections of .dwp files
+const (
+	DWARFPackageCUIndexSectionName = ".debug_cu_index"
+	DWARFPackageTUIndexSectionName = ".debug_tu_index"
+)
+
+var ErrSplitUnitNotFound = errors.New("split unit not found")
+
+// DWARFContribution is part of package section contributed by single unit
+type DWARFContribution struct {
+	Offset uint64
+	Size   uint64
+}
+
+// DWARFPackageUnit is single unit of .dwp package index
+type DWARFPackageUnit struct {
+	Signature uint64 // DWO id of compilation unit, type signature of type unit
+	// Contributions are parts of package sections by section names like .debug_info.dwo, sections shared by all units
+	// like .debug_str.dwo are not listed
+	Contributions map[string]DWARFContribution
+}
+
+// DWARFPackageIndex is decoded .debug_cu_index or .debug_tu_index section of .dwp package
+type DWARFPackageIndex struct {
+	Version uint32 // 2 for GNU packages of DWARF 4 units, 5 for DWARF 5
+	Units   []DWARFPackageUnit
+
+	signatures []uint64
+	rows       []uint32 // one-based indexes of Units in hash table slots, zero for empty slot
+}
+
+// section names by identifiers of index columns
+var (
+	dwarfPackageColumnsV2 = map[uint32]string{
+		1: ".debug_info.dwo",
+		2: ".debug_types.dwo",
+		3: ".debug_abbrev.dwo",
+		4: ".debug_line.dwo",
+		5: ".debug_loc.dwo",
+		6: ".debug_str_offsets.dwo",
+		7: ".debug_macinfo.dwo",
+		8: ".debug_macro.dwo",
+	}
+	dwarfPackageColumnsV5 = map[uint32]string{
+		1: ".debug_info.dwo",
+		3: ".debug_abbrev.dwo",
+		4: ".debug_line.dwo",
+		5: ".debug_loclists.dwo",
+		6: ".debug_str_offsets.dwo",
+		7: ".debug_macro.dwo",
+		8: ".debug_rnglists.dwo",
+	}
+)
+
+// DWARFPackageIndex decodes index section of .dwp package, name is DWARFPackageCUIndexSectionName or
+// DWARFPackageTUIndexSectionName
+func (f *File) DWARFPackageIndex(name string) (*DWARFPackageIndex, error) {
+	content, err := f.DWARFSection(name)
+	if err != nil {
+		return nil, err
+	}
+	return readDWARFPackageIndex(name, content, f.ByteOrder())
+}
+
+func readDWARFPackageIndex(name string, content []byte, byteOrder binary.ByteOrder) (*DWARFPackageIndex, error) {
+	buf := &dwarfBuffer{section: name, data: content, byteOrder: byteOrder}
+	index := &DWARFPackageIndex{}
+	// DWARF 5 index starts with 2 byte version and padding, GNU one with 4 byte version
+	if version := buf.uint16(); version == 5 {
+		index.Version = uint32(version)
+		buf.skip(2)
+	} else {
+		buf.offset = 0
+		index.Version = buf.uint32()
+	}
+	columns := dwarfPackageColumnsV5
+	switch {
+	case buf.err != nil:
+		return nil, buf.err
+	case index.Version == 2:
+		columns = dwarfPackageColumnsV2
+	case index.Version != 5:
+		return nil, fmt.Errorf("%w %v has unsupported version %v", ErrInvalidDWARF, name, index.Version)
+	}
+
+	columnCount, unitCount, slotCount := buf.uint32(), buf.uint32(), buf.uint32()
+	if buf.err != nil {
+		return nil, buf.err
+	}
+	if slotCount&(slotCount-1) != 0 || unitCount > slotCount || columnCount > uint32(len(columns)) {
+		return nil, fmt.Errorf("%w %v has %v slots for %v units with %v columns", ErrInvalidDWARF, name, slotCount, unitCount, columnCount)
+	}
+	// hash table, column section identifiers and offsets and sizes tables must fit
+	if size := uint64(slotCount)*12 + uint64(columnCount)*4 + uint64(unitCount)*uint64(columnCount)*8; size > uint64(len(content))-buf.offset {
+		return nil, fmt.Errorf("%w %v tables of size 0x%x overflow section", ErrInvalidDWARF, name, size)
+	}
+
+	index.signatures = make([]uint64, slotCount)
+	for i := range index.signatures {
+		index.signatures[i] = buf.uint64()
+	}
+	index.rows = make([]uint32, slotCount)
+	for i := range index.rows {
+		if index.rows[i] = buf.uint32(); index.rows[i] > unitCount {
+			buf.fail("slot %v refers to row %v out of %v", i, index.rows[i], unitCount)
+		}
+	}
+	names := make([]string, columnCount)
+	for i := range names {
+		id := buf.uint32()
+		if names[i] = columns[id]; names[i] == "" && buf.err == nil {
+			buf.fail("unknown section identifier %v", id)
+		}
+	}
+	index.Units = make([]DWARFPackageUnit, unitCount)
+	for i := range index.Units {
+		index.Units[i].Contributions = make(map[string]DWARFContribution, columnCount)
+		for _, name := range names {
+			index.Units[i].Contributions[name] = DWARFContribution{Offset: uint64(buf.uint32())}
+		}
+	}
+	for i := range index.Units {
+		for _, name := range names {
+			contribution := index.Units[i].Contributions[name]
+			contribution.Size = uint64(buf.uint32())
+			index.Units[i].Contributions[name] = contribution
+		}
+	}
+	for slot, row := range index.rows {
+		if row != 0 {
+			index.Units[row-1].Signature = index.signatures[slot]
+		}
+	}
+	if buf.err != nil {
+		return nil, buf.err
+	}
+	return index, nil
+}
+
+// Find looks unit up by its signature in hash table of index
+func (i *DWARFPackageIndex) Find(signature uint64) (*DWARFPackageUnit, bool) {
+	if len(i.signatures) == 0 {
+		return nil, false
+	}
+	mask := uint64(len(i.signatures) - 1)
+	slot := signature & mask
+	step := (signature>>32)&mask | 1
+	for probes := 0; probes < len(i.signatures); probes++ {
+		row := i.rows[slot]
+		if row == 0 {
+			return nil, false
+		}
+		if i.signatures[slot] == signature {
+			return &i.Units[row-1], true
+		}
+		slot = (slot + step) & mask
+	}
+	return nil, false
+}
+
+// IsSkeleton reports if unit is skeleton of split unit kept in .dwo file or .dwp package
+func (u *CompilationUnit) IsSkeleton() bool {
+	return u.Type == DWARFUnitSkeleton
+}
+
+// DWOName returns DW_AT_dwo_name or DW_AT_GNU_dwo_name of skeleton unit, relative names are relative to DW_AT_comp_dir
+func (u *CompilationUnit) DWOName() string {
+	if u.entry == nil {
+		return ""
+	}
+	if name, ok := u.entry.Value(DWARFAttrDWOName).(string); ok {
+		return name
+	}
+	name, _ := u.entry.Value(DWARFAttrGNUDWOName).(string)
+	return name
+}
+
+// Skeleton returns skeleton unit of split unit, nil for other units
+func (u *CompilationUnit) Skeleton() *CompilationUnit {
+	return u.data.skeleton
+}
+
+// DWARF returns data unit belongs to, for split units it is data of .dwo file or of unit contribution to .dwp package
+func (u *CompilationUnit) DWARF() *DWARFData {
+	return u.data
+}
+
+// splitSections are .dwo sections of .dwo file or .dwp package
+type splitSections struct {
+	sections map[string][]byte
+	cuIndex  *DWARFPackageIndex // nil for .dwo files
+}
+
+var splitSectionNames = map[string]bool{
+	".debug_info.dwo":        true,
+	".debug_abbrev.dwo":      true,
+	".debug_str.dwo":         true,
+	".debug_str_offsets.dwo": true,
+	".debug_line.dwo":        true,
+	".debug_loc.dwo":         true,
+	".debug_loclists.dwo":    true,
+	".debug_rnglists.dwo":    true,
+}
+
+func (f *File) splitSections() (*splitSections, error) {
+	f.splitSectionsOnce.Do(func() {
+		f.splitSectionsCache, f.splitSectionsErr = f.readSplitSections()
+	})
+	return f.splitSectionsCache, f.splitSectionsErr
+}
+
+func (f *File) readSplitSections() (*splitSections, error) {
+	split := &splitSections{sections: map[string][]byte{}}
+	for _, section := range f.Sections {
+		if !splitSectionNames[section.Name] {
+			continue
+		}
+		content, err := section.Data()
+		if err != nil {
+			return nil, fmt.Errorf("%w %v: %v", ErrInvalidDWARF, section.Name, err)
+		}
+		// compilers put each type unit of .dwo file to its own .debug_info.dwo section
+		split.sections[section.Name] = append(split.sections[section.Name], content...)
+	}
+	if split.sections[".debug_info.dwo"] == nil {
+		return nil, fmt.Errorf("%w: .debug_info.dwo", ErrNoDWARFSection)
+	}
+	if f.Section(DWARFPackageCUIndexSectionName) != nil {
+		var err error
+		if split.cuIndex, err = f.DWARFPackageIndex(DWARFPackageCUIndexSectionName); err != nil {
+			return nil, err
+		}
+	}
+	return split, nil
+}
+
+// SplitUnit reads split unit of skeleton unit from file, which is .dwo file or .dwp package. Split unit shares
+// addresses, line table and before DWARF 5 also ranges of skeleton, so file of skeleton must stay open while split unit
+// is used. Returns ErrSplitUnitNotFound if file has no unit with DWO id of skeleton. Split units are read once per
+// DWO id and skeleton
+func (f *File) SplitUnit(skeleton *CompilationUnit) (*CompilationUnit, error) {
+	if !skeleton.IsSkeleton() {
+		return nil, fmt.Errorf("%w unit at 0x%x is not skeleton unit", ErrSplitUnitNotFound, skeleton.Offset)
+	}
+	f.splitUnitsLock.Lock()
+	defer f.splitUnitsLock.Unlock()
+	// split unit is bound to sections of its skeleton, so skeleton of other file with the same DWO id reads it again
+	if unit, ok := f.splitUnits[skeleton.ID]; ok && unit.data.skeleton == skeleton {
+		return unit, nil
+	}
+	unit, err := f.readSplitUnit(skeleton)
+	if err != nil {
+		return nil, err
+	}
+	if f.splitUnits == nil {
+		f.splitUnits = map[uint64]*CompilationUnit{}
+	}
+	f.splitUnits[skeleton.ID] = unit
+	return unit, nil
+}
+
+func (f *File) readSplitUnit(skeleton *CompilationUnit) (*CompilationUnit, error) {
+	split, err := f.splitSections()
+	if err != nil {
+		return nil, err
+	}
+	section := func(name string) ([]byte, error) {
+		return split.sections[name], nil
+	}
+	if split.cuIndex != nil {
+		unit, ok := split.cuIndex.Find(skeleton.ID)
+		if !ok {
+			return nil, fmt.Errorf("%w DWO id 0x%x is not in package index", ErrSplitUnitNotFound, skeleton.ID)
+		}
+		section = func(name string) ([]byte, error) {
+			content := split.sections[name]
+			contribution, ok := unit.Contributions[name]
+			if !ok {
+				return nil, nil
+			}
+			if contribution.Offset > uint64(len(content)) || contribution.Size > uint64(len(content))-contribution.Offset {
+				return nil, fmt.Errorf("%w %v contribution at 0x%x of size 0x%x overflows section", ErrInvalidDWARF, name, contribution.Offset, contribution.Size)
+			}
+			return content[contribution.Offset : contribution.Offset+contribution.Size], nil
+		}
+	}
+
+	data := &DWARFData{
+		file:      f,
+		byteOrder: f.ByteOrder(),
+		sections: dwarfSections{
+			str:    split.sections[".debug_str.dwo"],
+			addr:   skeleton.data.sections.addr,
+			ranges: skeleton.data.sections.ranges,
+		},
+		skeleton: skeleton,
+	}
+	for name, content := range map[string]*[]byte{
+		".debug_info.dwo":        &data.sections.info,
+		".debug_abbrev.dwo":      &data.sections.abbrev,
+		".debug_str_offsets.dwo": &data.sections.strOffsets,
+		".debug_loc.dwo":         &data.sections.loc,
+		".debug_loclists.dwo":    &data.sections.locLists,
+		".debug_rnglists.dwo":    &data.sections.rngLists,
+	} {
+		if *content, err = section(name); err != nil {
+			return nil, err
+		}
+	}
+	line, err := section(".debug_line.dwo")
+	if err != nil {
+		return nil, err
+	}
+	data.lineSections = &lineSections{line: line, str: data.sections.str}
+
+	if err := data.readUnits(); err != nil {
+		return nil, err
+	}
+	for _, unit := range data.units {
+		if unit.Type == DWARFUnitSplitCompile && unit.ID == skeleton.ID {
+			return unit, nil
+		}
+	}
+	return nil, fmt.Errorf("%w DWO id 0x%x", ErrSplitUnitNotFound, skeleton.ID)
+}
+
+// SplitDWARFResolver finds split units of skeleton units in .dwp packages first, then in .dwo files named by skeleton
+// units. Opened files are kept open for following lookups until Close
+type SplitDWARFResolver struct {
+	Packages []string // paths of .dwp packages, GDB looks for one named after executable with .dwp suffix
+	// SearchPath lists directories searched for .dwo files not found relative to DW_AT_comp_dir of skeleton, both
+	// DW_AT_dwo_name and its base name are tried in each of them
+	SearchPath []string
+
+	files map[string]*File
+}
+
+// SplitUnit finds split unit of skeleton unit, see SplitDWARFResolver
+func (r *SplitDWARFResolver) SplitUnit(skeleton *CompilationUnit) (*CompilationUnit, error) {
+	if !skeleton.IsSkeleton() {
+		return nil, fmt.Errorf("%w unit at 0x%x is not skeleton unit", ErrSplitUnitNotFound, skeleton.Offset)
+	}
+	var rejected []string
+	for _, path := range r.Packages {
+		unit, err := r.splitUnitFrom(path, skeleton)
+		if err == nil {
+			return unit, nil
+		}
+		// packages hold units of many files, so missing unit is no reason to report package
+		if !errors.Is(err, ErrSplitUnitNotFound) {
+			rejected = append(rejected, fmt.Sprintf("%v: %v", path, err))
+		}
+	}
+	for _, candidate := range dwoCandidates(skeleton, r.SearchPath) {
+		unit, err := r.splitUnitFrom(candidate, skeleton)
+		if err == nil {
+			return unit, nil
+		}
+		if !errors.Is(err, os.ErrNotExist) {
+			rejected = append(rejected, fmt.Sprintf("%v: %v", candidate, err))
+		}
+	}
+	if len(rejected) > 0 {
+		return nil, fmt.Errorf("%w for DWO id 0x%x, rejected candidates: %v", ErrSplitUnitNotFound, skeleton.ID, strings.Join(rejected, "; "))
+	}
+	return nil, fmt.Errorf("%w for DWO id 0x%x", ErrSplitUnitNotFound, skeleton.ID)
+}
+
+// FunctionsForAddress returns frames of code at address like DWARFData.FunctionsForAddress, skeleton units are walked
+// through their split units
+func (r *SplitDWARFResolver) FunctionsForAddress(data *DWARFData, address MemoryAddress) ([]FunctionFrame, error) {
+	return data.functionsForAddress(address, r.SplitUnit)
+}
+
+// Close closes all opened .dwo files and packages
+func (r *SplitDWARFResolver) Close() error {
+	var err error
+	for path, file := range r.files {
+		if closeErr := file.Close(); closeErr != nil && err == nil {
+			err = closeErr
+		}
+		delete(r.files, path)
+	}
+	return err
+}
+
+func (r *SplitDWARFResolver) splitUnitFrom(path string, skeleton *CompilationUnit) (*CompilationUnit, error) {
+	file, ok := r.files[path]
+	if !ok {
+		var err error
+		if file, err = Open(path); err != nil {
+			return nil, err
+		}
+		if r.files == nil {
+			r.files = map[string]*File{}
+		}
+		r.files[path] = file
+	}
+	return file.SplitUnit(skeleton)
+}
+
+// dwoCandidates lists .dwo file locations - DW_AT_dwo_name relative to DW_AT_comp_dir, then the name and its base name
+// in each search directory
+func dwoCandidates(skeleton *CompilationUnit, searchPath []string) []string {
+	name := skeleton.DWOName()
+	if name == "" {
+		return nil
+	}
+	var candidates []string
+	if filepath.IsAbs(name) {
+		candidates = append(candidates, name)
+	} else if compDir, ok := skeleton.entry.Value(DWARFAttrCompDir).(string); ok {
+		candidates = append(candidates, filepath.Join(compDir, name))
+	}
+	for _, dir := range searchPath {
+		candidates = append(candidates, filepath.Join(dir, name))
+		if base := filepath.Base(name); base != name {
+			candidates = append(candidates, filepath.Join(dir, base))
+		}
+	}
+	return candidates
+}
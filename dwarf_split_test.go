@@ -0,0 +1,207 @@
+package elf
+
+import (
+	"encoding/binary"
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSplitUnits(t *testing.T) {
+	tcs := []struct {
+		filename string
+		version  uint16
+		packages []string
+	}{
+		{"split_linux_amd64", 5, nil},
+		{"split_linux_amd64", 5, []string{filepath.Join("testdata", "split_linux_amd64.dwp")}},
+		{"split_dwarf4_linux_amd64", 4, nil},
+		{"split_dwarf4_linux_amd64", 4, []string{filepath.Join("testdata", "split_dwarf4_linux_amd64.dwp")}},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.filename, func(t *testing.T) {
+			file, err := Open(filepath.Join("testdata", tc.filename))
+			assert.NoError(t, err)
+			defer file.Close()
+			data, err := file.DWARF()
+			assert.NoError(t, err)
+			resolver := &SplitDWARFResolver{Packages: tc.packages, SearchPath: []string{"testdata"}}
+			defer resolver.Close()
+
+			skeletons := data.Units()
+			assert.Len(t, skeletons, 2)
+			skeleton := skeletons[0]
+			assert.True(t, skeleton.IsSkeleton())
+			assert.Equal(t, tc.version, skeleton.Version)
+			assert.Equal(t, "../"+tc.filename+"-inline.dwo", skeleton.DWOName())
+			assert.Nil(t, skeleton.Skeleton())
+
+			unit, err := resolver.SplitUnit(skeleton)
+			assert.NoError(t, err)
+			assert.Equal(t, DWARFUnitSplitCompile, unit.Type)
+			assert.Equal(t, skeleton.ID, unit.ID)
+			assert.Same(t, skeleton, unit.Skeleton())
+			assert.Equal(t, skeleton.AddrBase, unit.AddrBase)
+			assert.Equal(t, "inline.c", unit.Entry().Name())
+			skeletonTable, err := skeleton.LineTable()
+			assert.NoError(t, err)
+			table, err := unit.LineTable()
+			assert.NoError(t, err)
+			assert.Same(t, skeletonTable, table)
+
+			again, err := resolver.SplitUnit(skeleton)
+			assert.NoError(t, err)
+			assert.Same(t, unit, again)
+
+			// compare with TestFunctionsForAddress of the same code built without split DWARF
+			frames, err := resolver.FunctionsForAddress(data, 0x11b0)
+			assert.NoError(t, err)
+			var names []string
+			for _, frame := range frames {
+				names = append(names, frame.Name)
+			}
+			assert.Equal(t, []string{"square", "sum_of_squares", "compute"}, names)
+			assert.Equal(t, "/root/module/testdata/src/inline.c", frames[0].CallFile)
+			assert.Equal(t, 13, frames[0].CallLine)
+			assert.Equal(t, 20, frames[1].CallLine)
+			compute := frames[2].Entry
+			ranges, err := compute.Ranges()
+			assert.NoError(t, err)
+			assert.Equal(t, []AddressRange{{0x1180, 0x11c4}}, ranges)
+			inlined, err := frames[1].Entry.Ranges()
+			assert.NoError(t, err)
+			assert.Equal(t, []AddressRange{{0x11a0, 0x11ad}, {0x11b0, 0x11bb}}, inlined)
+			_, err = data.FunctionsForAddress(0x11b0)
+			assert.True(t, errors.Is(err, ErrFunctionNotFound))
+
+			// location list of compute's loop counter, compare with llvm-dwarfdump --debug-info of .dwo file
+			reader := compute.Unit.EntriesAt(compute.Offset)
+			var counter *DIE
+			for {
+				die, err := reader.Next()
+				assert.NoError(t, err)
+				if die.Tag == DWARFTagVariable && die.Name() == "i" {
+					counter = die
+					break
+				}
+			}
+			locations, err := counter.Locations(DWARFAttrLocation)
+			assert.NoError(t, err)
+			assert.Len(t, locations, 5)
+			for _, location := range locations {
+				assert.False(t, location.Default)
+				assert.True(t, location.Low >= 0x1180 && location.High <= 0x11c4, "%v", location.AddressRange)
+			}
+
+			layoutUnit, err := resolver.SplitUnit(skeletons[1])
+			assert.NoError(t, err)
+			assert.Equal(t, "layout.c", layoutUnit.Entry().Name())
+			layouts, err := layoutUnit.DWARF().StructLayouts()
+			assert.NoError(t, err)
+			assert.Equal(t, "padded", layouts[0].Name)
+			assert.Equal(t, uint64(8), layouts[0].Savable)
+		})
+	}
+}
+
+func TestSplitUnitNotFound(t *testing.T) {
+	file, err := Open(filepath.Join("testdata", "split_linux_amd64"))
+	assert.NoError(t, err)
+	defer file.Close()
+	data, err := file.DWARF()
+	assert.NoError(t, err)
+	skeleton := data.Units()[0]
+
+	for _, filename := range []string{"split_linux_amd64-layout.dwo", "split_dwarf4_linux_amd64.dwp"} {
+		dwo, err := Open(filepath.Join("testdata", filename))
+		assert.NoError(t, err)
+		defer dwo.Close()
+		_, err = dwo.SplitUnit(skeleton)
+		assert.True(t, errors.Is(err, ErrSplitUnitNotFound), "%v", filename)
+	}
+
+	// not split
+	_, err = file.SplitUnit(skeleton)
+	assert.True(t, errors.Is(err, ErrNoDWARFSection))
+
+	inline, err := Open(filepath.Join("testdata", "inline_linux_amd64"))
+	assert.NoError(t, err)
+	defer inline.Close()
+	inlineData, err := inline.DWARF()
+	assert.NoError(t, err)
+	unit := inlineData.Units()[0]
+	assert.False(t, unit.IsSkeleton())
+	assert.Empty(t, unit.DWOName())
+	resolver := &SplitDWARFResolver{}
+	_, err = resolver.SplitUnit(unit)
+	assert.True(t, errors.Is(err, ErrSplitUnitNotFound))
+}
+
+func TestDWARFPackageIndex(t *testing.T) {
+	// compare with llvm-dwarfdump --debug-cu-index
+	tcs := []struct {
+		filename   string
+		version    uint32
+		signature  uint64
+		info, loc  DWARFContribution
+		locSection string
+	}{
+		{"split_linux_amd64.dwp", 5, 0x498afd802d6a90c7, DWARFContribution{0x28b, 0x207}, DWARFContribution{0, 0}, ".debug_loclists.dwo"},
+		{"split_dwarf4_linux_amd64.dwp", 2, 0xc6a242cb02fc0aef, DWARFContribution{0, 0x2e8}, DWARFContribution{0, 0x175}, ".debug_loc.dwo"},
+	}
+	for _, tc := range tcs {
+		file, err := Open(filepath.Join("testdata", tc.filename))
+		assert.NoError(t, err)
+		defer file.Close()
+
+		index, err := file.DWARFPackageIndex(DWARFPackageCUIndexSectionName)
+		assert.NoError(t, err)
+		assert.Equal(t, tc.version, index.Version)
+		assert.Len(t, index.Units, 2)
+		unit, ok := index.Find(tc.signature)
+		assert.True(t, ok)
+		assert.Equal(t, tc.signature, unit.Signature)
+		assert.Equal(t, tc.info, unit.Contributions[".debug_info.dwo"])
+		assert.Equal(t, tc.loc, unit.Contributions[tc.locSection])
+		_, ok = index.Find(0x1234)
+		assert.False(t, ok)
+
+		_, err = file.DWARFPackageIndex(DWARFPackageTUIndexSectionName)
+		assert.True(t, errors.Is(err, ErrNoDWARFSection))
+	}
+}
+
+func TestDWARFPackageIndexCollisions(t *testing.T) {
+	// both signatures hash to slot 1, the second one probes slot 0 next
+	first, second := uint64(0x100000001), uint64(0x200000001)
+	var w dwarf64Writer
+	w.values(
+		uint16(5), uint16(0), uint32(1), uint32(2), uint32(4),
+		second, first, uint64(0), uint64(0),
+		uint32(2), uint32(1), uint32(0), uint32(0),
+		uint32(1), // DW_SECT_INFO
+		uint32(0), uint32(0x40),
+		uint32(0x40), uint32(0x20),
+	)
+	index, err := readDWARFPackageIndex(DWARFPackageTUIndexSectionName, w.Bytes(), binary.LittleEndian)
+	assert.NoError(t, err)
+
+	unit, ok := index.Find(first)
+	assert.True(t, ok)
+	assert.Equal(t, DWARFContribution{0, 0x40}, unit.Contributions[".debug_info.dwo"])
+	unit, ok = index.Find(second)
+	assert.True(t, ok)
+	assert.Equal(t, DWARFContribution{0x40, 0x20}, unit.Contributions[".debug_info.dwo"])
+	_, ok = index.Find(0x500000001)
+	assert.False(t, ok)
+
+	_, err = readDWARFPackageIndex(DWARFPackageTUIndexSectionName, w.Bytes()[:20], binary.LittleEndian)
+	assert.True(t, errors.Is(err, ErrInvalidDWARF))
+	// slot count must be power of two
+	content := append([]byte(nil), w.Bytes()...)
+	content[12] = 3
+	_, err = readDWARFPackageIndex(DWARFPackageTUIndexSectionName, content, binary.LittleEndian)
+	assert.True(t, errors.Is(err, ErrInvalidDWARF))
+}
@@ -0,0 +1,565 @@
+package elf
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+)
+
+type DynamicTag int64
+
+const (
+	//0	DT_NULL	Marks end of dynamic section
+	DynamicTagNull DynamicTag = 0
+	//1	DT_NEEDED	String table offset to name of a needed library
+	DynamicTagNeeded DynamicTag = 1
+	//2	DT_PLTRELSZ	Size in bytes of PLT relocs
+	DynamicTagPLTRelSize DynamicTag = 2
+	//3	DT_PLTGOT	Processor defined value
+	DynamicTagPLTGOT DynamicTag = 3
+	//4	DT_HASH	Address of symbol hash table
+	DynamicTagHash DynamicTag = 4
+	//5	DT_STRTAB	Address of string table
+	DynamicTagStrTab DynamicTag = 5
+	//6	DT_SYMTAB	Address of symbol table
+	DynamicTagSymTab DynamicTag = 6
+	//7	DT_RELA	Address of Rela relocs
+	DynamicTagRela DynamicTag = 7
+	//8	DT_RELASZ	Total size of Rela relocs
+	DynamicTagRelaSize DynamicTag = 8
+	//9	DT_RELAENT	Size of one Rela reloc
+	DynamicTagRelaEnt DynamicTag = 9
+	//10	DT_STRSZ	Size of string table
+	DynamicTagStrSize DynamicTag = 10
+	//11	DT_SYMENT	Size of one symbol table entry
+	DynamicTagSymEnt DynamicTag = 11
+	//12	DT_INIT	Address of init function
+	DynamicTagInit DynamicTag = 12
+	//13	DT_FINI	Address of termination function
+	DynamicTagFini DynamicTag = 13
+	//14	DT_SONAME	String table offset to name of shared object
+	DynamicTagSOName DynamicTag = 14
+	//15	DT_RPATH	String table offset to library search path (deprecated)
+	DynamicTagRPath DynamicTag = 15
+	//16	DT_SYMBOLIC	Start symbol search here
+	DynamicTagSymbolic DynamicTag = 16
+	//17	DT_REL	Address of Rel relocs
+	DynamicTagRel DynamicTag = 17
+	//18	DT_RELSZ	Total size of Rel relocs
+	DynamicTagRelSize DynamicTag = 18
+	//19	DT_RELENT	Size of one Rel reloc
+	DynamicTagRelEnt DynamicTag = 19
+	//20	DT_PLTREL	Type of reloc in PLT
+	DynamicTagPLTRel DynamicTag = 20
+	//21	DT_DEBUG	For debugging, unspecified
+	DynamicTagDebug DynamicTag = 21
+	//22	DT_TEXTREL	Reloc might modify .text
+	DynamicTagTextRel DynamicTag = 22
+	//23	DT_JMPREL	Address of PLT relocs
+	DynamicTagJmpRel DynamicTag = 23
+	//24	DT_BIND_NOW	Process relocations of object
+	DynamicTagBindNow DynamicTag = 24
+	//25	DT_INIT_ARRAY	Array with addresses of init functions
+	DynamicTagInitArray DynamicTag = 25
+	//26	DT_FINI_ARRAY	Array with addresses of fini functions
+	DynamicTagFiniArray DynamicTag = 26
+	//27	DT_INIT_ARRAYSZ	Size in bytes of DT_INIT_ARRAY
+	DynamicTagInitArraySize DynamicTag = 27
+	//28	DT_FINI_ARRAYSZ	Size in bytes of DT_FINI_ARRAY
+	DynamicTagFiniArraySize DynamicTag = 28
+	//29	DT_RUNPATH	String table offset to library search path
+	DynamicTagRunPath DynamicTag = 29
+	//30	DT_FLAGS	Flags for the object being loaded
+	DynamicTagFlags DynamicTag = 30
+	//32	DT_PREINIT_ARRAY	Array with addresses of preinit functions, also DT_ENCODING
+	DynamicTagPreInitArray DynamicTag = 32
+	//33	DT_PREINIT_ARRAYSZ	Size in bytes of DT_PREINIT_ARRAY
+	DynamicTagPreInitArraySize DynamicTag = 33
+	//34	DT_SYMTAB_SHNDX	Address of SYMTAB_SHNDX section
+	DynamicTagSymTabShndx DynamicTag = 34
+	//35	DT_RELRSZ	Total size of RELR relative relocations
+	DynamicTagRelrSize DynamicTag = 35
+	//36	DT_RELR	Address of RELR relative relocations
+	DynamicTagRelr DynamicTag = 36
+	//37	DT_RELRENT	Size of one RELR relative relocation
+	DynamicTagRelrEnt DynamicTag = 37
+
+	//0x6000000d	DT_LOOS	Start of OS-specific
+	DynamicTagLowOS DynamicTag = 0x6000000d
+	//0x6000000f	DT_ANDROID_REL	Android packed Rel relocs
+	DynamicTagAndroidRel DynamicTag = 0x6000000f
+	//0x60000010	DT_ANDROID_RELSZ
+	DynamicTagAndroidRelSize DynamicTag = 0x60000010
+	//0x60000011	DT_ANDROID_RELA	Android packed Rela relocs
+	DynamicTagAndroidRela DynamicTag = 0x60000011
+	//0x60000012	DT_ANDROID_RELASZ
+	DynamicTagAndroidRelaSize DynamicTag = 0x60000012
+	//0x6fffe000	DT_ANDROID_RELR	Android RELR relative relocations
+	DynamicTagAndroidRelr DynamicTag = 0x6fffe000
+	//0x6fffe001	DT_ANDROID_RELRSZ
+	DynamicTagAndroidRelrSize DynamicTag = 0x6fffe001
+	//0x6fffe003	DT_ANDROID_RELRENT
+	DynamicTagAndroidRelrEnt DynamicTag = 0x6fffe003
+	//0x6ffff000	DT_HIOS	End of OS-specific
+	DynamicTagHiOS DynamicTag = 0x6ffff000
+
+	//0x6ffffd00	DT_VALRNGLO	Start of entries using d_val
+	DynamicTagValRangeLow DynamicTag = 0x6ffffd00
+	//0x6ffffdf5	DT_GNU_PRELINKED	Prelinking timestamp
+	DynamicTagGNUPrelinked DynamicTag = 0x6ffffdf5
+	//0x6ffffdf6	DT_GNU_CONFLICTSZ	Size of conflict section
+	DynamicTagGNUConflictSize DynamicTag = 0x6ffffdf6
+	//0x6ffffdf7	DT_GNU_LIBLISTSZ	Size of library list
+	DynamicTagGNULibListSize DynamicTag = 0x6ffffdf7
+	//0x6ffffdf8	DT_CHECKSUM
+	DynamicTagChecksum DynamicTag = 0x6ffffdf8
+	//0x6ffffdf9	DT_PLTPADSZ
+	DynamicTagPLTPadSize DynamicTag = 0x6ffffdf9
+	//0x6ffffdfa	DT_MOVEENT
+	DynamicTagMoveEnt DynamicTag = 0x6ffffdfa
+	//0x6ffffdfb	DT_MOVESZ
+	DynamicTagMoveSize DynamicTag = 0x6ffffdfb
+	//0x6ffffdfc	DT_FEATURE_1	Feature selection (DTF_*)
+	DynamicTagFeature1 DynamicTag = 0x6ffffdfc
+	//0x6ffffdfd	DT_POSFLAG_1	Flags for DT_* entries, effecting the following DT_* entry
+	DynamicTagPosFlag1 DynamicTag = 0x6ffffdfd
+	//0x6ffffdfe	DT_SYMINSZ	Size of syminfo table (in bytes)
+	DynamicTagSymInfoSize DynamicTag = 0x6ffffdfe
+	//0x6ffffdff	DT_SYMINENT	Entry size of syminfo, also DT_VALRNGHI
+	DynamicTagSymInfoEnt DynamicTag = 0x6ffffdff
+
+	//0x6ffffe00	DT_ADDRRNGLO	Start of entries using d_ptr
+	DynamicTagAddrRangeLow DynamicTag = 0x6ffffe00
+	//0x6ffffef5	DT_GNU_HASH	GNU-style hash table
+	DynamicTagGNUHash DynamicTag = 0x6ffffef5
+	//0x6ffffef6	DT_TLSDESC_PLT
+	DynamicTagTLSDescPLT DynamicTag = 0x6ffffef6
+	//0x6ffffef7	DT_TLSDESC_GOT
+	DynamicTagTLSDescGOT DynamicTag = 0x6ffffef7
+	//0x6ffffef8	DT_GNU_CONFLICT	Start of conflict section
+	DynamicTagGNUConflict DynamicTag = 0x6ffffef8
+	//0x6ffffef9	DT_GNU_LIBLIST	Library list
+	DynamicTagGNULibList DynamicTag = 0x6ffffef9
+	//0x6ffffefa	DT_CONFIG	Configuration information
+	DynamicTagConfig DynamicTag = 0x6ffffefa
+	//0x6ffffefb	DT_DEPAUDIT	Dependency auditing
+	DynamicTagDepAudit DynamicTag = 0x6ffffefb
+	//0x6ffffefc	DT_AUDIT	Object auditing
+	DynamicTagAudit DynamicTag = 0x6ffffefc
+	//0x6ffffefd	DT_PLTPAD	PLT padding
+	DynamicTagPLTPad DynamicTag = 0x6ffffefd
+	//0x6ffffefe	DT_MOVETAB	Move table
+	DynamicTagMoveTab DynamicTag = 0x6ffffefe
+	//0x6ffffeff	DT_SYMINFO	Syminfo table, also DT_ADDRRNGHI
+	DynamicTagSymInfo DynamicTag = 0x6ffffeff
+
+	//0x6ffffff0	DT_VERSYM	Address of symbol versions table
+	DynamicTagVerSym DynamicTag = 0x6ffffff0
+	//0x6ffffff9	DT_RELACOUNT	Count of relative Rela relocs
+	DynamicTagRelaCount DynamicTag = 0x6ffffff9
+	//0x6ffffffa	DT_RELCOUNT	Count of relative Rel relocs
+	DynamicTagRelCount DynamicTag = 0x6ffffffa
+	//0x6ffffffb	DT_FLAGS_1	State flags (DF_1_*)
+	DynamicTagFlags1 DynamicTag = 0x6ffffffb
+	//0x6ffffffc	DT_VERDEF	Address of version definition table
+	DynamicTagVerDef DynamicTag = 0x6ffffffc
+	//0x6ffffffd	DT_VERDEFNUM	Number of version definitions
+	DynamicTagVerDefNum DynamicTag = 0x6ffffffd
+	//0x6ffffffe	DT_VERNEED	Address of table with needed versions
+	DynamicTagVerNeed DynamicTag = 0x6ffffffe
+	//0x6fffffff	DT_VERNEEDNUM	Number of needed versions
+	DynamicTagVerNeedNum DynamicTag = 0x6fffffff
+
+	//0x70000000	DT_LOPROC	Start of processor-specific
+	DynamicTagLowProc DynamicTag = 0x70000000
+	//0x7ffffffd	DT_AUXILIARY	Shared object to load before self
+	DynamicTagAuxiliary DynamicTag = 0x7ffffffd
+	//0x7ffffffe	DT_USED	Same as DT_NEEDED
+	DynamicTagUsed DynamicTag = 0x7ffffffe
+	//0x7fffffff	DT_FILTER	Shared object to get values from, also DT_HIPROC
+	DynamicTagFilter DynamicTag = 0x7fffffff
+)
+
+var dynamicTagNames = map[DynamicTag]string{
+	DynamicTagNull:             "NULL",
+	DynamicTagNeeded:           "NEEDED",
+	DynamicTagPLTRelSize:       "PLTRELSZ",
+	DynamicTagPLTGOT:           "PLTGOT",
+	DynamicTagHash:             "HASH",
+	DynamicTagStrTab:           "STRTAB",
+	DynamicTagSymTab:           "SYMTAB",
+	DynamicTagRela:             "RELA",
+	DynamicTagRelaSize:         "RELASZ",
+	DynamicTagRelaEnt:          "RELAENT",
+	DynamicTagStrSize:          "STRSZ",
+	DynamicTagSymEnt:           "SYMENT",
+	DynamicTagInit:             "INIT",
+	DynamicTagFini:             "FINI",
+	DynamicTagSOName:           "SONAME",
+	DynamicTagRPath:            "RPATH",
+	DynamicTagSymbolic:         "SYMBOLIC",
+	DynamicTagRel:              "REL",
+	DynamicTagRelSize:          "RELSZ",
+	DynamicTagRelEnt:           "RELENT",
+	DynamicTagPLTRel:           "PLTREL",
+	DynamicTagDebug:            "DEBUG",
+	DynamicTagTextRel:          "TEXTREL",
+	DynamicTagJmpRel:           "JMPREL",
+	DynamicTagBindNow:          "BIND_NOW",
+	DynamicTagInitArray:        "INIT_ARRAY",
+	DynamicTagFiniArray:        "FINI_ARRAY",
+	DynamicTagInitArraySize:    "INIT_ARRAYSZ",
+	DynamicTagFiniArraySize:    "FINI_ARRAYSZ",
+	DynamicTagRunPath:          "RUNPATH",
+	DynamicTagFlags:            "FLAGS",
+	DynamicTagPreInitArray:     "PREINIT_ARRAY",
+	DynamicTagPreInitArraySize: "PREINIT_ARRAYSZ",
+	DynamicTagSymTabShndx:      "SYMTAB_SHNDX",
+	DynamicTagRelrSize:         "RELRSZ",
+	DynamicTagRelr:             "RELR",
+	DynamicTagRelrEnt:          "RELRENT",
+	DynamicTagAndroidRel:       "ANDROID_REL",
+	DynamicTagAndroidRelSize:   "ANDROID_RELSZ",
+	DynamicTagAndroidRela:      "ANDROID_RELA",
+	DynamicTagAndroidRelaSize:  "ANDROID_RELASZ",
+	DynamicTagAndroidRelr:      "ANDROID_RELR",
+	DynamicTagAndroidRelrSize:  "ANDROID_RELRSZ",
+	DynamicTagAndroidRelrEnt:   "ANDROID_RELRENT",
+	DynamicTagGNUPrelinked:     "GNU_PRELINKED",
+	DynamicTagGNUConflictSize:  "GNU_CONFLICTSZ",
+	DynamicTagGNULibListSize:   "GNU_LIBLISTSZ",
+	DynamicTagChecksum:         "CHECKSUM",
+	DynamicTagPLTPadSize:       "PLTPADSZ",
+	DynamicTagMoveEnt:          "MOVEENT",
+	DynamicTagMoveSize:         "MOVESZ",
+	DynamicTagFeature1:         "FEATURE_1",
+	DynamicTagPosFlag1:         "POSFLAG_1",
+	DynamicTagSymInfoSize:      "SYMINSZ",
+	DynamicTagSymInfoEnt:       "SYMINENT",
+	DynamicTagGNUHash:          "GNU_HASH",
+	DynamicTagTLSDescPLT:       "TLSDESC_PLT",
+	DynamicTagTLSDescGOT:       "TLSDESC_GOT",
+	DynamicTagGNUConflict:      "GNU_CONFLICT",
+	DynamicTagGNULibList:       "GNU_LIBLIST",
+	DynamicTagConfig:           "CONFIG",
+	DynamicTagDepAudit:         "DEPAUDIT",
+	DynamicTagAudit:            "AUDIT",
+	DynamicTagPLTPad:           "PLTPAD",
+	DynamicTagMoveTab:          "MOVETAB",
+	DynamicTagSymInfo:          "SYMINFO",
+	DynamicTagVerSym:           "VERSYM",
+	DynamicTagRelaCount:        "RELACOUNT",
+	DynamicTagRelCount:         "RELCOUNT",
+	DynamicTagFlags1:           "FLAGS_1",
+	DynamicTagVerDef:           "VERDEF",
+	DynamicTagVerDefNum:        "VERDEFNUM",
+	DynamicTagVerNeed:          "VERNEED",
+	DynamicTagVerNeedNum:       "VERNEEDNUM",
+	DynamicTagAuxiliary:        "AUXILIARY",
+	DynamicTagUsed:             "USED",
+	DynamicTagFilter:           "FILTER",
+}
+
+func (dt DynamicTag) String() string {
+	if name, ok := dynamicTagNames[dt]; ok {
+		return name
+	}
+	switch {
+	case dt >= DynamicTagLowOS && dt <= DynamicTagHiOS:
+		return fmt.Sprintf("OS specific: 0x%08X", uint64(dt))
+	case dt >= DynamicTagLowProc && dt <= DynamicTagFilter:
+		return fmt.Sprintf("proc specific: 0x%08X", uint64(dt))
+	}
+	return fmt.Sprintf("unknown: 0x%08X", uint64(dt))
+}
+
+// DynamicFlags is value of DT_FLAGS entry
+type DynamicFlags uint64
+
+const (
+	//0x01	DF_ORIGIN	Object may use DF_ORIGIN
+	DynamicFlagOrigin DynamicFlags = 0x01
+	//0x02	DF_SYMBOLIC	Symbol resolutions starts here
+	DynamicFlagSymbolic DynamicFlags = 0x02
+	//0x04	DF_TEXTREL	Object contains text relocations
+	DynamicFlagTextRel DynamicFlags = 0x04
+	//0x08	DF_BIND_NOW	No lazy binding for this object
+	DynamicFlagBindNow DynamicFlags = 0x08
+	//0x10	DF_STATIC_TLS	Module uses the static TLS model
+	DynamicFlagStaticTLS DynamicFlags = 0x10
+)
+
+var dynamicFlagNames = [...]string{"ORIGIN", "SYMBOLIC", "TEXTREL", "BIND_NOW", "STATIC_TLS"}
+
+func (df DynamicFlags) String() string {
+	return flagNames(uint64(df), dynamicFlagNames[:])
+}
+
+// DynamicFlags1 is value of DT_FLAGS_1 entry
+type DynamicFlags1 uint64
+
+const (
+	//0x00000001	DF_1_NOW	Set RTLD_NOW for this object
+	DynamicFlag1Now DynamicFlags1 = 1 << iota
+	//0x00000002	DF_1_GLOBAL	Set RTLD_GLOBAL for this object
+	DynamicFlag1Global
+	//0x00000004	DF_1_GROUP	Set RTLD_GROUP for this object
+	DynamicFlag1Group
+	//0x00000008	DF_1_NODELETE	Set RTLD_NODELETE for this object
+	DynamicFlag1NoDelete
+	//0x00000010	DF_1_LOADFLTR	Trigger filtee loading at runtime
+	DynamicFlag1LoadFilter
+	//0x00000020	DF_1_INITFIRST	Set RTLD_INITFIRST for this object
+	DynamicFlag1InitFirst
+	//0x00000040	DF_1_NOOPEN	Set RTLD_NOOPEN for this object
+	DynamicFlag1NoOpen
+	//0x00000080	DF_1_ORIGIN	$ORIGIN must be handled
+	DynamicFlag1Origin
+	//0x00000100	DF_1_DIRECT	Direct binding enabled
+	DynamicFlag1Direct
+	//0x00000200	DF_1_TRANS
+	DynamicFlag1Trans
+	//0x00000400	DF_1_INTERPOSE	Object is used to interpose
+	DynamicFlag1Interpose
+	//0x00000800	DF_1_NODEFLIB	Ignore default lib search path
+	DynamicFlag1NoDefLib
+	//0x00001000	DF_1_NODUMP	Object can't be dldump'ed
+	DynamicFlag1NoDump
+	//0x00002000	DF_1_CONFALT	Configuration alternative created
+	DynamicFlag1ConfAlt
+	//0x00004000	DF_1_ENDFILTEE	Filtee terminates filters search
+	DynamicFlag1EndFiltee
+	//0x00008000	DF_1_DISPRELDNE	Disp reloc applied at build time
+	DynamicFlag1DispRelDone
+	//0x00010000	DF_1_DISPRELPND	Disp reloc applied at run-time
+	DynamicFlag1DispRelPending
+	//0x00020000	DF_1_NODIRECT	Object has no-direct binding
+	DynamicFlag1NoDirect
+	//0x00040000	DF_1_IGNMULDEF
+	DynamicFlag1IgnoreMultipleDefs
+	//0x00080000	DF_1_NOKSYMS
+	DynamicFlag1NoKSyms
+	//0x00100000	DF_1_NOHDR
+	DynamicFlag1NoHeader
+	//0x00200000	DF_1_EDITED	Object is modified after built
+	DynamicFlag1Edited
+	//0x00400000	DF_1_NORELOC
+	DynamicFlag1NoReloc
+	//0x00800000	DF_1_SYMINTPOSE	Object has individual interposers
+	DynamicFlag1SymInterpose
+	//0x01000000	DF_1_GLOBAUDIT	Global auditing required
+	DynamicFlag1GlobalAudit
+	//0x02000000	DF_1_SINGLETON	Singleton symbols are used
+	DynamicFlag1Singleton
+	//0x04000000	DF_1_STUB
+	DynamicFlag1Stub
+	//0x08000000	DF_1_PIE	Object is position independent executable
+	DynamicFlag1PIE
+	//0x10000000	DF_1_KMOD
+	DynamicFlag1KernelModule
+	//0x20000000	DF_1_WEAKFILTER
+	DynamicFlag1WeakFilter
+	//0x40000000	DF_1_NOCOMMON
+	DynamicFlag1NoCommon
+)
+
+var dynamicFlag1Names = [...]string{
+	"NOW", "GLOBAL", "GROUP", "NODELETE", "LOADFLTR", "INITFIRST", "NOOPEN", "ORIGIN",
+	"DIRECT", "TRANS", "INTERPOSE", "NODEFLIB", "NODUMP", "CONFALT", "ENDFILTEE", "DISPRELDNE",
+	"DISPRELPND", "NODIRECT", "IGNMULDEF", "NOKSYMS", "NOHDR", "EDITED", "NORELOC", "SYMINTPOSE",
+	"GLOBAUDIT", "SINGLETON", "STUB", "PIE", "KMOD", "WEAKFILTER", "NOCOMMON",
+}
+
+func (df DynamicFlags1) String() string {
+	return flagNames(uint64(df), dynamicFlag1Names[:])
+}
+
+// flagNames joins names of set bits, where names[i] is name of bit i. Unknown bits are printed as hex
+func flagNames(flags uint64, names []string) string {
+	var set []string
+	for i, name := range names {
+		if flags&(1<<uint(i)) != 0 {
+			set = append(set, name)
+			flags &^= 1 << uint(i)
+		}
+	}
+	if flags != 0 {
+		set = append(set, fmt.Sprintf("0x%X", flags))
+	}
+	return strings.Join(set, " ")
+}
+
+// DynamicEntry is single d_tag / d_val (or d_ptr) pair of dynamic section
+type DynamicEntry struct {
+	Tag   DynamicTag // 4 bytes signed on elf32 8 bytes on elf64
+	Value uint64     // 4 bytes on elf32 8 bytes on elf64
+}
+
+func (de DynamicEntry) String() string {
+	switch de.Tag {
+	case DynamicTagFlags:
+		return fmt.Sprintf("%v: %v", de.Tag, DynamicFlags(de.Value))
+	case DynamicTagFlags1:
+		return fmt.Sprintf("%v: %v", de.Tag, DynamicFlags1(de.Value))
+	}
+	return fmt.Sprintf("%v: 0x%X", de.Tag, de.Value)
+}
+
+var ErrInvalidDynamicEntry = errors.New("invalid dynamic entry")
+var ErrNoDynamicSection = errors.New("no dynamic section")
+
+func ReadDynamicEntry(nativeReader NativeWordReader) (DynamicEntry, error) {
+	var entry DynamicEntry
+
+	wordVal, err := nativeReader.ReadNativeWord()
+	if err != nil {
+		return entry, fmt.Errorf("%w tag read: %v", ErrInvalidDynamicEntry, err)
+	}
+	if nativeReader.Class == ELFClass32 {
+		entry.Tag = DynamicTag(int32(wordVal))
+	} else {
+		entry.Tag = DynamicTag(wordVal)
+	}
+
+	wordVal, err = nativeReader.ReadNativeWord()
+	if err != nil {
+		return entry, fmt.Errorf("%w value read: %v", ErrInvalidDynamicEntry, err)
+	}
+	entry.Value = wordVal
+
+	return entry, nil
+}
+
+// DynamicEntries returns entries of dynamic section up to DT_NULL or end of its content. PT_DYNAMIC segment is preferred,
+// so section headers are not required, SHT_DYNAMIC section is used only when there is no such segment
+func (f *File) DynamicEntries() ([]DynamicEntry, error) {
+	var content []byte
+	var err error
+	if segment := f.dynamicSegment(); segment != nil {
+		content, err = readAt(segment.reader, segment.FileOffset, segment.SizeInFile)
+	} else if sections := f.SectionsByType(SectionTypeDynLinkInfo); len(sections) > 0 {
+		content, err = sections[0].Data()
+	} else {
+		return nil, ErrNoDynamicSection
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w read: %v", ErrInvalidDynamicEntry, err)
+	}
+
+	reader := bytes.NewReader(content)
+	nativeReader := f.NativeReader(reader)
+	var entries []DynamicEntry
+	// like readelf, accept table which is not terminated by DT_NULL, but not partial entry at its end
+	for reader.Len() > 0 {
+		entry, err := ReadDynamicEntry(nativeReader)
+		if err != nil {
+			return nil, fmt.Errorf("entry %v: %w", len(entries), err)
+		}
+		if entry.Tag == DynamicTagNull {
+			return entries, nil
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+// ImportedLibraries returns names of DT_NEEDED libraries in order of appearance
+func (f *File) ImportedLibraries() ([]string, error) {
+	return f.dynamicStrings(DynamicTagNeeded)
+}
+
+// SONAME returns DT_SONAME value or empty string if object has none
+func (f *File) SONAME() (string, error) {
+	values, err := f.dynamicStrings(DynamicTagSOName)
+	if err != nil || len(values) == 0 {
+		return "", err
+	}
+	return values[0], nil
+}
+
+// RunPath returns DT_RUNPATH search path split into separate directories
+func (f *File) RunPath() ([]string, error) {
+	return f.dynamicSearchPath(DynamicTagRunPath)
+}
+
+// RPath returns deprecated DT_RPATH search path split into separate directories
+func (f *File) RPath() ([]string, error) {
+	return f.dynamicSearchPath(DynamicTagRPath)
+}
+
+func (f *File) dynamicSearchPath(tag DynamicTag) ([]string, error) {
+	values, err := f.dynamicStrings(tag)
+	if err != nil {
+		return nil, err
+	}
+	var paths []string
+	for _, value := range values {
+		paths = append(paths, strings.Split(value, ":")...)
+	}
+	return paths, nil
+}
+
+// dynamicStrings resolves values of all entries with given tag as offsets to DT_STRTAB string table
+func (f *File) dynamicStrings(tag DynamicTag) ([]string, error) {
+	entries, err := f.DynamicEntries()
+	if err != nil {
+		return nil, err
+	}
+	strTab, err := f.dynamicStringTable(entries)
+	if err != nil {
+		return nil, err
+	}
+	var values []string
+	for _, entry := range entries {
+		if entry.Tag != tag {
+			continue
+		}
+		if entry.Value > math.MaxUint32 {
+			return nil, fmt.Errorf("%w %v string offset 0x%x", ErrInvalidDynamicEntry, tag, entry.Value)
+		}
+		value, err := strTab.String(uint32(entry.Value))
+		if err != nil {
+			return nil, fmt.Errorf("%v: %w", tag, err)
+		}
+		values = append(values, value)
+	}
+	return values, nil
+}
+
+func (f *File) dynamicStringTable(entries []DynamicEntry) (StringTable, error) {
+	address, hasAddress := dynamicValue(entries, DynamicTagStrTab)
+	size, hasSize := dynamicValue(entries, DynamicTagStrSize)
+	if !hasAddress || !hasSize {
+		return nil, fmt.Errorf("%w missing DT_STRTAB or DT_STRSZ", ErrInvalidDynamicEntry)
+	}
+	offset, err := f.AddressToOffset(MemoryAddress(address))
+	if err != nil {
+		return nil, fmt.Errorf("%w DT_STRTAB: %v", ErrInvalidDynamicEntry, err)
+	}
+	content, err := readAt(f.reader, offset, size)
+	if err != nil {
+		return nil, fmt.Errorf("%w DT_STRTAB read: %v", ErrInvalidDynamicEntry, err)
+	}
+	return StringTable(content), nil
+}
+
+func (f *File) dynamicSegment() *Segment {
+	for _, segment := range f.Segments {
+		if segment.Type == SegmentTypeDynLink {
+			return segment
+		}
+	}
+	return nil
+}
+
+// dynamicValue returns value of first entry with given tag
+func dynamicValue(entries []DynamicEntry, tag DynamicTag) (uint64, bool) {
+	for _, entry := range entries {
+		if entry.Tag == tag {
+			return entry.Value, true
+		}
+	}
+	return 0, false
+}
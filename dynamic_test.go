@@ -0,0 +1,127 @@
+package elf
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDynamicEntries(t *testing.T) {
+	file, err := Open(filepath.Join("testdata", "sample_linux_amd64"))
+	assert.NoError(t, err)
+	defer file.Close()
+
+	entries, err := file.DynamicEntries()
+	assert.NoError(t, err)
+	assert.Equal(t, DynamicEntry{Tag: DynamicTagNeeded, Value: entries[0].Value}, entries[0])
+	last := entries[len(entries)-1]
+	assert.Equal(t, DynamicEntry{Tag: DynamicTagRelaCount, Value: 3}, last)
+
+	flags1, ok := dynamicValue(entries, DynamicTagFlags1)
+	assert.True(t, ok)
+	assert.Equal(t, DynamicFlag1PIE, DynamicFlags1(flags1))
+	assert.Equal(t, "PIE", DynamicFlags1(flags1).String())
+
+	libraries, err := file.ImportedLibraries()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"libsample.so.1", "libstdc++.so.6", "libgcc_s.so.1", "libc.so.6"}, libraries)
+
+	runPath, err := file.RunPath()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"$ORIGIN/lib"}, runPath)
+
+	rpath, err := file.RPath()
+	assert.NoError(t, err)
+	assert.Empty(t, rpath)
+
+	soname, err := file.SONAME()
+	assert.NoError(t, err)
+	assert.Equal(t, "", soname)
+}
+
+func TestSONAME(t *testing.T) {
+	file, err := Open(filepath.Join("testdata", "libsample.so"))
+	assert.NoError(t, err)
+	defer file.Close()
+
+	soname, err := file.SONAME()
+	assert.NoError(t, err)
+	assert.Equal(t, "libsample.so.1", soname)
+}
+
+func TestDynamicEntriesWithoutSectionHeaders(t *testing.T) {
+	file, err := NewFile(bytes.NewReader(withoutSectionHeaders(t, "sample_linux_amd64")))
+	assert.NoError(t, err)
+	assert.Empty(t, file.Sections)
+
+	libraries, err := file.ImportedLibraries()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"libsample.so.1", "libstdc++.so.6", "libgcc_s.so.1", "libc.so.6"}, libraries)
+}
+
+func TestDynamicEntriesWithoutNull(t *testing.T) {
+	file, err := Open(filepath.Join("testdata", "sample_linux_amd64"))
+	assert.NoError(t, err)
+	defer file.Close()
+	entries, err := file.DynamicEntries()
+	assert.NoError(t, err)
+
+	// segment ends right after last entry, like readelf entries read so far are kept
+	segment := file.dynamicSegment()
+	segment.SizeInFile = uint64(len(entries)) * 16
+	truncated, err := file.DynamicEntries()
+	assert.NoError(t, err)
+	assert.Equal(t, entries, truncated)
+
+	// last entry is cut off partway
+	segment.SizeInFile -= 8
+	_, err = file.DynamicEntries()
+	assert.True(t, errors.Is(err, ErrInvalidDynamicEntry))
+}
+
+func TestDynamicStringOffsetOverflow(t *testing.T) {
+	content, err := ioutil.ReadFile(filepath.Join("testdata", "sample_linux_amd64"))
+	assert.NoError(t, err)
+	file, err := NewFile(bytes.NewReader(content))
+	assert.NoError(t, err)
+
+	// upper half of DT_NEEDED value of the first entry is set, lower half is valid offset
+	offset := file.dynamicSegment().FileOffset
+	content[offset+12] = 1
+	file, err = NewFile(bytes.NewReader(content))
+	assert.NoError(t, err)
+	_, err = file.ImportedLibraries()
+	assert.True(t, errors.Is(err, ErrInvalidDynamicEntry))
+}
+
+func TestNoDynamicEntries(t *testing.T) {
+	file, err := Open(filepath.Join("testdata", "helloworld_linux_amd64"))
+	assert.NoError(t, err)
+	defer file.Close()
+
+	_, err = file.DynamicEntries()
+	assert.True(t, errors.Is(err, ErrNoDynamicSection))
+}
+
+func TestDynamicTagStrings(t *testing.T) {
+	assert.Equal(t, "NEEDED", DynamicTagNeeded.String())
+	assert.Equal(t, "GNU_HASH", DynamicTagGNUHash.String())
+	assert.Equal(t, "VERNEEDNUM", DynamicTagVerNeedNum.String())
+	assert.Equal(t, "OS specific: 0x6000000E", DynamicTag(0x6000000e).String())
+	assert.Equal(t, "proc specific: 0x70000001", DynamicTag(0x70000001).String())
+	assert.Equal(t, "BIND_NOW STATIC_TLS", (DynamicFlagBindNow | DynamicFlagStaticTLS).String())
+	assert.Equal(t, "NOW 0x80000000", (DynamicFlag1Now | 0x80000000).String())
+}
+
+// withoutSectionHeaders returns content of 64bit little endian test file with e_shoff, e_shnum and e_shstrndx zeroed
+func withoutSectionHeaders(t *testing.T, filename string) []byte {
+	content, err := ioutil.ReadFile(filepath.Join("testdata", filename))
+	assert.NoError(t, err)
+	copy(content[0x28:0x30], make([]byte, 8))
+	copy(content[0x3c:0x40], make([]byte, 4))
+	return content
+}
@@ -1,6 +1,7 @@
 package elf
 
 import (
+	"encoding/binary"
 	"errors"
 	"fmt"
 	"io"
@@ -11,23 +12,63 @@ var magic = [4]byte{0x7F, 0x45, 0x4c, 0x46}
 type ELFClass int
 
 const (
-	ELFClass32 ELFClass = iota + 1
+	ELFClassNone ELFClass = iota
+	ELFClass32
 	ELFClass64
 )
 
+var elfClassNames = newConstantNames("ELFClass", []constantName{
+	{0, "ELFCLASSNONE", "ELFClassNone", "none"},
+	{1, "ELFCLASS32", "ELFClass32", "32bit"},
+	{2, "ELFCLASS64", "ELFClass64", "64bit"},
+})
+
 func (ec ELFClass) String() string {
-	return [...]string{"", "32bit", "64bit"}[ec]
+	if text, ok := elfClassNames.text(uint64(ec)); ok {
+		return text
+	}
+	return fmt.Sprintf("unknown: %d", int(ec))
+}
+
+func (ec ELFClass) GoString() string {
+	return elfClassNames.goString(uint64(ec))
+}
+
+// ParseELFClass resolves specification (ELFCLASS64) or Go (ELFClass64) constant name
+func ParseELFClass(name string) (ELFClass, error) {
+	value, err := elfClassNames.parse(name)
+	return ELFClass(value), err
 }
 
 type Endianess int
 
 const (
-	LittleEndian Endianess = iota + 1
+	EndianessNone Endianess = iota
+	LittleEndian
 	BigEndian
 )
 
+var endianessNames = newConstantNames("Endianess", []constantName{
+	{0, "ELFDATANONE", "EndianessNone", "none"},
+	{1, "ELFDATA2LSB", "LittleEndian", "Little endian"},
+	{2, "ELFDATA2MSB", "BigEndian", "Big endian"},
+})
+
 func (e Endianess) String() string {
-	return [...]string{"", "Little endian", "Big endian"}[e]
+	if text, ok := endianessNames.text(uint64(e)); ok {
+		return text
+	}
+	return fmt.Sprintf("unknown: %d", int(e))
+}
+
+func (e Endianess) GoString() string {
+	return endianessNames.goString(uint64(e))
+}
+
+// ParseEndianess resolves specification (ELFDATA2LSB) or Go (LittleEndian) constant name
+func ParseEndianess(name string) (Endianess, error) {
+	value, err := endianessNames.parse(name)
+	return Endianess(value), err
 }
 
 const Version = 1
@@ -40,6 +81,7 @@ const (
 	NetBSD
 	Linux
 	GNU_Hurd
+	ABI_86Open
 	Solaris
 	AIX
 	IRIX
@@ -53,29 +95,58 @@ const (
 	Fenix_OS
 	CloudABI
 	Stratus_Technologies_OpenVOS
+	CUDA       OSAbi = 51
+	ARM        OSAbi = 97
+	Standalone OSAbi = 255
+
+	// values from 64 up to 255 are architecture specific, e.g. ELFOSABI_ARM_AEABI and ELFOSABI_AMDGPU_HSA are both 64
+	OSAbiArchSpecific OSAbi = 64
 )
 
+var osAbiNames = newConstantNames("OSAbi", []constantName{
+	{0, "ELFOSABI_NONE", "System_V", "System V"},
+	{1, "ELFOSABI_HPUX", "HP_UX", "HP UX"},
+	{2, "ELFOSABI_NETBSD", "NetBSD", "NetBSD"},
+	{3, "ELFOSABI_LINUX", "Linux", "Linux"},
+	{4, "ELFOSABI_HURD", "GNU_Hurd", "GNU Hurd"},
+	{5, "ELFOSABI_86OPEN", "ABI_86Open", "86Open"},
+	{6, "ELFOSABI_SOLARIS", "Solaris", "Solaris"},
+	{7, "ELFOSABI_AIX", "AIX", "AIX"},
+	{8, "ELFOSABI_IRIX", "IRIX", "IRIX"},
+	{9, "ELFOSABI_FREEBSD", "FreeBSD", "FreeBSD"},
+	{10, "ELFOSABI_TRU64", "Tru64", "Tru64"},
+	{11, "ELFOSABI_MODESTO", "Novell_Modesto", "Novell Modesto"},
+	{12, "ELFOSABI_OPENBSD", "OpenBSD", "OpenBSD"},
+	{13, "ELFOSABI_OPENVMS", "OpenVMS", "OpenVMS"},
+	{14, "ELFOSABI_NSK", "NonStop_Kernel", "NonStop Kernel"},
+	{15, "ELFOSABI_AROS", "AROS", "AROS"},
+	{16, "ELFOSABI_FENIXOS", "Fenix_OS", "Fenix OS"},
+	{17, "ELFOSABI_CLOUDABI", "CloudABI", "CloudABI"},
+	{18, "ELFOSABI_OPENVOS", "Stratus_Technologies_OpenVOS", "Stratus Technologies OpenVOS"},
+	{51, "ELFOSABI_CUDA", "CUDA", "CUDA"},
+	{97, "ELFOSABI_ARM", "ARM", "ARM"},
+	{255, "ELFOSABI_STANDALONE", "Standalone", "Standalone"},
+	{64, "ELFOSABI_LOARCH", "OSAbiArchSpecific", ""},
+})
+
 func (osabi OSAbi) String() string {
-	return [...]string{
-		"System V",
-		"HP UX",
-		"NetBSD",
-		"Linux",
-		"GNU Hurd",
-		"Solaris",
-		"AIX",
-		"IRIX",
-		"FreeBSD",
-		"Tru64",
-		"Novell Modesto",
-		"OpenBSD",
-		"OpenVMS",
-		"NonStop Kernel",
-		"AROS",
-		"Fenix OS",
-		"CloudABI",
-		"Stratus Technologies OpenVOS",
-	}[osabi]
+	if text, ok := osAbiNames.text(uint64(osabi)); ok {
+		return text
+	}
+	if osabi >= OSAbiArchSpecific && osabi <= 0xff {
+		return fmt.Sprintf("arch specific: %d", int(osabi))
+	}
+	return fmt.Sprintf("unknown: %d", int(osabi))
+}
+
+func (osabi OSAbi) GoString() string {
+	return osAbiNames.goString(uint64(osabi))
+}
+
+// ParseOSAbi resolves specification (ELFOSABI_LINUX) or Go (Linux) constant name
+func ParseOSAbi(name string) (OSAbi, error) {
+	value, err := osAbiNames.parse(name)
+	return OSAbi(value), err
 }
 
 type ABIVersion int
@@ -88,80 +159,45 @@ const (
 	ET_EXEC
 	ET_DYN
 	ET_CORE
-	ET_LOOS   = 0x0fe00
-	ET_HIOS   = 0x0feff
-	ET_LOPROC = 0x0ff00
-	ET_HIPROC = 0x0ffff
+	ET_LOOS   ObjectType = 0x0fe00
+	ET_HIOS   ObjectType = 0x0feff
+	ET_LOPROC ObjectType = 0x0ff00
+	ET_HIPROC ObjectType = 0x0ffff
 )
 
+var objectTypeNames = newConstantNames("ObjectType", []constantName{
+	{0, "ET_NONE", "ET_NONE", "none"},
+	{1, "ET_REL", "ET_REL", "rel"},
+	{2, "ET_EXEC", "ET_EXEC", "exec"},
+	{3, "ET_DYN", "ET_DYN", "dyn"},
+	{4, "ET_CORE", "ET_CORE", "core"},
+	{0x0fe00, "ET_LOOS", "ET_LOOS", "low OS"},
+	{0x0feff, "ET_HIOS", "ET_HIOS", "high OS"},
+	{0x0ff00, "ET_LOPROC", "ET_LOPROC", "low proc"},
+	{0x0ffff, "ET_HIPROC", "ET_HIPROC", "high proc"},
+})
+
 func (ot ObjectType) String() string {
-	if ot < 4 {
-		return [...]string{"none", "rel", "exec", "dyn", "core"}[ot]
-	}
-	switch ot {
-	case ET_LOOS:
-		return "low OS"
-	case ET_HIOS:
-		return "high OS"
-	case ET_LOPROC:
-		return "low proc"
-	case ET_HIPROC:
-		return "high proc"
+	if text, ok := objectTypeNames.text(uint64(ot)); ok {
+		return text
+	}
+	switch {
+	case ot > ET_LOOS && ot < ET_HIOS:
+		return fmt.Sprintf("OS specific: %04X", int(ot))
+	case ot > ET_LOPROC && ot < ET_HIPROC:
+		return fmt.Sprintf("proc specific: %04X", int(ot))
 	}
 	return fmt.Sprintf("unknown: %04X", int(ot))
 }
 
-type InstructionSet int
-
-const (
-	ISNotSpecified  InstructionSet = 0x00
-	ISSparc                        = 0x02
-	ISx86                          = 0x03
-	ISMIPS                         = 0x08
-	ISPowerPC                      = 0x14
-	ISPowerPC64                    = 0x15
-	ISS390WithS390x                = 0x16
-	ISARM                          = 0x28
-	ISSuperH                       = 0x2A
-	ISIA64                         = 0x32
-	ISAmd64                        = 0x3E
-	ISTMS320C6000                  = 0x8C
-	ISAArch64                      = 0xB7
-	ISRISCV                        = 0xF3
-)
+func (ot ObjectType) GoString() string {
+	return objectTypeNames.goString(uint64(ot))
+}
 
-func (is InstructionSet) String() string {
-	switch is {
-	case ISNotSpecified:
-		return "not-specific"
-	case ISSparc:
-		return "SPARC"
-	case ISx86:
-		return "x86"
-	case ISMIPS:
-		return "MIPS"
-	case ISPowerPC:
-		return "PowerPC"
-	case ISPowerPC64:
-		return "PowerPC (64-bit)"
-	case ISS390WithS390x:
-		return "S390, including S390x"
-	case ISARM:
-		return "ARM"
-	case ISSuperH:
-		return "SuperH"
-	case ISIA64:
-		return "IA-64"
-	case ISAmd64:
-		return "amd64"
-	case ISTMS320C6000:
-		return "TMS320C6000 Family"
-	case ISAArch64:
-		return "AArch64"
-	case ISRISCV:
-		return "RISC-V"
-	}
-	return fmt.Sprintf("")
+// ParseObjectType resolves specification constant name, e.g. ET_DYN
+func ParseObjectType(name string) (ObjectType, error) {
+	value, err := objectTypeNames.parse(name)
+	return ObjectType(value), err
 }
 
 type MemoryAddress uint64
@@ -185,9 +221,14 @@ func (mf ArchNativeFlags) String() string {
 type TableInfo struct {
 	Offset     FileOffset
 	EntrySize  uint16
-	EntryCount uint16
+	EntryCount uint32 // 2 bytes in header, bigger counts are stored in first section header (see PN_XNUM and zero e_shnum)
 }
 
+const (
+	// PN_XNUM program header count means real count is in sh_info of first section header
+	ProgramHeaderCountExtended = 0xffff
+)
+
 type Header struct {
 	// Magic 4 bytes - 0x7F followed by ELF(45 4c 46)
 	Class     ELFClass
@@ -208,7 +249,7 @@ type Header struct {
 	// ProgramHeaderCount int           2 bytes count of program header entries in table
 	// SectionHeaderSize  int           2 bytes - size of section header entry in table
 	// SectionHeaderCount int           2 bytes - count of section header entry in table
-	NamesSectionIndex uint16 // 2 bytes - index to section table for section with section names
+	NamesSectionIndex uint32 // 2 bytes - index to section table for section with section names, SHN_XINDEX means real index is in sh_link of first section header
 	// ELF header size is 52 for 32bit ELF or 64 for 64bit ELF
 }
 
@@ -231,12 +272,18 @@ func Read(reader io.Reader) (Header, error) {
 		return header, fmt.Errorf("%w class read: %v", ErrInvalidELF, err)
 	}
 	header.Class = ELFClass(val)
+	if header.Class != ELFClass32 && header.Class != ELFClass64 {
+		return header, fmt.Errorf("%w unknown class: %v", ErrInvalidELF, header.Class)
+	}
 
 	val, err = singleByte(reader)
 	if err != nil {
 		return header, fmt.Errorf("%w endianess read: %v", ErrInvalidELF, err)
 	}
 	header.Endianess = Endianess(val)
+	if header.Endianess != LittleEndian && header.Endianess != BigEndian {
+		return header, fmt.Errorf("%w unknown endianess: %v", ErrInvalidELF, header.Endianess)
+	}
 
 	version, err := singleByte(reader)
 	if err != nil {
@@ -333,9 +380,11 @@ func Read(reader io.Reader) (Header, error) {
 	if err != nil {
 		return header, fmt.Errorf("%w names section index: %v", ErrInvalidELF, err)
 	}
-	header.NamesSectionIndex = uint16val
+	header.NamesSectionIndex = uint32(uint16val)
 
-	if header.SectionHeaderTable.EntryCount <= header.NamesSectionIndex {
+	// SHN_UNDEF is used when file has no section names, e.g. stripped of section header table entirely.
+	// Extended numbering can't be validated here as it needs first section header which is resolved by NewFile
+	if header.NamesSectionIndex != 0 && !header.hasExtendedNumbering() && header.SectionHeaderTable.EntryCount <= header.NamesSectionIndex {
 		return header, fmt.Errorf("%w names section index %v out of bounds for section table: %v", ErrInvalidELF, header.NamesSectionIndex, header.SectionHeaderTable.EntryCount)
 	}
 
@@ -353,6 +402,23 @@ func (h Header) NativeReader(reader io.Reader) NativeWordReader {
 	}
 }
 
+// hasExtendedNumbering reports if any of header table counts or names section index is stored in first section header
+func (h Header) hasExtendedNumbering() bool {
+	if h.SectionHeaderTable.Offset == 0 {
+		return false
+	}
+	return h.SectionHeaderTable.EntryCount == 0 ||
+		h.NamesSectionIndex == uint32(SectionIndexExtended) ||
+		h.ProgramHeaderTable.EntryCount == ProgramHeaderCountExtended
+}
+
+func (h Header) ByteOrder() binary.ByteOrder {
+	if h.Endianess == BigEndian {
+		return binary.BigEndian
+	}
+	return binary.LittleEndian
+}
+
 func fillSizeCount(reader NativeWordReader, t *TableInfo) error {
 	size, err := reader.Uint16()
 	if err != nil {
@@ -364,7 +430,7 @@ func fillSizeCount(reader NativeWordReader, t *TableInfo) error {
 	if err != nil {
 		return fmt.Errorf("count read: %v", err)
 	}
-	t.EntryCount = count
+	t.EntryCount = uint32(count)
 	return nil
 }
 
@@ -29,7 +29,7 @@ func TestElfReader(t *testing.T) {
 		assert.NoError(t, err)
 		t.Logf("%v -> %+v", tc.filename, header)
 		nativeReader := header.NativeReader(reader)
-		var i uint16
+		var i uint32
 		t.Log("   --- Program header table ---")
 		for i = 0; i < header.ProgramHeaderTable.EntryCount; i++ {
 			programHeader, err := ReadProgramHeader(nativeReader)
@@ -0,0 +1,268 @@
+package elf
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"sync"
+)
+
+// minimal sizes of table entries as defined by the spec, EntrySize declared in header can only be bigger
+const (
+	programHeaderSize32 = 0x20
+	programHeaderSize64 = 0x38
+	sectionHeaderSize32 = 0x28
+	sectionHeaderSize64 = 0x40
+)
+
+// Segment is a single entry of program header table
+type Segment struct {
+	ProgramHeader
+
+	reader io.ReaderAt
+}
+
+// Section is a single entry of section header table
+type Section struct {
+	SectionHeader
+	Name string // resolved from section names string table
+
+	reader io.ReaderAt
+	file   *File
+}
+
+// File is ELF file opened for random access, with program and section header tables read from offsets declared in Header
+type File struct {
+	Header
+	Segments []*Segment
+	Sections []*Section
+
+	reader io.ReaderAt
+	closer io.Closer
+
+	dynamicSymbolsOnce sync.Once
+	dynamicSymbols     *dynamicSymbolTable
+	dynamicSymbolsErr  error
+
+	miniDebugInfoOnce sync.Once
+	miniDebugInfo     *File
+	miniDebugInfoErr  error
+
+	sectionGroupsOnce sync.Once
+	sectionGroups     *sectionGroupTable
+	sectionGroupsErr  error
+
+	lineSectionsOnce  sync.Once
+	lineSectionsCache lineSections
+	lineSectionsErr   error
+
+	lineSequencesOnce  sync.Once
+	lineSequencesCache []lineSequence
+	lineSequencesErr   error
+
+	dwarfOnce sync.Once
+	dwarfData *DWARFData
+	dwarfErr  error
+
+	splitSectionsOnce  sync.Once
+	splitSectionsCache *splitSections
+	splitSectionsErr   error
+
+	splitUnitsLock sync.Mutex
+	splitUnits     map[uint64]*CompilationUnit // by DWO id
+
+	nameIndexesOnce sync.Once
+	nameIndexes     []*NameIndex
+	nameIndexesErr  error
+
+	gdbIndexOnce sync.Once
+	gdbIndex     *GDBIndex
+	gdbIndexErr  error
+}
+
+var ErrInvalidTable = errors.New("invalid header table")
+
+// Open opens named file and parses it as ELF, returned File must be closed by caller
+func Open(path string) (*File, error) {
+	osFile, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	file, err := NewFile(osFile)
+	if err != nil {
+		osFile.Close()
+		return nil, err
+	}
+	file.closer = osFile
+	return file, nil
+}
+
+// NewFile parses ELF header and both header tables from given reader. Reader must stay valid for the lifetime of File
+func NewFile(reader io.ReaderAt) (*File, error) {
+	header, err := Read(io.NewSectionReader(reader, 0, 1<<63-1))
+	if err != nil {
+		return nil, err
+	}
+	file := &File{
+		Header: header,
+		reader: reader,
+	}
+	if header.hasExtendedNumbering() {
+		if err := file.resolveExtendedNumbering(); err != nil {
+			return nil, err
+		}
+	}
+
+	err = file.forEachEntry(file.ProgramHeaderTable, programHeaderSize32, programHeaderSize64, func(nativeReader NativeWordReader) error {
+		programHeader, err := ReadProgramHeader(nativeReader)
+		if err != nil {
+			return err
+		}
+		file.Segments = append(file.Segments, &Segment{ProgramHeader: programHeader, reader: reader})
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("program header table: %w", err)
+	}
+
+	err = file.forEachEntry(file.SectionHeaderTable, sectionHeaderSize32, sectionHeaderSize64, func(nativeReader NativeWordReader) error {
+		sectionHeader, err := ReadSectionHeader(nativeReader)
+		if err != nil {
+			return err
+		}
+		file.Sections = append(file.Sections, &Section{SectionHeader: sectionHeader, reader: reader, file: file})
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("section header table: %w", err)
+	}
+
+	if err := file.resolveSectionNames(); err != nil {
+		return nil, fmt.Errorf("section names: %w", err)
+	}
+
+	return file, nil
+}
+
+// Close closes underlying file if File was created by Open, otherwise it does nothing
+func (f *File) Close() error {
+	if f.closer == nil {
+		return nil
+	}
+	err := f.closer.Close()
+	f.closer = nil
+	return err
+}
+
+// Section returns first section with given name or nil if there is no such section
+func (f *File) Section(name string) *Section {
+	for _, section := range f.Sections {
+		if section.Name == name {
+			return section
+		}
+	}
+	return nil
+}
+
+// SectionsByType returns all sections of given type in section table order
+func (f *File) SectionsByType(sectionType SectionType) []*Section {
+	var sections []*Section
+	for _, section := range f.Sections {
+		if section.Type == sectionType {
+			sections = append(sections, section)
+		}
+	}
+	return sections
+}
+
+func (f *File) resolveSectionNames() error {
+	// SHN_UNDEF - file has no section names
+	if f.NamesSectionIndex == 0 || len(f.Sections) == 0 {
+		return nil
+	}
+	if int(f.NamesSectionIndex) >= len(f.Sections) {
+		return fmt.Errorf("%w names section index %v out of bounds: %v", ErrInvalidStringTable, f.NamesSectionIndex, len(f.Sections))
+	}
+	namesSection := f.Sections[f.NamesSectionIndex]
+	if namesSection.Type != SectionTypeStrTable {
+		return fmt.Errorf("%w names section type is %v", ErrInvalidStringTable, namesSection.Type)
+	}
+	content, err := namesSection.Data()
+	if err != nil {
+		return fmt.Errorf("%w read: %v", ErrInvalidStringTable, err)
+	}
+	names := StringTable(content)
+	for i, section := range f.Sections {
+		if section.Type == SectionTypeNull {
+			continue
+		}
+		section.Name, err = names.String(section.NameOffset)
+		if err != nil {
+			return fmt.Errorf("section %v: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// resolveExtendedNumbering reads real section count, names section index and program header count from first section header
+func (f *File) resolveExtendedNumbering() error {
+	table := f.SectionHeaderTable
+	minSize := uint16(sectionHeaderSize32)
+	if f.Class == ELFClass64 {
+		minSize = sectionHeaderSize64
+	}
+	if table.EntrySize < minSize {
+		return fmt.Errorf("%w section header entry size %v is smaller than %v", ErrInvalidTable, table.EntrySize, minSize)
+	}
+	first, err := ReadSectionHeader(f.NativeReader(io.NewSectionReader(f.reader, int64(table.Offset), int64(table.EntrySize))))
+	if err != nil {
+		return fmt.Errorf("%w first section header: %v", ErrInvalidTable, err)
+	}
+
+	if f.SectionHeaderTable.EntryCount == 0 {
+		if first.Size > math.MaxUint32 {
+			return fmt.Errorf("%w extended section count %v", ErrInvalidTable, first.Size)
+		}
+		f.SectionHeaderTable.EntryCount = uint32(first.Size)
+	}
+	if f.NamesSectionIndex == uint32(SectionIndexExtended) {
+		f.NamesSectionIndex = first.Link
+	}
+	if f.ProgramHeaderTable.EntryCount == ProgramHeaderCountExtended {
+		f.ProgramHeaderTable.EntryCount = first.Info
+	}
+
+	if f.NamesSectionIndex != 0 && f.SectionHeaderTable.EntryCount <= f.NamesSectionIndex {
+		return fmt.Errorf("%w names section index %v out of bounds for section table: %v", ErrInvalidELF, f.NamesSectionIndex, f.SectionHeaderTable.EntryCount)
+	}
+	return nil
+}
+
+// forEachEntry reads each table entry from its own offset (table offset + index * declared entry size)
+func (f *File) forEachEntry(table TableInfo, minSize32, minSize64 uint16, readEntry func(NativeWordReader) error) error {
+	if table.EntryCount == 0 {
+		return nil
+	}
+	minSize := minSize32
+	if f.Class == ELFClass64 {
+		minSize = minSize64
+	}
+	if table.EntrySize < minSize {
+		return fmt.Errorf("%w entry size %v is smaller than %v", ErrInvalidTable, table.EntrySize, minSize)
+	}
+
+	var i uint32
+	for i = 0; i < table.EntryCount; i++ {
+		offset := int64(table.Offset) + int64(i)*int64(table.EntrySize)
+		if offset < 0 {
+			return fmt.Errorf("%w entry %v offset overflow", ErrInvalidTable, i)
+		}
+		entryReader := io.NewSectionReader(f.reader, offset, int64(table.EntrySize))
+		if err := readEntry(f.NativeReader(entryReader)); err != nil {
+			return fmt.Errorf("entry %v: %w", i, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,169 @@
+package elf
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOpenReadsTablesFromOffsets(t *testing.T) {
+	tcs := []struct {
+		filename    string
+		textAddress MemoryAddress
+		strtab      FileOffset
+	}{
+		{"helloworld_linux_386", 0x08049000, 0x1b8580},
+		{"helloworld_linux_amd64", 0x401000, 0x1e9630},
+		{"helloworld_linux_ppc64", 0x11000, 0x1ff798},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.filename, func(t *testing.T) {
+			file, err := Open(filepath.Join("testdata", tc.filename))
+			assert.NoError(t, err)
+			defer file.Close()
+
+			assert.Len(t, file.Segments, 7)
+			assert.Len(t, file.Sections, 25)
+			assert.Equal(t, SegmentTypeProgramHeaderTable, file.Segments[0].Type)
+			assert.Equal(t, SegmentTypeLoad, file.Segments[2].Type)
+			assert.Equal(t, FileOffset(0), file.Segments[2].FileOffset)
+
+			assert.Equal(t, SectionTypeNull, file.Sections[0].Type)
+			assert.Equal(t, SectionTypeProgBits, file.Sections[1].Type)
+			assert.Equal(t, tc.textAddress, file.Sections[1].Virtual)
+			assert.Equal(t, FileOffset(0x1000), file.Sections[1].Offset)
+			assert.Equal(t, SectionTypeStrTable, file.Sections[24].Type)
+			assert.Equal(t, tc.strtab, file.Sections[24].Offset)
+		})
+	}
+}
+
+func TestNewFileRejectsTooSmallEntrySize(t *testing.T) {
+	content, err := ioutil.ReadFile(filepath.Join("testdata", "helloworld_linux_amd64"))
+	assert.NoError(t, err)
+	// e_phentsize of 64bit little endian header
+	content[0x36] = 0x10
+
+	_, err = NewFile(bytes.NewReader(content))
+	assert.True(t, errors.Is(err, ErrInvalidTable))
+}
+
+func TestSectionNames(t *testing.T) {
+	for _, filename := range []string{"helloworld_linux_386", "helloworld_linux_amd64", "helloworld_linux_ppc64"} {
+		t.Run(filename, func(t *testing.T) {
+			file, err := Open(filepath.Join("testdata", filename))
+			assert.NoError(t, err)
+			defer file.Close()
+
+			assert.Equal(t, "", file.Sections[0].Name)
+			assert.Equal(t, ".text", file.Sections[1].Name)
+			assert.Equal(t, ".note.go.buildid", file.Sections[22].Name)
+
+			text := file.Section(".text")
+			assert.NotNil(t, text)
+			assert.Equal(t, FileOffset(0x1000), text.Offset)
+			assert.Nil(t, file.Section(".no-such-section"))
+
+			strTables := file.SectionsByType(SectionTypeStrTable)
+			assert.Len(t, strTables, 2)
+			assert.Equal(t, ".shstrtab", strTables[0].Name)
+			assert.Equal(t, ".strtab", strTables[1].Name)
+		})
+	}
+}
+
+func TestExtendedNumbering(t *testing.T) {
+	file, err := NewFile(bytes.NewReader(extendedNumberingELF(0x12345)))
+	assert.NoError(t, err)
+
+	assert.Equal(t, uint32(5), file.SectionHeaderTable.EntryCount)
+	assert.Equal(t, uint32(4), file.NamesSectionIndex)
+	assert.Equal(t, uint32(0), file.ProgramHeaderTable.EntryCount)
+	assert.Len(t, file.Sections, 5)
+	assert.Len(t, file.Segments, 0)
+	assert.Equal(t, ".symtab_shndx", file.Sections[3].Name)
+	assert.Equal(t, SectionTypeExtSectionInd, file.Sections[3].Type)
+
+	symbols, err := file.Symbols()
+	assert.NoError(t, err)
+	assert.Len(t, symbols, 2)
+	assert.Equal(t, "big", symbols[1].Name)
+	assert.Equal(t, SectionIndex(0x12345), symbols[1].SectionIndex)
+}
+
+func TestExtendedNumberingRequiresIndicesSection(t *testing.T) {
+	content := extendedNumberingELF(0x12345)
+	// turn .symtab_shndx into SHT_PROGBITS
+	binary.LittleEndian.PutUint32(content[0x40+3*0x40+4:], uint32(SectionTypeProgBits))
+
+	file, err := NewFile(bytes.NewReader(content))
+	assert.NoError(t, err)
+	_, err = file.Symbols()
+	assert.True(t, errors.Is(err, ErrInvalidSymbol))
+}
+
+func TestExtendedNumberingNamesIndexOutOfBounds(t *testing.T) {
+	content := extendedNumberingELF(0x12345)
+	// sh_link of first section header
+	binary.LittleEndian.PutUint32(content[0x40+0x28:], 5)
+
+	_, err := NewFile(bytes.NewReader(content))
+	assert.True(t, errors.Is(err, ErrInvalidELF))
+}
+
+// extendedNumberingELF builds 64bit little endian relocatable with e_shnum, e_shstrndx and e_phnum escaped to first section header
+// and single symbol with its section index stored in SHT_SYMTAB_SHNDX
+func extendedNumberingELF(symbolSectionIndex uint32) []byte {
+	le := binary.LittleEndian
+	const sectionCount = 5
+	names := "\x00.symtab\x00.strtab\x00.symtab_shndx\x00.shstrtab\x00"
+	symbolNames := "\x00big\x00"
+
+	dataOffset := 0x40 + sectionCount*0x40
+	symtab := make([]byte, 2*0x18)
+	le.PutUint32(symtab[0x18:], 1)
+	symtab[0x18+4] = byte(SymbolBindingGlobal<<4) | byte(SymbolTypeObject)
+	le.PutUint16(symtab[0x18+6:], uint16(SectionIndexExtended))
+	indices := make([]byte, 8)
+	le.PutUint32(indices[4:], symbolSectionIndex)
+
+	content := make([]byte, dataOffset)
+	sectionHeader := func(index int, name, sectionType, link, entrySize uint32, offset, size int) {
+		header := content[0x40+index*0x40:]
+		le.PutUint32(header[0:], name)
+		le.PutUint32(header[4:], sectionType)
+		le.PutUint64(header[0x18:], uint64(offset))
+		le.PutUint64(header[0x20:], uint64(size))
+		le.PutUint32(header[0x28:], link)
+		le.PutUint64(header[0x38:], uint64(entrySize))
+	}
+	appendData := func(data []byte) int {
+		offset := len(content)
+		content = append(content, data...)
+		return offset
+	}
+
+	copy(content, []byte{0x7f, 'E', 'L', 'F', byte(ELFClass64), byte(LittleEndian), 1})
+	le.PutUint16(content[0x10:], 1)      // ET_REL
+	le.PutUint32(content[0x14:], 1)      // e_version
+	le.PutUint64(content[0x28:], 0x40)   // e_shoff
+	le.PutUint16(content[0x34:], 0x40)   // e_ehsize
+	le.PutUint16(content[0x36:], 0x38)   // e_phentsize
+	le.PutUint16(content[0x38:], 0xffff) // e_phnum PN_XNUM
+	le.PutUint16(content[0x3a:], 0x40)   // e_shentsize
+	le.PutUint16(content[0x3c:], 0)      // e_shnum
+	le.PutUint16(content[0x3e:], 0xffff) // e_shstrndx SHN_XINDEX
+
+	// real counts: sh_size - section count, sh_link - names section index, sh_info - program header count
+	sectionHeader(0, 0, uint32(SectionTypeNull), 4, 0, 0, sectionCount)
+	sectionHeader(1, 1, uint32(SectionTypeSymTable), 2, 0x18, appendData(symtab), len(symtab))
+	sectionHeader(2, 9, uint32(SectionTypeStrTable), 0, 0, appendData([]byte(symbolNames)), len(symbolNames))
+	sectionHeader(3, 17, uint32(SectionTypeExtSectionInd), 1, 4, appendData(indices), len(indices))
+	sectionHeader(4, 31, uint32(SectionTypeStrTable), 0, 0, appendData([]byte(names)), len(names))
+	return content
+}
@@ -0,0 +1,232 @@
+package elf
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+// GDBIndexSectionName is section of name index built for GDB by gold, lld or gdb-add-index
+const GDBIndexSectionName = ".gdb_index"
+
+// GDBSymbolKind is kind of symbol declared by GDB index of version 7 and later
+type GDBSymbolKind uint8
+
+const (
+	GDBSymbolNone     GDBSymbolKind = iota // kind is not known
+	GDBSymbolType                          // types, typedefs and tags of structures, unions and enumerations
+	GDBSymbolVariable                      // variables and enumerators
+	GDBSymbolFunction
+	GDBSymbolOther // e.g. modules and namespaces
+)
+
+var gdbSymbolKindNames = [...]string{"none", "type", "variable", "function", "other"}
+
+func (gsk GDBSymbolKind) String() string {
+	if int(gsk) < len(gdbSymbolKindNames) {
+		return gdbSymbolKindNames[gsk]
+	}
+	return fmt.Sprintf("unknown: 0x%X", uint8(gsk))
+}
+
+// GDBIndexUnit is compilation unit listed by GDB index
+type GDBIndexUnit struct {
+	Offset uint64 // offset of unit in .debug_info
+	Length uint64 // length of unit including its header
+}
+
+// GDBIndexTypeUnit is type unit of .debug_types listed by GDB index
+type GDBIndexTypeUnit struct {
+	Offset     uint64 // offset of unit in .debug_types
+	TypeOffset uint64 // offset of type entry relative to type unit
+	Signature  uint64
+}
+
+// GDBIndexAddressRange is address range of code of compilation unit
+type GDBIndexAddressRange struct {
+	AddressRange
+	Unit uint32 // index of unit in GDBIndex.CompileUnits
+}
+
+// GDBIndexSymbol is single unit of symbol listed by GDB index
+type GDBIndexSymbol struct {
+	Unit   uint32 // index of unit, indexes past GDBIndex.CompileUnits refer to GDBIndex.TypeUnits
+	Kind   GDBSymbolKind
+	Static bool // symbol is local to unit
+}
+
+// GDBIndex is decoded .gdb_index section of version 7 to 9. Symbol names are qualified by namespaces and classes, e.g.
+// ns::Class::method, but they have no parameters of functions
+type GDBIndex struct {
+	Version      uint32
+	CompileUnits []GDBIndexUnit
+	TypeUnits    []GDBIndexTypeUnit
+	Addresses    []GDBIndexAddressRange
+	MainName     string // name of main function declared by shortcut table of version 9, empty if not declared
+	MainLanguage uint32 // DW_LANG_* language of main function, zero if not declared
+
+	symbolTable  []uint32 // slots of symbol hash table, pairs of name and unit vector offsets in constant pool
+	constantPool []byte
+}
+
+// gdbIndexHeaderSizes are header sizes of supported versions, version 9 adds offset of shortcut table
+var gdbIndexHeaderSizes = map[uint32]int{7: 24, 8: 24, 9: 28}
+
+// GDBIndex decodes .gdb_index section
+func (f *File) GDBIndex() (*GDBIndex, error) {
+	f.gdbIndexOnce.Do(func() {
+		f.gdbIndex, f.gdbIndexErr = f.readGDBIndex()
+	})
+	return f.gdbIndex, f.gdbIndexErr
+}
+
+func (f *File) readGDBIndex() (*GDBIndex, error) {
+	content, err := f.DWARFSection(GDBIndexSectionName)
+	if err != nil {
+		return nil, err
+	}
+	return readGDBIndex(content)
+}
+
+// readGDBIndex decodes index, its values are little endian regardless of target
+func readGDBIndex(content []byte) (*GDBIndex, error) {
+	buf := &dwarfBuffer{section: GDBIndexSectionName, data: content, byteOrder: binary.LittleEndian}
+	index := &GDBIndex{Version: buf.uint32()}
+	if buf.err != nil {
+		return nil, buf.err
+	}
+	headerSize, ok := gdbIndexHeaderSizes[index.Version]
+	if !ok {
+		return nil, fmt.Errorf("%w %v has unsupported version %v", ErrInvalidDWARF, GDBIndexSectionName, index.Version)
+	}
+	// offsets of unit list, type unit list, address area, symbol table, shortcut table of version 9 and constant pool
+	offsets := make([]uint64, headerSize/4-1)
+	for i := range offsets {
+		offsets[i] = uint64(buf.uint32())
+		if buf.err == nil && (offsets[i] < uint64(headerSize) || i > 0 && offsets[i] < offsets[i-1] || offsets[i] > uint64(len(content))) {
+			return nil, fmt.Errorf("%w %v area %v at 0x%x is out of order", ErrInvalidDWARF, GDBIndexSectionName, i, offsets[i])
+		}
+	}
+	if buf.err != nil {
+		return nil, buf.err
+	}
+	area := func(i int) *dwarfBuffer {
+		end := uint64(len(content))
+		if i+1 < len(offsets) {
+			end = offsets[i+1]
+		}
+		return &dwarfBuffer{section: GDBIndexSectionName, data: content[:end], offset: offsets[i], byteOrder: binary.LittleEndian}
+	}
+	units, typeUnits, addresses, symbols := area(0), area(1), area(2), area(3)
+	// areas are arrays of fixed size entries, symbol table slots are pairs of offsets
+	for i, entrySize := range []uint64{16, 24, 20, 8} {
+		if size := offsets[i+1] - offsets[i]; size%entrySize != 0 {
+			return nil, fmt.Errorf("%w %v area %v of size 0x%x is not array of %v byte entries", ErrInvalidDWARF, GDBIndexSectionName, i, size, entrySize)
+		}
+	}
+	index.constantPool = content[offsets[len(offsets)-1]:]
+	for units.offset < uint64(len(units.data)) {
+		index.CompileUnits = append(index.CompileUnits, GDBIndexUnit{Offset: units.uint64(), Length: units.uint64()})
+	}
+	for typeUnits.offset < uint64(len(typeUnits.data)) {
+		index.TypeUnits = append(index.TypeUnits, GDBIndexTypeUnit{Offset: typeUnits.uint64(), TypeOffset: typeUnits.uint64(), Signature: typeUnits.uint64()})
+	}
+	for addresses.offset < uint64(len(addresses.data)) && addresses.err == nil {
+		var address GDBIndexAddressRange
+		address.Low, address.High, address.Unit = MemoryAddress(addresses.uint64()), MemoryAddress(addresses.uint64()), addresses.uint32()
+		if address.Unit >= uint32(len(index.CompileUnits)) {
+			addresses.fail("address range of unit %v out of %v", address.Unit, len(index.CompileUnits))
+		}
+		index.Addresses = append(index.Addresses, address)
+	}
+	if addresses.err != nil {
+		return nil, addresses.err
+	}
+	for symbols.offset < uint64(len(symbols.data)) {
+		index.symbolTable = append(index.symbolTable, symbols.uint32())
+	}
+	if slots := len(index.symbolTable) / 2; slots&(slots-1) != 0 {
+		return nil, fmt.Errorf("%w %v symbol table of %v slots is not power of two", ErrInvalidDWARF, GDBIndexSectionName, slots)
+	}
+
+	if index.Version >= 9 {
+		shortcuts := area(4)
+		index.MainLanguage = shortcuts.uint32()
+		nameOffset := uint64(shortcuts.uint32())
+		if shortcuts.err != nil {
+			return nil, shortcuts.err
+		}
+		if nameOffset != 0 {
+			var err error
+			if index.MainName, err = cstringAt(GDBIndexSectionName, index.constantPool, nameOffset); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return index, nil
+}
+
+// gdbIndexHash is hash of symbol names in GDB index, versions 5 and later hash lower case names
+func gdbIndexHash(name string) uint32 {
+	var hash uint32
+	for i := 0; i < len(name); i++ {
+		c := name[i]
+		if 'A' <= c && c <= 'Z' {
+			c += 'a' - 'A'
+		}
+		hash = hash*67 + uint32(c) - 113
+	}
+	return hash
+}
+
+// Lookup returns units of symbol, nil if index has no such symbol. Names are compared case sensitively although hash
+// table is case insensitive
+func (i *GDBIndex) Lookup(name string) ([]GDBIndexSymbol, error) {
+	slots := uint32(len(i.symbolTable) / 2)
+	if slots == 0 {
+		return nil, nil
+	}
+	hash := gdbIndexHash(name)
+	mask := slots - 1
+	slot := hash & mask
+	step := (hash*17)&mask | 1
+	for probes := uint32(0); probes < slots; probes++ {
+		nameOffset, vectorOffset := i.symbolTable[2*slot], i.symbolTable[2*slot+1]
+		if nameOffset == 0 && vectorOffset == 0 {
+			return nil, nil
+		}
+		indexed, err := cstringAt(GDBIndexSectionName, i.constantPool, uint64(nameOffset))
+		if err != nil {
+			return nil, err
+		}
+		if indexed == name {
+			return i.symbols(uint64(vectorOffset))
+		}
+		slot = (slot + step) & mask
+	}
+	return nil, nil
+}
+
+// symbols decodes unit vector at offset of constant pool
+func (i *GDBIndex) symbols(offset uint64) ([]GDBIndexSymbol, error) {
+	buf := &dwarfBuffer{section: GDBIndexSectionName, data: i.constantPool, offset: offset, byteOrder: binary.LittleEndian}
+	count := uint64(buf.uint32())
+	if buf.err == nil && count > (uint64(len(i.constantPool))-buf.offset)/4 {
+		buf.fail("%v units overflow constant pool", count)
+	}
+	if buf.err != nil {
+		return nil, buf.err
+	}
+	symbols := make([]GDBIndexSymbol, count)
+	for j := range symbols {
+		value := buf.uint32()
+		symbols[j] = GDBIndexSymbol{
+			Unit:   value & 0xffffff,
+			Kind:   GDBSymbolKind(value >> 28 & 7),
+			Static: value&(1<<31) != 0,
+		}
+		if units := uint32(len(i.CompileUnits) + len(i.TypeUnits)); symbols[j].Unit >= units {
+			return nil, fmt.Errorf("%w %v symbol of unit %v out of %v", ErrInvalidDWARF, GDBIndexSectionName, symbols[j].Unit, units)
+		}
+	}
+	return symbols, nil
+}
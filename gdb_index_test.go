@@ -0,0 +1,105 @@
+package elf
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGDBIndex(t *testing.T) {
+	file, err := Open(filepath.Join("testdata", "gdb_index_linux_amd64"))
+	assert.NoError(t, err)
+	defer file.Close()
+
+	// compare with llvm-dwarfdump --gdb-index
+	index, err := file.GDBIndex()
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(7), index.Version)
+	assert.Equal(t, []GDBIndexUnit{{0, 0x991c}, {0x991c, 0x175}}, index.CompileUnits)
+	assert.Empty(t, index.TypeUnits)
+	assert.Len(t, index.Addresses, 4)
+	assert.Equal(t, GDBIndexAddressRange{AddressRange{0xf29, 0x11cc}, 0}, index.Addresses[0])
+	assert.Equal(t, GDBIndexAddressRange{AddressRange{0x1340, 0x1371}, 1}, index.Addresses[3])
+	assert.Empty(t, index.MainName)
+	assertGDBIndexSymbols(t, index)
+
+	// int is in slot of its hash, gold declares no symbol kinds
+	assert.Equal(t, uint32(754), gdbIndexHash("int")&1023)
+	assert.Equal(t, gdbIndexHash("int"), gdbIndexHash("INT"))
+}
+
+func assertGDBIndexSymbols(t *testing.T, index *GDBIndex) {
+	symbols, err := index.Lookup("int")
+	assert.NoError(t, err)
+	assert.Equal(t, []GDBIndexSymbol{{Unit: 0}, {Unit: 1}}, symbols)
+	symbols, err = index.Lookup("sample_name")
+	assert.NoError(t, err)
+	assert.Equal(t, []GDBIndexSymbol{{Unit: 1}}, symbols)
+	symbols, err = index.Lookup("std::runtime_error::runtime_error")
+	assert.NoError(t, err)
+	assert.Equal(t, []GDBIndexSymbol{{Unit: 0}}, symbols)
+	for _, name := range []string{"INT", "runtime_error", ""} {
+		symbols, err = index.Lookup(name)
+		assert.NoError(t, err)
+		assert.Nil(t, symbols, name)
+	}
+}
+
+func TestGDBIndexVersions(t *testing.T) {
+	file, err := Open(filepath.Join("testdata", "gdb_index_linux_amd64"))
+	assert.NoError(t, err)
+	defer file.Close()
+	content, err := file.Section(GDBIndexSectionName).Data()
+	assert.NoError(t, err)
+
+	// version 8 changed only meaning of C++ names
+	v8 := append([]byte(nil), content...)
+	v8[0] = 8
+	index, err := readGDBIndex(v8)
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(8), index.Version)
+	assertGDBIndexSymbols(t, index)
+
+	// version 9 inserts shortcut table before constant pool
+	pool := binary.LittleEndian.Uint32(content[20:])
+	var v9 dwarf64Writer
+	v9.values(uint32(9))
+	for i := 4; i < 24; i += 4 {
+		v9.values(binary.LittleEndian.Uint32(content[i:]) + 4)
+	}
+	v9.values(pool+12, content[24:pool], uint32(0x21), uint32(uint32(len(content))-pool), content[pool:], []byte("main\x00"))
+	index, err = readGDBIndex(v9.Bytes())
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(9), index.Version)
+	assert.Equal(t, "main", index.MainName)
+	assert.Equal(t, uint32(0x21), index.MainLanguage)
+	assert.Len(t, index.CompileUnits, 2)
+	assert.Len(t, index.Addresses, 4)
+	assertGDBIndexSymbols(t, index)
+
+	for _, version := range []byte{6, 10} {
+		invalid := append([]byte(nil), content...)
+		invalid[0] = version
+		_, err = readGDBIndex(invalid)
+		assert.True(t, errors.Is(err, ErrInvalidDWARF), "%v", version)
+	}
+	// constant pool before symbol table
+	invalid := append([]byte(nil), content...)
+	binary.LittleEndian.PutUint32(invalid[20:], 0x40)
+	_, err = readGDBIndex(invalid)
+	assert.True(t, errors.Is(err, ErrInvalidDWARF))
+	// areas truncated within their entries, e.g. 20 byte unit list
+	for i, end := range []uint32{0x18 + 20, 0x38 + 4, 0x88 + 12, 0x2088 + 4} {
+		truncated := append([]byte(nil), content...)
+		binary.LittleEndian.PutUint32(truncated[8+4*i:], end)
+		_, err = readGDBIndex(truncated)
+		assert.True(t, errors.Is(err, ErrInvalidDWARF), "%v", i)
+		assert.Contains(t, err.Error(), fmt.Sprintf("area %v of size", i))
+	}
+	_, err = readGDBIndex(content[:10])
+	assert.True(t, errors.Is(err, ErrInvalidDWARF))
+}
@@ -2,4 +2,8 @@ module github.com/tadovas/elf
 
 go 1.14
 
-require github.com/stretchr/testify v1.6.1
+require (
+	github.com/klauspost/compress v1.13.4
+	github.com/stretchr/testify v1.6.1
+	github.com/ulikunitz/xz v0.5.15
+)
@@ -0,0 +1,142 @@
+package elf
+
+import (
+	"errors"
+	"fmt"
+)
+
+// GroupFlags is flag word at the start of SHT_GROUP section
+type GroupFlags uint32
+
+const (
+	//0x1	GRP_COMDAT	Group may be duplicated in other object files and linker keeps only one copy
+	GroupFlagComdat GroupFlags = 0x1
+	//0x0ff00000	GRP_MASKOS	OS-specific flags
+	GroupFlagMaskOS GroupFlags = 0x0ff00000
+	//0xf0000000	GRP_MASKPROC	Processor-specific flags
+	GroupFlagMaskProc GroupFlags = 0xf0000000
+)
+
+var groupFlagNames = [...]string{"COMDAT"}
+
+func (gf GroupFlags) String() string {
+	return flagNames(uint64(gf), groupFlagNames[:])
+}
+
+// SectionGroup is decoded SHT_GROUP section
+type SectionGroup struct {
+	Section         *Section // SHT_GROUP section itself
+	Flags           GroupFlags
+	SignatureSymbol Symbol     // symbol selected by sh_info from symbol table linked by sh_link
+	Signature       string     // signature symbol name, or name of its section for STT_SECTION symbols
+	Members         []*Section // member sections in group order
+}
+
+// IsComdat reports if group has GRP_COMDAT flag set
+func (sg *SectionGroup) IsComdat() bool {
+	return sg.Flags&GroupFlagComdat != 0
+}
+
+var ErrInvalidSectionGroup = errors.New("invalid section group")
+
+// sectionGroupTable is all groups of a file with lookup of group by member section
+type sectionGroupTable struct {
+	groups    []*SectionGroup
+	bySection map[*Section]*SectionGroup
+}
+
+// SectionGroups returns all SHT_GROUP sections decoded in section table order, nil if file has no groups.
+// Groups are decoded once per File
+func (f *File) SectionGroups() ([]*SectionGroup, error) {
+	table, err := f.sectionGroupTable()
+	if err != nil {
+		return nil, err
+	}
+	return append([]*SectionGroup(nil), table.groups...), nil
+}
+
+// Group returns group the section is member of, nil if it doesn't belong to any group
+func (s *Section) Group() (*SectionGroup, error) {
+	table, err := s.file.sectionGroupTable()
+	if err != nil {
+		return nil, err
+	}
+	return table.bySection[s], nil
+}
+
+func (f *File) sectionGroupTable() (*sectionGroupTable, error) {
+	f.sectionGroupsOnce.Do(func() {
+		f.sectionGroups, f.sectionGroupsErr = f.readSectionGroups()
+	})
+	return f.sectionGroups, f.sectionGroupsErr
+}
+
+func (f *File) readSectionGroups() (*sectionGroupTable, error) {
+	table := &sectionGroupTable{bySection: map[*Section]*SectionGroup{}}
+	// groups of relocatable file usually share single symbol table, so it's decoded once
+	symbolTables := map[uint32][]Symbol{}
+	for _, section := range f.SectionsByType(SectionTypeSectionGroup) {
+		symbols, ok := symbolTables[section.Link]
+		if !ok {
+			var err error
+			if symbols, err = f.groupSymbols(section); err != nil {
+				return nil, fmt.Errorf("group section %v: %w", section.Name, err)
+			}
+			symbolTables[section.Link] = symbols
+		}
+		group, err := f.readSectionGroup(section, symbols)
+		if err != nil {
+			return nil, fmt.Errorf("group section %v: %w", section.Name, err)
+		}
+		for _, member := range group.Members {
+			if other, ok := table.bySection[member]; ok {
+				return nil, fmt.Errorf("%w section %v is member of both %v and %v", ErrInvalidSectionGroup, member.Name, other.Signature, group.Signature)
+			}
+			table.bySection[member] = group
+		}
+		table.groups = append(table.groups, group)
+	}
+	return table, nil
+}
+
+// groupSymbols decodes symbol table linked by group section, which holds its signature symbol
+func (f *File) groupSymbols(section *Section) ([]Symbol, error) {
+	if int(section.Link) >= len(f.Sections) {
+		return nil, fmt.Errorf("%w symbol table index %v out of bounds: %v", ErrInvalidSectionGroup, section.Link, len(f.Sections))
+	}
+	symbols, err := f.SectionSymbols(f.Sections[section.Link])
+	if err != nil {
+		return nil, fmt.Errorf("signature: %w", err)
+	}
+	return symbols, nil
+}
+
+func (f *File) readSectionGroup(section *Section, symbols []Symbol) (*SectionGroup, error) {
+	content, err := section.Data()
+	if err != nil {
+		return nil, fmt.Errorf("%w read: %v", ErrInvalidSectionGroup, err)
+	}
+	if len(content) < 4 || len(content)%4 != 0 {
+		return nil, fmt.Errorf("%w size %v is not multiple of 4 entry words", ErrInvalidSectionGroup, len(content))
+	}
+	byteOrder := f.ByteOrder()
+	group := &SectionGroup{Section: section, Flags: GroupFlags(byteOrder.Uint32(content))}
+
+	for offset := 4; offset < len(content); offset += 4 {
+		index := byteOrder.Uint32(content[offset:])
+		if index == 0 || int(index) >= len(f.Sections) {
+			return nil, fmt.Errorf("%w member index %v out of bounds: %v", ErrInvalidSectionGroup, index, len(f.Sections))
+		}
+		group.Members = append(group.Members, f.Sections[index])
+	}
+
+	if int(section.Info) >= len(symbols) {
+		return nil, fmt.Errorf("%w signature symbol index %v out of bounds: %v", ErrInvalidSectionGroup, section.Info, len(symbols))
+	}
+	group.SignatureSymbol = symbols[section.Info]
+	group.Signature = group.SignatureSymbol.Name
+	if group.SignatureSymbol.Type == SymbolTypeSection && int(group.SignatureSymbol.SectionIndex) < len(f.Sections) {
+		group.Signature = f.Sections[group.SignatureSymbol.SectionIndex].Name
+	}
+	return group, nil
+}
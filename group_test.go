@@ -0,0 +1,73 @@
+package elf
+
+import (
+	"errors"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSectionGroups(t *testing.T) {
+	file, err := Open(filepath.Join("testdata", "sample_linux_amd64.o"))
+	assert.NoError(t, err)
+	defer file.Close()
+
+	groups, err := file.SectionGroups()
+	assert.NoError(t, err)
+	assert.Len(t, groups, 4)
+
+	group := groups[2]
+	assert.True(t, group.IsComdat())
+	assert.Equal(t, "COMDAT", group.Flags.String())
+	assert.Equal(t, "DW.ref._ZTISt9exception", group.Signature)
+	assert.Equal(t, group.Signature, group.SignatureSymbol.Name)
+	assert.Equal(t, file.Sections[3], group.Section)
+	assert.Equal(t, []*Section{file.Sections[33], file.Sections[34]}, group.Members)
+
+	member, err := file.Section(".rela.data.rel.local.DW.ref._ZTISt9exception").Group()
+	assert.NoError(t, err)
+	assert.Same(t, group, member)
+
+	member, err = file.Section(".text").Group()
+	assert.NoError(t, err)
+	assert.Nil(t, member)
+}
+
+func TestSectionGroups32(t *testing.T) {
+	file, err := Open(filepath.Join("testdata", "object_linux_386.o"))
+	assert.NoError(t, err)
+	defer file.Close()
+
+	section := file.Section(".text.__x86.get_pc_thunk.ax")
+	assert.True(t, section.Flags.InGroup())
+	group, err := section.Group()
+	assert.NoError(t, err)
+	assert.True(t, group.IsComdat())
+	assert.Equal(t, "__x86.get_pc_thunk.ax", group.Signature)
+	assert.Equal(t, []*Section{section}, group.Members)
+}
+
+func TestNoSectionGroups(t *testing.T) {
+	file, err := Open(filepath.Join("testdata", "libsample.so"))
+	assert.NoError(t, err)
+	defer file.Close()
+
+	groups, err := file.SectionGroups()
+	assert.NoError(t, err)
+	assert.Empty(t, groups)
+}
+
+func TestInvalidSectionGroupMember(t *testing.T) {
+	content, err := ioutil.ReadFile(filepath.Join("testdata", "object_linux_386.o"))
+	assert.NoError(t, err)
+	file, err := Open(filepath.Join("testdata", "object_linux_386.o"))
+	assert.NoError(t, err)
+	defer file.Close()
+
+	groupSection := file.SectionsByType(SectionTypeSectionGroup)[0]
+	content[groupSection.Offset+4] = 0xff
+	_, err = sectionOf(t, content, ".text").Group()
+	assert.True(t, errors.Is(err, ErrInvalidSectionGroup))
+}
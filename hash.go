@@ -0,0 +1,329 @@
+package elf
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+)
+
+var ErrNoHashTable = errors.New("no symbol hash table")
+var ErrInvalidHashTable = errors.New("invalid symbol hash table")
+var ErrSymbolNotFound = errors.New("symbol not found")
+
+// GNUHash is hash function used by DT_GNU_HASH tables (Bernstein hash)
+func GNUHash(name string) uint32 {
+	h := uint32(5381)
+	for i := 0; i < len(name); i++ {
+		h = h*33 + uint32(name[i])
+	}
+	return h
+}
+
+// SysVHash is hash function used by DT_HASH tables
+func SysVHash(name string) uint32 {
+	var h uint32
+	for i := 0; i < len(name); i++ {
+		h = h<<4 + uint32(name[i])
+		g := h & 0xf0000000
+		if g != 0 {
+			h ^= g >> 24
+		}
+		h &^= g
+	}
+	return h
+}
+
+// gnuHashTable is decoded DT_GNU_HASH table, chains are indexed by symbol index - symbolOffset
+type gnuHashTable struct {
+	symbolOffset uint32
+	bloomShift   uint32
+	bloomBits    uint32   // bits in bloom filter word, 32 or 64 by class
+	bloom        []uint64 // 32bit class words are widened
+	buckets      []uint32
+	chains       []uint32
+}
+
+// sysvHashTable is decoded DT_HASH table, chains are indexed by symbol index
+type sysvHashTable struct {
+	buckets []uint32
+	chains  []uint32
+}
+
+// dynamicSymbolTable is symbol table reached through dynamic section together with its hash tables
+type dynamicSymbolTable struct {
+	symbols []Symbol
+	gnuHash *gnuHashTable
+	sysv    *sysvHashTable
+}
+
+// LookupDynamicSymbol finds symbol defined by this object by name using DT_GNU_HASH table or DT_HASH if there is no GNU one.
+// Symbol with default version is preferred over hidden versions of the same name.
+// Tables are reached through dynamic section, so section headers are not required, and are decoded once per File
+func (f *File) LookupDynamicSymbol(name string) (Symbol, error) {
+	table, err := f.dynamicSymbolTable()
+	if err != nil {
+		return Symbol{}, err
+	}
+	index, found := table.lookup(name)
+	if !found {
+		return Symbol{}, fmt.Errorf("%w: %v", ErrSymbolNotFound, name)
+	}
+	return table.symbols[index], nil
+}
+
+func (f *File) dynamicSymbolTable() (*dynamicSymbolTable, error) {
+	f.dynamicSymbolsOnce.Do(func() {
+		f.dynamicSymbols, f.dynamicSymbolsErr = f.readDynamicSymbolTable()
+	})
+	return f.dynamicSymbols, f.dynamicSymbolsErr
+}
+
+func (f *File) readDynamicSymbolTable() (*dynamicSymbolTable, error) {
+	entries, err := f.DynamicEntries()
+	if err != nil {
+		return nil, err
+	}
+	table := &dynamicSymbolTable{}
+	var symbolCount uint32
+	if address, ok := dynamicValue(entries, DynamicTagGNUHash); ok {
+		table.gnuHash, symbolCount, err = f.readGNUHashTable(MemoryAddress(address))
+	} else if address, ok := dynamicValue(entries, DynamicTagHash); ok {
+		table.sysv, err = f.readSysVHashTable(MemoryAddress(address))
+		if table.sysv != nil {
+			symbolCount = uint32(len(table.sysv.chains))
+		}
+	} else {
+		return nil, ErrNoHashTable
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	table.symbols, err = f.readDynamicTableSymbols(entries, symbolCount)
+	if err != nil {
+		return nil, err
+	}
+	if err := f.attachVersions(table.symbols); err != nil {
+		return nil, err
+	}
+	return table, nil
+}
+
+func (f *File) readGNUHashTable(address MemoryAddress) (*gnuHashTable, uint32, error) {
+	vm := f.VirtualMemory()
+	header, err := f.readWords(vm, address, 4)
+	if err != nil {
+		return nil, 0, fmt.Errorf("%w GNU header read: %v", ErrInvalidHashTable, err)
+	}
+	bucketCount, bloomSize := header[0], header[2]
+	table := &gnuHashTable{symbolOffset: header[1], bloomShift: header[3], bloomBits: 32}
+	if bucketCount == 0 || bloomSize == 0 {
+		return nil, 0, fmt.Errorf("%w GNU table with %v buckets and %v bloom words", ErrInvalidHashTable, bucketCount, bloomSize)
+	}
+	address += 16
+
+	if f.Class == ELFClass64 {
+		words, err := f.readWords(vm, address, uint64(bloomSize)*2)
+		if err != nil {
+			return nil, 0, fmt.Errorf("%w GNU bloom filter read: %v", ErrInvalidHashTable, err)
+		}
+		table.bloomBits = 64
+		table.bloom = make([]uint64, bloomSize)
+		for i := range table.bloom {
+			// words are read in native order, so combine them the same way
+			low, high := words[2*i], words[2*i+1]
+			if f.Endianess == BigEndian {
+				low, high = high, low
+			}
+			table.bloom[i] = uint64(high)<<32 | uint64(low)
+		}
+		address += MemoryAddress(bloomSize) * 8
+	} else {
+		words, err := f.readWords(vm, address, uint64(bloomSize))
+		if err != nil {
+			return nil, 0, fmt.Errorf("%w GNU bloom filter read: %v", ErrInvalidHashTable, err)
+		}
+		table.bloom = make([]uint64, bloomSize)
+		for i, word := range words {
+			table.bloom[i] = uint64(word)
+		}
+		address += MemoryAddress(bloomSize) * 4
+	}
+
+	table.buckets, err = f.readWords(vm, address, uint64(bucketCount))
+	if err != nil {
+		return nil, 0, fmt.Errorf("%w GNU buckets read: %v", ErrInvalidHashTable, err)
+	}
+	address += MemoryAddress(bucketCount) * 4
+
+	// table has no explicit symbol count, it ends with last chain of highest bucket
+	var lastChainStart uint32
+	for _, bucket := range table.buckets {
+		if bucket > lastChainStart {
+			lastChainStart = bucket
+		}
+	}
+	if lastChainStart == 0 {
+		return table, table.symbolOffset, nil
+	}
+	if lastChainStart < table.symbolOffset {
+		return nil, 0, fmt.Errorf("%w GNU bucket %v points below symbol offset %v", ErrInvalidHashTable, lastChainStart, table.symbolOffset)
+	}
+	table.chains, err = f.readWords(vm, address, uint64(lastChainStart-table.symbolOffset)+1)
+	if err != nil {
+		return nil, 0, fmt.Errorf("%w GNU chains read: %v", ErrInvalidHashTable, err)
+	}
+	for table.chains[len(table.chains)-1]&1 == 0 {
+		next, err := f.readWords(vm, address+MemoryAddress(len(table.chains))*4, 1)
+		if err != nil {
+			return nil, 0, fmt.Errorf("%w GNU chains read: %v", ErrInvalidHashTable, err)
+		}
+		table.chains = append(table.chains, next[0])
+	}
+	return table, table.symbolOffset + uint32(len(table.chains)), nil
+}
+
+func (f *File) readSysVHashTable(address MemoryAddress) (*sysvHashTable, error) {
+	vm := f.VirtualMemory()
+	header, err := f.readWords(vm, address, 2)
+	if err != nil {
+		return nil, fmt.Errorf("%w header read: %v", ErrInvalidHashTable, err)
+	}
+	if header[0] == 0 {
+		return nil, fmt.Errorf("%w table without buckets", ErrInvalidHashTable)
+	}
+	words, err := f.readWords(vm, address+8, uint64(header[0])+uint64(header[1]))
+	if err != nil {
+		return nil, fmt.Errorf("%w buckets and chains read: %v", ErrInvalidHashTable, err)
+	}
+	return &sysvHashTable{buckets: words[:header[0]], chains: words[header[0]:]}, nil
+}
+
+func (f *File) readDynamicTableSymbols(entries []DynamicEntry, count uint32) ([]Symbol, error) {
+	address, ok := dynamicValue(entries, DynamicTagSymTab)
+	if !ok {
+		return nil, fmt.Errorf("%w missing DT_SYMTAB", ErrInvalidDynamicEntry)
+	}
+	entrySize := uint64(symbolSize32)
+	if f.Class == ELFClass64 {
+		entrySize = symbolSize64
+	}
+	if size, ok := dynamicValue(entries, DynamicTagSymEnt); ok && size != 0 {
+		if size < entrySize {
+			return nil, fmt.Errorf("%w DT_SYMENT %v is smaller than %v", ErrInvalidSymbol, size, entrySize)
+		}
+		entrySize = size
+	}
+	names, err := f.dynamicStringTable(entries)
+	if err != nil {
+		return nil, err
+	}
+
+	// count and entry size come from file, so their product is checked against mapped bytes without overflowing
+	vm := f.VirtualMemory()
+	if mapped := vm.mappedSize(MemoryAddress(address)); uint64(count) > mapped/entrySize {
+		return nil, fmt.Errorf("%w DT_SYMTAB of %v symbols overflows %v mapped bytes", ErrInvalidSymbol, count, mapped)
+	}
+	content, err := vm.read(MemoryAddress(address), uint64(count)*entrySize)
+	if err != nil {
+		return nil, fmt.Errorf("%w DT_SYMTAB read: %v", ErrInvalidSymbol, err)
+	}
+	symbols := make([]Symbol, 0, count)
+	for offset := uint64(0); offset < uint64(len(content)); offset += entrySize {
+		symbol, err := ReadSymbol(f.NativeReader(bytes.NewReader(content[offset : offset+entrySize])))
+		if err != nil {
+			return nil, fmt.Errorf("symbol %v: %w", len(symbols), err)
+		}
+		symbol.Name, err = names.String(symbol.NameOffset)
+		if err != nil {
+			return nil, fmt.Errorf("symbol %v name: %w", len(symbols), err)
+		}
+		symbols = append(symbols, symbol)
+	}
+	return symbols, nil
+}
+
+// readWords reads count of native order 4 byte words at virtual address
+func (f *File) readWords(vm *VirtualMemory, address MemoryAddress, count uint64) ([]uint32, error) {
+	content, err := vm.read(address, count*4)
+	if err != nil {
+		return nil, err
+	}
+	byteOrder := f.ByteOrder()
+	words := make([]uint32, count)
+	for i := range words {
+		words[i] = byteOrder.Uint32(content[i*4:])
+	}
+	return words, nil
+}
+
+func (t *dynamicSymbolTable) lookup(name string) (int, bool) {
+	if t.gnuHash != nil {
+		return t.gnuHash.lookup(name, t.symbols)
+	}
+	return t.sysv.lookup(name, t.symbols)
+}
+
+func (t *gnuHashTable) lookup(name string, symbols []Symbol) (int, bool) {
+	hash := GNUHash(name)
+	word := t.bloom[(hash/t.bloomBits)%uint32(len(t.bloom))]
+	mask := uint64(1)<<(hash%t.bloomBits) | uint64(1)<<((hash>>t.bloomShift)%t.bloomBits)
+	if word&mask != mask {
+		return 0, false
+	}
+
+	index := t.buckets[hash%uint32(len(t.buckets))]
+	if index < t.symbolOffset {
+		return 0, false
+	}
+	var match matchingSymbol
+	for ; int(index-t.symbolOffset) < len(t.chains) && int(index) < len(symbols); index++ {
+		chainHash := t.chains[index-t.symbolOffset]
+		if chainHash|1 == hash|1 && match.check(symbols, index, name) {
+			break
+		}
+		if chainHash&1 != 0 {
+			break
+		}
+	}
+	return match.result()
+}
+
+func (t *sysvHashTable) lookup(name string, symbols []Symbol) (int, bool) {
+	hash := SysVHash(name)
+	index := t.buckets[hash%uint32(len(t.buckets))]
+	// chain length is bounded to survive cycles in malformed tables
+	var match matchingSymbol
+	for steps := 0; index != 0 && int(index) < len(symbols) && steps < len(t.chains); steps++ {
+		if symbols[index].SectionIndex != SectionIndexUndefined && match.check(symbols, index, name) {
+			break
+		}
+		index = t.chains[index]
+	}
+	return match.result()
+}
+
+// matchingSymbol remembers first hidden version match while chain is searched for default version
+type matchingSymbol struct {
+	index uint32
+	found bool
+}
+
+// check reports if symbol is default version match and search can stop
+func (m *matchingSymbol) check(symbols []Symbol, index uint32, name string) bool {
+	if symbols[index].Name != name {
+		return false
+	}
+	if !m.found {
+		m.index, m.found = index, true
+	}
+	if symbols[index].VersionHidden {
+		return false
+	}
+	m.index = index
+	return true
+}
+
+func (m *matchingSymbol) result() (int, bool) {
+	return int(m.index), m.found
+}
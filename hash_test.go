@@ -0,0 +1,118 @@
+package elf
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io/ioutil"
+	"math"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLookupDynamicSymbol(t *testing.T) {
+	file, err := Open(filepath.Join("testdata", "libsample.so"))
+	assert.NoError(t, err)
+	defer file.Close()
+
+	symbol, err := file.LookupDynamicSymbol("sample_name")
+	assert.NoError(t, err)
+	assert.Equal(t, "sample_name", symbol.Name)
+	assert.Equal(t, SymbolTypeFunc, symbol.Type)
+	assert.Equal(t, MemoryAddress(0x1121), symbol.Value)
+
+	_, err = file.LookupDynamicSymbol("getenv")
+	assert.True(t, errors.Is(err, ErrSymbolNotFound), "undefined symbols are not definitions")
+	_, err = file.LookupDynamicSymbol("no_such_symbol")
+	assert.True(t, errors.Is(err, ErrSymbolNotFound))
+}
+
+func TestHashTablesFindEveryDefinedSymbol(t *testing.T) {
+	file, err := Open(filepath.Join("testdata", "libsample.so"))
+	assert.NoError(t, err)
+	defer file.Close()
+
+	table, err := file.dynamicSymbolTable()
+	assert.NoError(t, err)
+	assert.NotNil(t, table.gnuHash)
+
+	entries, err := file.DynamicEntries()
+	assert.NoError(t, err)
+	address, ok := dynamicValue(entries, DynamicTagHash)
+	assert.True(t, ok)
+	sysv, err := file.readSysVHashTable(MemoryAddress(address))
+	assert.NoError(t, err)
+	assert.Len(t, sysv.chains, len(table.symbols))
+
+	for _, symbol := range table.symbols {
+		if symbol.SectionIndex == SectionIndexUndefined {
+			continue
+		}
+		index, found := table.gnuHash.lookup(symbol.Name, table.symbols)
+		assert.True(t, found, symbol.Name)
+		assert.Equal(t, symbol.Name, table.symbols[index].Name)
+
+		index, found = sysv.lookup(symbol.Name, table.symbols)
+		assert.True(t, found, symbol.Name)
+		assert.Equal(t, symbol.Name, table.symbols[index].Name)
+	}
+}
+
+func TestDynamicSymbolsWithoutSectionHeaders(t *testing.T) {
+	file, err := NewFile(bytes.NewReader(withoutSectionHeaders(t, "libsample.so")))
+	assert.NoError(t, err)
+	assert.Empty(t, file.Sections)
+
+	symbols, err := file.DynamicSymbols()
+	assert.NoError(t, err)
+	assert.Len(t, symbols, 12)
+	assert.Equal(t, "getenv", symbols[1].Name)
+	assert.Equal(t, "SAMPLE_2.0", symbols[8].Name)
+
+	symbol, err := file.LookupDynamicSymbol("SAMPLE_1.0")
+	assert.NoError(t, err)
+	assert.Equal(t, SectionIndexAbsolute, symbol.SectionIndex)
+}
+
+func TestNoHashTable(t *testing.T) {
+	file, err := Open(filepath.Join("testdata", "helloworld_linux_amd64"))
+	assert.NoError(t, err)
+	defer file.Close()
+
+	_, err = file.LookupDynamicSymbol("main")
+	assert.True(t, errors.Is(err, ErrNoDynamicSection))
+}
+
+func TestHashFunctions(t *testing.T) {
+	assert.Equal(t, uint32(0x00001505), GNUHash(""))
+	assert.Equal(t, uint32(0x156b2bb8), GNUHash("printf"))
+	assert.Equal(t, uint32(0x0), SysVHash(""))
+	assert.Equal(t, uint32(0x077905a6), SysVHash("printf"))
+}
+
+func TestMalformedHashTableSizes(t *testing.T) {
+	content, err := ioutil.ReadFile(filepath.Join("testdata", "libsample.so"))
+	assert.NoError(t, err)
+	file, err := NewFile(bytes.NewReader(content))
+	assert.NoError(t, err)
+	entries, err := file.DynamicEntries()
+	assert.NoError(t, err)
+
+	// symbol count is checked against mapped bytes before allocating
+	_, err = file.readDynamicTableSymbols(entries, math.MaxUint32)
+	assert.True(t, errors.Is(err, ErrInvalidSymbol))
+
+	// sum of bucket and chain counts overflows 32 bits
+	address, ok := dynamicValue(entries, DynamicTagHash)
+	assert.True(t, ok)
+	offset, err := file.AddressToOffset(MemoryAddress(address))
+	assert.NoError(t, err)
+	binary.LittleEndian.PutUint32(content[offset:], 0x80000000)
+	binary.LittleEndian.PutUint32(content[offset+4:], 0x80000000)
+	file, err = NewFile(bytes.NewReader(content))
+	assert.NoError(t, err)
+	_, err = file.readSysVHashTable(MemoryAddress(address))
+	assert.True(t, errors.Is(err, ErrInvalidHashTable))
+}
@@ -0,0 +1,622 @@
+package elf
+
+import "fmt"
+
+// InstructionSet is e_machine field of header, constants are named by specification EM_ names without underscores
+type InstructionSet int
+
+const (
+	//0x00	EM_NONE	Unknown machine
+	ISNotSpecified InstructionSet = 0
+	//0x01	EM_M32	AT&T WE32100
+	ISM32 InstructionSet = 1
+	//0x02	EM_SPARC	Sun SPARC
+	ISSparc InstructionSet = 2
+	//0x03	EM_386	Intel i386
+	ISx86 InstructionSet = 3
+	//0x04	EM_68K	Motorola 68000
+	IS68K InstructionSet = 4
+	//0x05	EM_88K	Motorola 88000
+	IS88K InstructionSet = 5
+	//0x06	EM_IAMCU	Intel MCU
+	ISIAMCU InstructionSet = 6
+	//0x07	EM_860	Intel i860
+	IS860 InstructionSet = 7
+	//0x08	EM_MIPS	MIPS R3000 Big-Endian only
+	ISMIPS InstructionSet = 8
+	//0x09	EM_S370	IBM System/370
+	ISS370 InstructionSet = 9
+	//0x0A	EM_MIPS_RS3_LE	MIPS R3000 Little-Endian
+	ISMIPSRS3LE InstructionSet = 10
+	//0x0F	EM_PARISC	HP PA-RISC
+	ISPARISC InstructionSet = 15
+	//0x11	EM_VPP500	Fujitsu VPP500
+	ISVPP500 InstructionSet = 17
+	//0x12	EM_SPARC32PLUS	SPARC v8plus
+	ISSPARC32PLUS InstructionSet = 18
+	//0x13	EM_960	Intel 80960
+	IS960 InstructionSet = 19
+	//0x14	EM_PPC	PowerPC 32-bit
+	ISPowerPC InstructionSet = 20
+	//0x15	EM_PPC64	PowerPC 64-bit
+	ISPowerPC64 InstructionSet = 21
+	//0x16	EM_S390	IBM System/390
+	ISS390WithS390x InstructionSet = 22
+	//0x17	EM_SPU	IBM SPU/SPC
+	ISSPU InstructionSet = 23
+	//0x24	EM_V800	NEC V800
+	ISV800 InstructionSet = 36
+	//0x25	EM_FR20	Fujitsu FR20
+	ISFR20 InstructionSet = 37
+	//0x26	EM_RH32	TRW RH-32
+	ISRH32 InstructionSet = 38
+	//0x27	EM_RCE	Motorola RCE
+	ISRCE InstructionSet = 39
+	//0x28	EM_ARM	ARM
+	ISARM InstructionSet = 40
+	//0x2A	EM_SH	Hitachi SH
+	ISSuperH InstructionSet = 42
+	//0x2B	EM_SPARCV9	SPARC v9 64-bit
+	ISSPARCV9 InstructionSet = 43
+	//0x2C	EM_TRICORE	Siemens TriCore embedded processor
+	ISTRICORE InstructionSet = 44
+	//0x2D	EM_ARC	Argonaut RISC Core
+	ISARC InstructionSet = 45
+	//0x2E	EM_H8_300	Hitachi H8/300
+	ISH8300 InstructionSet = 46
+	//0x2F	EM_H8_300H	Hitachi H8/300H
+	ISH8300H InstructionSet = 47
+	//0x30	EM_H8S	Hitachi H8S
+	ISH8S InstructionSet = 48
+	//0x31	EM_H8_500	Hitachi H8/500
+	ISH8500 InstructionSet = 49
+	//0x32	EM_IA_64	Intel IA-64 Processor
+	ISIA64 InstructionSet = 50
+	//0x33	EM_MIPS_X	Stanford MIPS-X
+	ISMIPSX InstructionSet = 51
+	//0x34	EM_COLDFIRE	Motorola ColdFire
+	ISCOLDFIRE InstructionSet = 52
+	//0x35	EM_68HC12	Motorola M68HC12
+	IS68HC12 InstructionSet = 53
+	//0x36	EM_MMA	Fujitsu MMA
+	ISMMA InstructionSet = 54
+	//0x37	EM_PCP	Siemens PCP
+	ISPCP InstructionSet = 55
+	//0x38	EM_NCPU	Sony nCPU
+	ISNCPU InstructionSet = 56
+	//0x39	EM_NDR1	Denso NDR1 microprocessor
+	ISNDR1 InstructionSet = 57
+	//0x3A	EM_STARCORE	Motorola Star*Core processor
+	ISSTARCORE InstructionSet = 58
+	//0x3B	EM_ME16	Toyota ME16 processor
+	ISME16 InstructionSet = 59
+	//0x3C	EM_ST100	STMicroelectronics ST100 processor
+	ISST100 InstructionSet = 60
+	//0x3D	EM_TINYJ	Advanced Logic Corp. TinyJ processor
+	ISTINYJ InstructionSet = 61
+	//0x3E	EM_X86_64	Advanced Micro Devices x86-64
+	ISAmd64 InstructionSet = 62
+	//0x3F	EM_PDSP	Sony DSP Processor
+	ISPDSP InstructionSet = 63
+	//0x40	EM_PDP10	Digital Equipment Corp. PDP-10
+	ISPDP10 InstructionSet = 64
+	//0x41	EM_PDP11	Digital Equipment Corp. PDP-11
+	ISPDP11 InstructionSet = 65
+	//0x42	EM_FX66	Siemens FX66 microcontroller
+	ISFX66 InstructionSet = 66
+	//0x43	EM_ST9PLUS	STMicroelectronics ST9+ 8/16 bit microcontroller
+	ISST9PLUS InstructionSet = 67
+	//0x44	EM_ST7	STMicroelectronics ST7 8-bit microcontroller
+	ISST7 InstructionSet = 68
+	//0x45	EM_68HC16	Motorola MC68HC16 Microcontroller
+	IS68HC16 InstructionSet = 69
+	//0x46	EM_68HC11	Motorola MC68HC11 Microcontroller
+	IS68HC11 InstructionSet = 70
+	//0x47	EM_68HC08	Motorola MC68HC08 Microcontroller
+	IS68HC08 InstructionSet = 71
+	//0x48	EM_68HC05	Motorola MC68HC05 Microcontroller
+	IS68HC05 InstructionSet = 72
+	//0x49	EM_SVX	Silicon Graphics SVx
+	ISSVX InstructionSet = 73
+	//0x4A	EM_ST19	STMicroelectronics ST19 8-bit microcontroller
+	ISST19 InstructionSet = 74
+	//0x4B	EM_VAX	Digital VAX
+	ISVAX InstructionSet = 75
+	//0x4C	EM_CRIS	Axis Communications 32-bit embedded processor
+	ISCRIS InstructionSet = 76
+	//0x4D	EM_JAVELIN	Infineon Technologies 32-bit embedded processor
+	ISJAVELIN InstructionSet = 77
+	//0x4E	EM_FIREPATH	Element 14 64-bit DSP Processor
+	ISFIREPATH InstructionSet = 78
+	//0x4F	EM_ZSP	LSI Logic 16-bit DSP Processor
+	ISZSP InstructionSet = 79
+	//0x50	EM_MMIX	Donald Knuth's educational 64-bit processor
+	ISMMIX InstructionSet = 80
+	//0x51	EM_HUANY	Harvard University machine-independent object files
+	ISHUANY InstructionSet = 81
+	//0x52	EM_PRISM	SiTera Prism
+	ISPRISM InstructionSet = 82
+	//0x53	EM_AVR	Atmel AVR 8-bit microcontroller
+	ISAVR InstructionSet = 83
+	//0x54	EM_FR30	Fujitsu FR30
+	ISFR30 InstructionSet = 84
+	//0x55	EM_D10V	Mitsubishi D10V
+	ISD10V InstructionSet = 85
+	//0x56	EM_D30V	Mitsubishi D30V
+	ISD30V InstructionSet = 86
+	//0x57	EM_V850	NEC v850
+	ISV850 InstructionSet = 87
+	//0x58	EM_M32R	Mitsubishi M32R
+	ISM32R InstructionSet = 88
+	//0x59	EM_MN10300	Matsushita MN10300
+	ISMN10300 InstructionSet = 89
+	//0x5A	EM_MN10200	Matsushita MN10200
+	ISMN10200 InstructionSet = 90
+	//0x5B	EM_PJ	picoJava
+	ISPJ InstructionSet = 91
+	//0x5C	EM_OPENRISC	OpenRISC 32-bit embedded processor
+	ISOPENRISC InstructionSet = 92
+	//0x5D	EM_ARC_COMPACT	ARC International ARCompact processor (old spelling/synonym: EM_ARC_A5)
+	ISARCCOMPACT InstructionSet = 93
+	//0x5E	EM_XTENSA	Tensilica Xtensa Architecture
+	ISXTENSA InstructionSet = 94
+	//0x5F	EM_VIDEOCORE	Alphamosaic VideoCore processor
+	ISVIDEOCORE InstructionSet = 95
+	//0x60	EM_TMM_GPP	Thompson Multimedia General Purpose Processor
+	ISTMMGPP InstructionSet = 96
+	//0x61	EM_NS32K	National Semiconductor 32000 series
+	ISNS32K InstructionSet = 97
+	//0x62	EM_TPC	Tenor Network TPC processor
+	ISTPC InstructionSet = 98
+	//0x63	EM_SNP1K	Trebia SNP 1000 processor
+	ISSNP1K InstructionSet = 99
+	//0x64	EM_ST200	STMicroelectronics (www.st.com) ST200 microcontroller
+	ISST200 InstructionSet = 100
+	//0x65	EM_IP2K	Ubicom IP2xxx microcontroller family
+	ISIP2K InstructionSet = 101
+	//0x66	EM_MAX	MAX Processor
+	ISMAX InstructionSet = 102
+	//0x67	EM_CR	National Semiconductor CompactRISC microprocessor
+	ISCR InstructionSet = 103
+	//0x68	EM_F2MC16	Fujitsu F2MC16
+	ISF2MC16 InstructionSet = 104
+	//0x69	EM_MSP430	Texas Instruments embedded microcontroller msp430
+	ISMSP430 InstructionSet = 105
+	//0x6A	EM_BLACKFIN	Analog Devices Blackfin (DSP) processor
+	ISBLACKFIN InstructionSet = 106
+	//0x6B	EM_SE_C33	S1C33 Family of Seiko Epson processors
+	ISSEC33 InstructionSet = 107
+	//0x6C	EM_SEP	Sharp embedded microprocessor
+	ISSEP InstructionSet = 108
+	//0x6D	EM_ARCA	Arca RISC Microprocessor
+	ISARCA InstructionSet = 109
+	//0x6E	EM_UNICORE	Microprocessor series from PKU-Unity Ltd. and MPRC of Peking University
+	ISUNICORE InstructionSet = 110
+	//0x6F	EM_EXCESS	eXcess: 16/32/64-bit configurable embedded CPU
+	ISEXCESS InstructionSet = 111
+	//0x70	EM_DXP	Icera Semiconductor Inc. Deep Execution Processor
+	ISDXP InstructionSet = 112
+	//0x71	EM_ALTERA_NIOS2	Altera Nios II soft-core processor
+	ISALTERANIOS2 InstructionSet = 113
+	//0x72	EM_CRX	National Semiconductor CompactRISC CRX microprocessor
+	ISCRX InstructionSet = 114
+	//0x73	EM_XGATE	Motorola XGATE embedded processor
+	ISXGATE InstructionSet = 115
+	//0x74	EM_C166	Infineon C16x/XC16x processor
+	ISC166 InstructionSet = 116
+	//0x75	EM_M16C	Renesas M16C series microprocessors
+	ISM16C InstructionSet = 117
+	//0x76	EM_DSPIC30F	Microchip Technology dsPIC30F Digital Signal Controller
+	ISDSPIC30F InstructionSet = 118
+	//0x77	EM_CE	Freescale Communication Engine RISC core
+	ISCE InstructionSet = 119
+	//0x78	EM_M32C	Renesas M32C series microprocessors
+	ISM32C InstructionSet = 120
+	//0x83	EM_TSK3000	Altium TSK3000 core
+	ISTSK3000 InstructionSet = 131
+	//0x84	EM_RS08	Freescale RS08 embedded processor
+	ISRS08 InstructionSet = 132
+	//0x85	EM_SHARC	Analog Devices SHARC family of 32-bit DSP processors
+	ISSHARC InstructionSet = 133
+	//0x86	EM_ECOG2	Cyan Technology eCOG2 microprocessor
+	ISECOG2 InstructionSet = 134
+	//0x87	EM_SCORE7	Sunplus S+core7 RISC processor
+	ISSCORE7 InstructionSet = 135
+	//0x88	EM_DSP24	New Japan Radio (NJR) 24-bit DSP Processor
+	ISDSP24 InstructionSet = 136
+	//0x89	EM_VIDEOCORE3	Broadcom VideoCore III processor
+	ISVIDEOCORE3 InstructionSet = 137
+	//0x8A	EM_LATTICEMICO32	RISC processor for Lattice FPGA architecture
+	ISLATTICEMICO32 InstructionSet = 138
+	//0x8B	EM_SE_C17	Seiko Epson C17 family
+	ISSEC17 InstructionSet = 139
+	//0x8C	EM_TI_C6000	The Texas Instruments TMS320C6000 DSP family
+	ISTMS320C6000 InstructionSet = 140
+	//0x8D	EM_TI_C2000	The Texas Instruments TMS320C2000 DSP family
+	ISTIC2000 InstructionSet = 141
+	//0x8E	EM_TI_C5500	The Texas Instruments TMS320C55x DSP family
+	ISTIC5500 InstructionSet = 142
+	//0x8F	EM_TI_ARP32	Texas Instruments Application Specific RISC Processor, 32bit fetch
+	ISTIARP32 InstructionSet = 143
+	//0x90	EM_TI_PRU	Texas Instruments Programmable Realtime Unit
+	ISTIPRU InstructionSet = 144
+	//0xA0	EM_MMDSP_PLUS	STMicroelectronics 64bit VLIW Data Signal Processor
+	ISMMDSPPLUS InstructionSet = 160
+	//0xA1	EM_CYPRESS_M8C	Cypress M8C microprocessor
+	ISCYPRESSM8C InstructionSet = 161
+	//0xA2	EM_R32C	Renesas R32C series microprocessors
+	ISR32C InstructionSet = 162
+	//0xA3	EM_TRIMEDIA	NXP Semiconductors TriMedia architecture family
+	ISTRIMEDIA InstructionSet = 163
+	//0xA4	EM_QDSP6	QUALCOMM DSP6 Processor
+	ISQDSP6 InstructionSet = 164
+	//0xA5	EM_8051	Intel 8051 and variants
+	IS8051 InstructionSet = 165
+	//0xA6	EM_STXP7X	STMicroelectronics STxP7x family of configurable and extensible RISC processors
+	ISSTXP7X InstructionSet = 166
+	//0xA7	EM_NDS32	Andes Technology compact code size embedded RISC processor family
+	ISNDS32 InstructionSet = 167
+	//0xA8	EM_ECOG1	Cyan Technology eCOG1X family
+	ISECOG1 InstructionSet = 168
+	//0xA8	EM_ECOG1X	Cyan Technology eCOG1X family
+	ISECOG1X InstructionSet = 168
+	//0xA9	EM_MAXQ30	Dallas Semiconductor MAXQ30 Core Micro-controllers
+	ISMAXQ30 InstructionSet = 169
+	//0xAA	EM_XIMO16	New Japan Radio (NJR) 16-bit DSP Processor
+	ISXIMO16 InstructionSet = 170
+	//0xAB	EM_MANIK	M2000 Reconfigurable RISC Microprocessor
+	ISMANIK InstructionSet = 171
+	//0xAC	EM_CRAYNV2	Cray Inc. NV2 vector architecture
+	ISCRAYNV2 InstructionSet = 172
+	//0xAD	EM_RX	Renesas RX family
+	ISRX InstructionSet = 173
+	//0xAE	EM_METAG	Imagination Technologies META processor architecture
+	ISMETAG InstructionSet = 174
+	//0xAF	EM_MCST_ELBRUS	MCST Elbrus general purpose hardware architecture
+	ISMCSTELBRUS InstructionSet = 175
+	//0xB0	EM_ECOG16	Cyan Technology eCOG16 family
+	ISECOG16 InstructionSet = 176
+	//0xB1	EM_CR16	National Semiconductor CompactRISC CR16 16-bit microprocessor
+	ISCR16 InstructionSet = 177
+	//0xB2	EM_ETPU	Freescale Extended Time Processing Unit
+	ISETPU InstructionSet = 178
+	//0xB3	EM_SLE9X	Infineon Technologies SLE9X core
+	ISSLE9X InstructionSet = 179
+	//0xB4	EM_L10M	Intel L10M
+	ISL10M InstructionSet = 180
+	//0xB5	EM_K10M	Intel K10M
+	ISK10M InstructionSet = 181
+	//0xB7	EM_AARCH64	ARM 64-bit Architecture (AArch64)
+	ISAArch64 InstructionSet = 183
+	//0xB9	EM_AVR32	Atmel Corporation 32-bit microprocessor family
+	ISAVR32 InstructionSet = 185
+	//0xBA	EM_STM8	STMicroeletronics STM8 8-bit microcontroller
+	ISSTM8 InstructionSet = 186
+	//0xBB	EM_TILE64	Tilera TILE64 multicore architecture family
+	ISTILE64 InstructionSet = 187
+	//0xBC	EM_TILEPRO	Tilera TILEPro multicore architecture family
+	ISTILEPRO InstructionSet = 188
+	//0xBD	EM_MICROBLAZE	Xilinx MicroBlaze 32-bit RISC soft processor core
+	ISMICROBLAZE InstructionSet = 189
+	//0xBE	EM_CUDA	NVIDIA CUDA architecture
+	ISCUDA InstructionSet = 190
+	//0xBF	EM_TILEGX	Tilera TILE-Gx multicore architecture family
+	ISTILEGX InstructionSet = 191
+	//0xC0	EM_CLOUDSHIELD	CloudShield architecture family
+	ISCLOUDSHIELD InstructionSet = 192
+	//0xC1	EM_COREA_1ST	KIPO-KAIST Core-A 1st generation processor family
+	ISCOREA1ST InstructionSet = 193
+	//0xC2	EM_COREA_2ND	KIPO-KAIST Core-A 2nd generation processor family
+	ISCOREA2ND InstructionSet = 194
+	//0xC3	EM_ARC_COMPACT2	Synopsys ARCompact V2
+	ISARCCOMPACT2 InstructionSet = 195
+	//0xC4	EM_OPEN8	Open8 8-bit RISC soft processor core
+	ISOPEN8 InstructionSet = 196
+	//0xC5	EM_RL78	Renesas RL78 family
+	ISRL78 InstructionSet = 197
+	//0xC6	EM_VIDEOCORE5	Broadcom VideoCore V processor
+	ISVIDEOCORE5 InstructionSet = 198
+	//0xC7	EM_78KOR	Renesas 78KOR family
+	IS78KOR InstructionSet = 199
+	//0xC8	EM_56800EX	Freescale 56800EX Digital Signal Controller (DSC)
+	IS56800EX InstructionSet = 200
+	//0xC9	EM_BA1	Beyond BA1 CPU architecture
+	ISBA1 InstructionSet = 201
+	//0xCA	EM_BA2	Beyond BA2 CPU architecture
+	ISBA2 InstructionSet = 202
+	//0xCB	EM_XCORE	XMOS xCORE processor family
+	ISXCORE InstructionSet = 203
+	//0xCC	EM_MCHP_PIC	Microchip 8-bit PIC(r) family
+	ISMCHPPIC InstructionSet = 204
+	//0xCD	EM_INTEL205	Reserved by Intel
+	ISINTEL205 InstructionSet = 205
+	//0xCE	EM_INTEL206	Reserved by Intel
+	ISINTEL206 InstructionSet = 206
+	//0xCF	EM_INTEL207	Reserved by Intel
+	ISINTEL207 InstructionSet = 207
+	//0xD0	EM_INTEL208	Reserved by Intel
+	ISINTEL208 InstructionSet = 208
+	//0xD1	EM_INTEL209	Reserved by Intel
+	ISINTEL209 InstructionSet = 209
+	//0xD2	EM_KM32	KM211 KM32 32-bit processor
+	ISKM32 InstructionSet = 210
+	//0xD3	EM_KMX32	KM211 KMX32 32-bit processor
+	ISKMX32 InstructionSet = 211
+	//0xD4	EM_KMX16	KM211 KMX16 16-bit processor
+	ISKMX16 InstructionSet = 212
+	//0xD5	EM_KMX8	KM211 KMX8 8-bit processor
+	ISKMX8 InstructionSet = 213
+	//0xD6	EM_KVARC	KM211 KVARC processor
+	ISKVARC InstructionSet = 214
+	//0xD7	EM_CDP	Paneve CDP architecture family
+	ISCDP InstructionSet = 215
+	//0xD8	EM_COGE	Cognitive Smart Memory Processor
+	ISCOGE InstructionSet = 216
+	//0xD9	EM_COOL	Bluechip Systems CoolEngine
+	ISCOOL InstructionSet = 217
+	//0xDA	EM_NORC	Nanoradio Optimized RISC
+	ISNORC InstructionSet = 218
+	//0xDB	EM_CSR_KALIMBA	CSR Kalimba architecture family
+	ISCSRKALIMBA InstructionSet = 219
+	//0xDC	EM_Z80	Zilog Z80
+	ISZ80 InstructionSet = 220
+	//0xDD	EM_VISIUM	Controls and Data Services VISIUMcore processor
+	ISVISIUM InstructionSet = 221
+	//0xDE	EM_FT32	FTDI Chip FT32 high performance 32-bit RISC architecture
+	ISFT32 InstructionSet = 222
+	//0xDF	EM_MOXIE	Moxie processor family
+	ISMOXIE InstructionSet = 223
+	//0xE0	EM_AMDGPU	AMD GPU architecture
+	ISAMDGPU InstructionSet = 224
+	//0xF3	EM_RISCV	RISC-V
+	ISRISCV InstructionSet = 243
+	//0xF4	EM_LANAI	Lanai 32-bit processor
+	ISLANAI InstructionSet = 244
+	//0xF7	EM_BPF	Linux BPF – in-kernel virtual machine
+	ISBPF InstructionSet = 247
+	//0xFA	EM_NFP	Netronome Flow Processor
+	ISNFP InstructionSet = 250
+	//0xFB	EM_VE	NEC SX-Aurora Vector Engine
+	ISVE InstructionSet = 251
+	//0xFC	EM_CSKY	C-SKY processor family
+	ISCSKY InstructionSet = 252
+	//0xFD	EM_ARC_COMPACT3_64	Synopsys ARCv2.3 64-bit
+	ISARCCOMPACT364 InstructionSet = 253
+	//0xFE	EM_MCS6502	MOS Technology MCS 6502 processor
+	ISMCS6502 InstructionSet = 254
+	//0xFF	EM_ARC_COMPACT3	Synopsys ARCv2.3 32-bit
+	ISARCCOMPACT3 InstructionSet = 255
+	//0x100	EM_KVX	Kalray VLIW core of the MPPA processor family
+	ISKVX InstructionSet = 256
+	//0x101	EM_65816	WDC 65816/65C816
+	IS65816 InstructionSet = 257
+	//0x102	EM_LOONGARCH	LoongArch
+	ISLOONGARCH InstructionSet = 258
+
+	// non-standard or deprecated values
+	//0x06	EM_486	Intel i486
+	IS486 InstructionSet = 6
+	//0x0A	EM_MIPS_RS4_BE	MIPS R4000 Big-Endian
+	ISMIPSRS4BE InstructionSet = 10
+	//0x29	EM_ALPHA_STD	Digital Alpha (standard value)
+	ISALPHASTD InstructionSet = 41
+	//0x9026	EM_ALPHA	Alpha (written in the absence of an ABI)
+	ISALPHA InstructionSet = 36902
+)
+
+var instructionSetNames = newConstantNames("InstructionSet", []constantName{
+	{0, "EM_NONE", "ISNotSpecified", "not-specific"},
+	{1, "EM_M32", "ISM32", "AT&T WE32100"},
+	{2, "EM_SPARC", "ISSparc", "SPARC"},
+	{3, "EM_386", "ISx86", "x86"},
+	{4, "EM_68K", "IS68K", "Motorola 68000"},
+	{5, "EM_88K", "IS88K", "Motorola 88000"},
+	{6, "EM_IAMCU", "ISIAMCU", "Intel MCU"},
+	{7, "EM_860", "IS860", "Intel i860"},
+	{8, "EM_MIPS", "ISMIPS", "MIPS"},
+	{9, "EM_S370", "ISS370", "IBM System/370"},
+	{10, "EM_MIPS_RS3_LE", "ISMIPSRS3LE", "MIPS R3000 Little-Endian"},
+	{15, "EM_PARISC", "ISPARISC", "HP PA-RISC"},
+	{17, "EM_VPP500", "ISVPP500", "Fujitsu VPP500"},
+	{18, "EM_SPARC32PLUS", "ISSPARC32PLUS", "SPARC v8plus"},
+	{19, "EM_960", "IS960", "Intel 80960"},
+	{20, "EM_PPC", "ISPowerPC", "PowerPC"},
+	{21, "EM_PPC64", "ISPowerPC64", "PowerPC (64-bit)"},
+	{22, "EM_S390", "ISS390WithS390x", "S390, including S390x"},
+	{23, "EM_SPU", "ISSPU", "IBM SPU/SPC"},
+	{36, "EM_V800", "ISV800", "NEC V800"},
+	{37, "EM_FR20", "ISFR20", "Fujitsu FR20"},
+	{38, "EM_RH32", "ISRH32", "TRW RH-32"},
+	{39, "EM_RCE", "ISRCE", "Motorola RCE"},
+	{40, "EM_ARM", "ISARM", "ARM"},
+	{42, "EM_SH", "ISSuperH", "SuperH"},
+	{43, "EM_SPARCV9", "ISSPARCV9", "SPARC v9 64-bit"},
+	{44, "EM_TRICORE", "ISTRICORE", "Siemens TriCore embedded processor"},
+	{45, "EM_ARC", "ISARC", "Argonaut RISC Core"},
+	{46, "EM_H8_300", "ISH8300", "Hitachi H8/300"},
+	{47, "EM_H8_300H", "ISH8300H", "Hitachi H8/300H"},
+	{48, "EM_H8S", "ISH8S", "Hitachi H8S"},
+	{49, "EM_H8_500", "ISH8500", "Hitachi H8/500"},
+	{50, "EM_IA_64", "ISIA64", "IA-64"},
+	{51, "EM_MIPS_X", "ISMIPSX", "Stanford MIPS-X"},
+	{52, "EM_COLDFIRE", "ISCOLDFIRE", "Motorola ColdFire"},
+	{53, "EM_68HC12", "IS68HC12", "Motorola M68HC12"},
+	{54, "EM_MMA", "ISMMA", "Fujitsu MMA"},
+	{55, "EM_PCP", "ISPCP", "Siemens PCP"},
+	{56, "EM_NCPU", "ISNCPU", "Sony nCPU"},
+	{57, "EM_NDR1", "ISNDR1", "Denso NDR1 microprocessor"},
+	{58, "EM_STARCORE", "ISSTARCORE", "Motorola Star*Core processor"},
+	{59, "EM_ME16", "ISME16", "Toyota ME16 processor"},
+	{60, "EM_ST100", "ISST100", "STMicroelectronics ST100 processor"},
+	{61, "EM_TINYJ", "ISTINYJ", "Advanced Logic Corp. TinyJ processor"},
+	{62, "EM_X86_64", "ISAmd64", "amd64"},
+	{63, "EM_PDSP", "ISPDSP", "Sony DSP Processor"},
+	{64, "EM_PDP10", "ISPDP10", "Digital Equipment Corp. PDP-10"},
+	{65, "EM_PDP11", "ISPDP11", "Digital Equipment Corp. PDP-11"},
+	{66, "EM_FX66", "ISFX66", "Siemens FX66 microcontroller"},
+	{67, "EM_ST9PLUS", "ISST9PLUS", "STMicroelectronics ST9+ 8/16 bit microcontroller"},
+	{68, "EM_ST7", "ISST7", "STMicroelectronics ST7 8-bit microcontroller"},
+	{69, "EM_68HC16", "IS68HC16", "Motorola MC68HC16 Microcontroller"},
+	{70, "EM_68HC11", "IS68HC11", "Motorola MC68HC11 Microcontroller"},
+	{71, "EM_68HC08", "IS68HC08", "Motorola MC68HC08 Microcontroller"},
+	{72, "EM_68HC05", "IS68HC05", "Motorola MC68HC05 Microcontroller"},
+	{73, "EM_SVX", "ISSVX", "Silicon Graphics SVx"},
+	{74, "EM_ST19", "ISST19", "STMicroelectronics ST19 8-bit microcontroller"},
+	{75, "EM_VAX", "ISVAX", "Digital VAX"},
+	{76, "EM_CRIS", "ISCRIS", "Axis Communications 32-bit embedded processor"},
+	{77, "EM_JAVELIN", "ISJAVELIN", "Infineon Technologies 32-bit embedded processor"},
+	{78, "EM_FIREPATH", "ISFIREPATH", "Element 14 64-bit DSP Processor"},
+	{79, "EM_ZSP", "ISZSP", "LSI Logic 16-bit DSP Processor"},
+	{80, "EM_MMIX", "ISMMIX", "Donald Knuth's educational 64-bit processor"},
+	{81, "EM_HUANY", "ISHUANY", "Harvard University machine-independent object files"},
+	{82, "EM_PRISM", "ISPRISM", "SiTera Prism"},
+	{83, "EM_AVR", "ISAVR", "Atmel AVR 8-bit microcontroller"},
+	{84, "EM_FR30", "ISFR30", "Fujitsu FR30"},
+	{85, "EM_D10V", "ISD10V", "Mitsubishi D10V"},
+	{86, "EM_D30V", "ISD30V", "Mitsubishi D30V"},
+	{87, "EM_V850", "ISV850", "NEC v850"},
+	{88, "EM_M32R", "ISM32R", "Mitsubishi M32R"},
+	{89, "EM_MN10300", "ISMN10300", "Matsushita MN10300"},
+	{90, "EM_MN10200", "ISMN10200", "Matsushita MN10200"},
+	{91, "EM_PJ", "ISPJ", "picoJava"},
+	{92, "EM_OPENRISC", "ISOPENRISC", "OpenRISC 32-bit embedded processor"},
+	{93, "EM_ARC_COMPACT", "ISARCCOMPACT", "ARC International ARCompact processor (old spelling/synonym: EM_ARC_A5)"},
+	{94, "EM_XTENSA", "ISXTENSA", "Tensilica Xtensa Architecture"},
+	{95, "EM_VIDEOCORE", "ISVIDEOCORE", "Alphamosaic VideoCore processor"},
+	{96, "EM_TMM_GPP", "ISTMMGPP", "Thompson Multimedia General Purpose Processor"},
+	{97, "EM_NS32K", "ISNS32K", "National Semiconductor 32000 series"},
+	{98, "EM_TPC", "ISTPC", "Tenor Network TPC processor"},
+	{99, "EM_SNP1K", "ISSNP1K", "Trebia SNP 1000 processor"},
+	{100, "EM_ST200", "ISST200", "STMicroelectronics (www.st.com) ST200 microcontroller"},
+	{101, "EM_IP2K", "ISIP2K", "Ubicom IP2xxx microcontroller family"},
+	{102, "EM_MAX", "ISMAX", "MAX Processor"},
+	{103, "EM_CR", "ISCR", "National Semiconductor CompactRISC microprocessor"},
+	{104, "EM_F2MC16", "ISF2MC16", "Fujitsu F2MC16"},
+	{105, "EM_MSP430", "ISMSP430", "Texas Instruments embedded microcontroller msp430"},
+	{106, "EM_BLACKFIN", "ISBLACKFIN", "Analog Devices Blackfin (DSP) processor"},
+	{107, "EM_SE_C33", "ISSEC33", "S1C33 Family of Seiko Epson processors"},
+	{108, "EM_SEP", "ISSEP", "Sharp embedded microprocessor"},
+	{109, "EM_ARCA", "ISARCA", "Arca RISC Microprocessor"},
+	{110, "EM_UNICORE", "ISUNICORE", "Microprocessor series from PKU-Unity Ltd. and MPRC of Peking University"},
+	{111, "EM_EXCESS", "ISEXCESS", "eXcess: 16/32/64-bit configurable embedded CPU"},
+	{112, "EM_DXP", "ISDXP", "Icera Semiconductor Inc. Deep Execution Processor"},
+	{113, "EM_ALTERA_NIOS2", "ISALTERANIOS2", "Altera Nios II soft-core processor"},
+	{114, "EM_CRX", "ISCRX", "National Semiconductor CompactRISC CRX microprocessor"},
+	{115, "EM_XGATE", "ISXGATE", "Motorola XGATE embedded processor"},
+	{116, "EM_C166", "ISC166", "Infineon C16x/XC16x processor"},
+	{117, "EM_M16C", "ISM16C", "Renesas M16C series microprocessors"},
+	{118, "EM_DSPIC30F", "ISDSPIC30F", "Microchip Technology dsPIC30F Digital Signal Controller"},
+	{119, "EM_CE", "ISCE", "Freescale Communication Engine RISC core"},
+	{120, "EM_M32C", "ISM32C", "Renesas M32C series microprocessors"},
+	{131, "EM_TSK3000", "ISTSK3000", "Altium TSK3000 core"},
+	{132, "EM_RS08", "ISRS08", "Freescale RS08 embedded processor"},
+	{133, "EM_SHARC", "ISSHARC", "Analog Devices SHARC family of 32-bit DSP processors"},
+	{134, "EM_ECOG2", "ISECOG2", "Cyan Technology eCOG2 microprocessor"},
+	{135, "EM_SCORE7", "ISSCORE7", "Sunplus S+core7 RISC processor"},
+	{136, "EM_DSP24", "ISDSP24", "New Japan Radio (NJR) 24-bit DSP Processor"},
+	{137, "EM_VIDEOCORE3", "ISVIDEOCORE3", "Broadcom VideoCore III processor"},
+	{138, "EM_LATTICEMICO32", "ISLATTICEMICO32", "RISC processor for Lattice FPGA architecture"},
+	{139, "EM_SE_C17", "ISSEC17", "Seiko Epson C17 family"},
+	{140, "EM_TI_C6000", "ISTMS320C6000", "TMS320C6000 Family"},
+	{141, "EM_TI_C2000", "ISTIC2000", "The Texas Instruments TMS320C2000 DSP family"},
+	{142, "EM_TI_C5500", "ISTIC5500", "The Texas Instruments TMS320C55x DSP family"},
+	{143, "EM_TI_ARP32", "ISTIARP32", "Texas Instruments Application Specific RISC Processor, 32bit fetch"},
+	{144, "EM_TI_PRU", "ISTIPRU", "Texas Instruments Programmable Realtime Unit"},
+	{160, "EM_MMDSP_PLUS", "ISMMDSPPLUS", "STMicroelectronics 64bit VLIW Data Signal Processor"},
+	{161, "EM_CYPRESS_M8C", "ISCYPRESSM8C", "Cypress M8C microprocessor"},
+	{162, "EM_R32C", "ISR32C", "Renesas R32C series microprocessors"},
+	{163, "EM_TRIMEDIA", "ISTRIMEDIA", "NXP Semiconductors TriMedia architecture family"},
+	{164, "EM_QDSP6", "ISQDSP6", "QUALCOMM DSP6 Processor"},
+	{165, "EM_8051", "IS8051", "Intel 8051 and variants"},
+	{166, "EM_STXP7X", "ISSTXP7X", "STMicroelectronics STxP7x family of configurable and extensible RISC processors"},
+	{167, "EM_NDS32", "ISNDS32", "Andes Technology compact code size embedded RISC processor family"},
+	{168, "EM_ECOG1", "ISECOG1", "Cyan Technology eCOG1X family"},
+	{168, "EM_ECOG1X", "ISECOG1X", "Cyan Technology eCOG1X family"},
+	{169, "EM_MAXQ30", "ISMAXQ30", "Dallas Semiconductor MAXQ30 Core Micro-controllers"},
+	{170, "EM_XIMO16", "ISXIMO16", "New Japan Radio (NJR) 16-bit DSP Processor"},
+	{171, "EM_MANIK", "ISMANIK", "M2000 Reconfigurable RISC Microprocessor"},
+	{172, "EM_CRAYNV2", "ISCRAYNV2", "Cray Inc. NV2 vector architecture"},
+	{173, "EM_RX", "ISRX", "Renesas RX family"},
+	{174, "EM_METAG", "ISMETAG", "Imagination Technologies META processor architecture"},
+	{175, "EM_MCST_ELBRUS", "ISMCSTELBRUS", "MCST Elbrus general purpose hardware architecture"},
+	{176, "EM_ECOG16", "ISECOG16", "Cyan Technology eCOG16 family"},
+	{177, "EM_CR16", "ISCR16", "National Semiconductor CompactRISC CR16 16-bit microprocessor"},
+	{178, "EM_ETPU", "ISETPU", "Freescale Extended Time Processing Unit"},
+	{179, "EM_SLE9X", "ISSLE9X", "Infineon Technologies SLE9X core"},
+	{180, "EM_L10M", "ISL10M", "Intel L10M"},
+	{181, "EM_K10M", "ISK10M", "Intel K10M"},
+	{183, "EM_AARCH64", "ISAArch64", "AArch64"},
+	{185, "EM_AVR32", "ISAVR32", "Atmel Corporation 32-bit microprocessor family"},
+	{186, "EM_STM8", "ISSTM8", "STMicroeletronics STM8 8-bit microcontroller"},
+	{187, "EM_TILE64", "ISTILE64", "Tilera TILE64 multicore architecture family"},
+	{188, "EM_TILEPRO", "ISTILEPRO", "Tilera TILEPro multicore architecture family"},
+	{189, "EM_MICROBLAZE", "ISMICROBLAZE", "Xilinx MicroBlaze 32-bit RISC soft processor core"},
+	{190, "EM_CUDA", "ISCUDA", "NVIDIA CUDA architecture"},
+	{191, "EM_TILEGX", "ISTILEGX", "Tilera TILE-Gx multicore architecture family"},
+	{192, "EM_CLOUDSHIELD", "ISCLOUDSHIELD", "CloudShield architecture family"},
+	{193, "EM_COREA_1ST", "ISCOREA1ST", "KIPO-KAIST Core-A 1st generation processor family"},
+	{194, "EM_COREA_2ND", "ISCOREA2ND", "KIPO-KAIST Core-A 2nd generation processor family"},
+	{195, "EM_ARC_COMPACT2", "ISARCCOMPACT2", "Synopsys ARCompact V2"},
+	{196, "EM_OPEN8", "ISOPEN8", "Open8 8-bit RISC soft processor core"},
+	{197, "EM_RL78", "ISRL78", "Renesas RL78 family"},
+	{198, "EM_VIDEOCORE5", "ISVIDEOCORE5", "Broadcom VideoCore V processor"},
+	{199, "EM_78KOR", "IS78KOR", "Renesas 78KOR family"},
+	{200, "EM_56800EX", "IS56800EX", "Freescale 56800EX Digital Signal Controller (DSC)"},
+	{201, "EM_BA1", "ISBA1", "Beyond BA1 CPU architecture"},
+	{202, "EM_BA2", "ISBA2", "Beyond BA2 CPU architecture"},
+	{203, "EM_XCORE", "ISXCORE", "XMOS xCORE processor family"},
+	{204, "EM_MCHP_PIC", "ISMCHPPIC", "Microchip 8-bit PIC(r) family"},
+	{205, "EM_INTEL205", "ISINTEL205", "Reserved by Intel"},
+	{206, "EM_INTEL206", "ISINTEL206", "Reserved by Intel"},
+	{207, "EM_INTEL207", "ISINTEL207", "Reserved by Intel"},
+	{208, "EM_INTEL208", "ISINTEL208", "Reserved by Intel"},
+	{209, "EM_INTEL209", "ISINTEL209", "Reserved by Intel"},
+	{210, "EM_KM32", "ISKM32", "KM211 KM32 32-bit processor"},
+	{211, "EM_KMX32", "ISKMX32", "KM211 KMX32 32-bit processor"},
+	{212, "EM_KMX16", "ISKMX16", "KM211 KMX16 16-bit processor"},
+	{213, "EM_KMX8", "ISKMX8", "KM211 KMX8 8-bit processor"},
+	{214, "EM_KVARC", "ISKVARC", "KM211 KVARC processor"},
+	{215, "EM_CDP", "ISCDP", "Paneve CDP architecture family"},
+	{216, "EM_COGE", "ISCOGE", "Cognitive Smart Memory Processor"},
+	{217, "EM_COOL", "ISCOOL", "Bluechip Systems CoolEngine"},
+	{218, "EM_NORC", "ISNORC", "Nanoradio Optimized RISC"},
+	{219, "EM_CSR_KALIMBA", "ISCSRKALIMBA", "CSR Kalimba architecture family"},
+	{220, "EM_Z80", "ISZ80", "Zilog Z80"},
+	{221, "EM_VISIUM", "ISVISIUM", "Controls and Data Services VISIUMcore processor"},
+	{222, "EM_FT32", "ISFT32", "FTDI Chip FT32 high performance 32-bit RISC architecture"},
+	{223, "EM_MOXIE", "ISMOXIE", "Moxie processor family"},
+	{224, "EM_AMDGPU", "ISAMDGPU", "AMD GPU architecture"},
+	{243, "EM_RISCV", "ISRISCV", "RISC-V"},
+	{244, "EM_LANAI", "ISLANAI", "Lanai 32-bit processor"},
+	{247, "EM_BPF", "ISBPF", "Linux BPF – in-kernel virtual machine"},
+	{250, "EM_NFP", "ISNFP", "Netronome Flow Processor"},
+	{251, "EM_VE", "ISVE", "NEC SX-Aurora Vector Engine"},
+	{252, "EM_CSKY", "ISCSKY", "C-SKY processor family"},
+	{253, "EM_ARC_COMPACT3_64", "ISARCCOMPACT364", "Synopsys ARCv2.3 64-bit"},
+	{254, "EM_MCS6502", "ISMCS6502", "MOS Technology MCS 6502 processor"},
+	{255, "EM_ARC_COMPACT3", "ISARCCOMPACT3", "Synopsys ARCv2.3 32-bit"},
+	{256, "EM_KVX", "ISKVX", "Kalray VLIW core of the MPPA processor family"},
+	{257, "EM_65816", "IS65816", "WDC 65816/65C816"},
+	{258, "EM_LOONGARCH", "ISLOONGARCH", "LoongArch"},
+	{6, "EM_486", "IS486", "Intel i486"},
+	{10, "EM_MIPS_RS4_BE", "ISMIPSRS4BE", "MIPS R4000 Big-Endian"},
+	{41, "EM_ALPHA_STD", "ISALPHASTD", "Digital Alpha (standard value)"},
+	{36902, "EM_ALPHA", "ISALPHA", "Alpha (written in the absence of an ABI)"},
+})
+
+func (is InstructionSet) String() string {
+	if text, ok := instructionSetNames.text(uint64(is)); ok {
+		return text
+	}
+	return fmt.Sprintf("unknown: 0x%X", int(is))
+}
+
+func (is InstructionSet) GoString() string {
+	return instructionSetNames.goString(uint64(is))
+}
+
+// ParseInstructionSet resolves specification (EM_X86_64) or Go (ISAmd64) constant name
+func ParseInstructionSet(name string) (InstructionSet, error) {
+	value, err := instructionSetNames.parse(name)
+	return InstructionSet(value), err
+}
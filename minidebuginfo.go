@@ -0,0 +1,68 @@
+package elf
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+
+	"github.com/ulikunitz/xz"
+)
+
+// MiniDebugInfoSectionName is section holding xz compressed ELF file with minimal symbol table, see https://sourceware.org/gdb/onlinedocs/gdb/MiniDebugInfo.html
+const MiniDebugInfoSectionName = ".gnu_debugdata"
+
+var ErrNoMiniDebugInfo = errors.New("no MiniDebugInfo section")
+var ErrInvalidMiniDebugInfo = errors.New("invalid MiniDebugInfo")
+
+// maxMiniDebugInfoRatio limits size of embedded file to multiple of compressed section size. Symbol tables compress
+// several times at most, so larger output is treated as decompression bomb of malformed file
+const maxMiniDebugInfoRatio = 64
+
+// MiniDebugInfo decompresses and parses ELF file embedded in .gnu_debugdata section. It usually has only .symtab with
+// local functions stripped from the outer file, so its symbol values are addresses of the outer file.
+// Embedded file is decoded once per File and is kept in memory, it doesn't need to be closed
+func (f *File) MiniDebugInfo() (*File, error) {
+	f.miniDebugInfoOnce.Do(func() {
+		f.miniDebugInfo, f.miniDebugInfoErr = f.readMiniDebugInfo()
+	})
+	return f.miniDebugInfo, f.miniDebugInfoErr
+}
+
+func (f *File) readMiniDebugInfo() (*File, error) {
+	section := f.Section(MiniDebugInfoSectionName)
+	if section == nil {
+		return nil, ErrNoMiniDebugInfo
+	}
+	compressed, err := section.Data()
+	if err != nil {
+		return nil, fmt.Errorf("%w read: %v", ErrInvalidMiniDebugInfo, err)
+	}
+	content, err := decompressMiniDebugInfo(compressed)
+	if err != nil {
+		return nil, err
+	}
+	embedded, err := NewFile(bytes.NewReader(content))
+	if err != nil {
+		return nil, fmt.Errorf("%w embedded file: %v", ErrInvalidMiniDebugInfo, err)
+	}
+	return embedded, nil
+}
+
+// decompressMiniDebugInfo decompresses xz stream up to maxMiniDebugInfoRatio times its size
+func decompressMiniDebugInfo(compressed []byte) ([]byte, error) {
+	xzReader, err := xz.NewReader(bytes.NewReader(compressed))
+	if err != nil {
+		return nil, fmt.Errorf("%w xz stream: %v", ErrInvalidMiniDebugInfo, err)
+	}
+	limit := int64(len(compressed)) * maxMiniDebugInfoRatio
+	content, err := ioutil.ReadAll(io.LimitReader(xzReader, limit+1))
+	if err != nil {
+		return nil, fmt.Errorf("%w xz decompress: %v", ErrInvalidMiniDebugInfo, err)
+	}
+	if int64(len(content)) > limit {
+		return nil, fmt.Errorf("%w xz stream of %v bytes decompresses to more than %v bytes", ErrInvalidMiniDebugInfo, len(compressed), limit)
+	}
+	return content, nil
+}
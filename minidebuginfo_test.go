@@ -0,0 +1,121 @@
+package elf
+
+import (
+	"bytes"
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/ulikunitz/xz"
+)
+
+func TestMiniDebugInfo(t *testing.T) {
+	file, err := Open(filepath.Join("testdata", "libsample_minidebuginfo.so"))
+	assert.NoError(t, err)
+	defer file.Close()
+
+	embedded, err := file.MiniDebugInfo()
+	assert.NoError(t, err)
+	assert.Equal(t, file.ISet, embedded.ISet)
+	symbols, err := embedded.Symbols()
+	assert.NoError(t, err)
+	var names []string
+	for _, symbol := range symbols[1:] {
+		names = append(names, symbol.Name)
+	}
+	assert.Subset(t, names, []string{"frame_dummy", "sample_value_v1", "sample_value_v2"})
+	assert.NotContains(t, names, "sample_name")
+
+	again, err := file.MiniDebugInfo()
+	assert.NoError(t, err)
+	assert.Same(t, embedded, again)
+}
+
+func TestNoMiniDebugInfo(t *testing.T) {
+	file, err := Open(filepath.Join("testdata", "libsample.so"))
+	assert.NoError(t, err)
+	defer file.Close()
+
+	_, err = file.MiniDebugInfo()
+	assert.True(t, errors.Is(err, ErrNoMiniDebugInfo))
+}
+
+func TestMiniDebugInfoDecompressionLimit(t *testing.T) {
+	var compressed bytes.Buffer
+	writer, err := xz.NewWriter(&compressed)
+	assert.NoError(t, err)
+	_, err = writer.Write(make([]byte, 1<<20))
+	assert.NoError(t, err)
+	assert.NoError(t, writer.Close())
+
+	// megabyte of zeros compresses far better than any symbol table
+	_, err = decompressMiniDebugInfo(compressed.Bytes())
+	assert.True(t, errors.Is(err, ErrInvalidMiniDebugInfo))
+	assert.Contains(t, err.Error(), "decompresses to more than")
+
+	file, err := Open(filepath.Join("testdata", "libsample_minidebuginfo.so"))
+	assert.NoError(t, err)
+	defer file.Close()
+	section, err := file.Section(MiniDebugInfoSectionName).Data()
+	assert.NoError(t, err)
+	content, err := decompressMiniDebugInfo(section)
+	assert.NoError(t, err)
+	assert.Less(t, len(content), len(section)*maxMiniDebugInfoRatio)
+}
+
+func TestSymbolLookupFallsBackToMiniDebugInfo(t *testing.T) {
+	file, err := Open(filepath.Join("testdata", "libsample_minidebuginfo.so"))
+	assert.NoError(t, err)
+	defer file.Close()
+
+	_, err = file.LookupSymbol("frame_dummy", SymbolSourceDefault)
+	assert.True(t, errors.Is(err, ErrSymbolNotFound))
+	symbol, err := file.LookupSymbol("frame_dummy", SymbolSourceAll)
+	assert.NoError(t, err)
+	assert.Equal(t, MemoryAddress(0x1110), symbol.Value)
+
+	symbol, err = file.LookupSymbol("sample_value", SymbolSourceAll)
+	assert.NoError(t, err)
+	assert.Equal(t, "SAMPLE_2.0", symbol.Version)
+
+	_, err = file.SymbolForAddress(0x1112, SymbolSourceDefault)
+	assert.True(t, errors.Is(err, ErrSymbolNotFound))
+	symbol, err = file.SymbolForAddress(0x1112, SymbolSourceAll)
+	assert.NoError(t, err)
+	assert.Equal(t, "frame_dummy", symbol.Name)
+	symbol, err = file.SymbolForAddress(0x1150, SymbolSourceAll)
+	assert.NoError(t, err)
+	assert.Equal(t, "_fini", symbol.Name)
+	// .rodata follows .fini, zero sized _fini doesn't cover it
+	_, err = file.SymbolForAddress(0x2006, SymbolSourceAll)
+	assert.True(t, errors.Is(err, ErrSymbolNotFound))
+
+	// exported function is found in dynamic symbols before MiniDebugInfo is decoded
+	symbol, err = file.SymbolForAddress(0x1122, SymbolSourceAll)
+	assert.NoError(t, err)
+	assert.Equal(t, "sample_name", symbol.Name)
+	assert.Equal(t, "SAMPLE_1.0", symbol.Version)
+}
+
+func TestSymbolForAddress(t *testing.T) {
+	file, err := Open(filepath.Join("testdata", "libsample.so"))
+	assert.NoError(t, err)
+	defer file.Close()
+
+	symbol, err := file.SymbolForAddress(0x111a, SymbolSourceSymTable)
+	assert.NoError(t, err)
+	assert.Equal(t, "sample_value_v1", symbol.Name)
+
+	symbol, err = file.SymbolForAddress(0x111a, SymbolSourceDynamic)
+	assert.NoError(t, err)
+	assert.Equal(t, "sample_value", symbol.Name)
+	assert.True(t, symbol.VersionHidden)
+
+	_, err = file.SymbolForAddress(0x10, SymbolSourceAll)
+	assert.True(t, errors.Is(err, ErrSymbolNotFound))
+}
+
+func TestSymbolSourceString(t *testing.T) {
+	assert.Equal(t, "SYMTAB DYNAMIC MINIDEBUGINFO", SymbolSourceAll.String())
+}
@@ -0,0 +1,476 @@
+package elf
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// NoteType meaning depends on note owner name
+type NoteType uint32
+
+const (
+	//1	NT_GNU_ABI_TAG	ABI information, owner GNU
+	NoteTypeGNUABITag NoteType = 1
+	//2	NT_GNU_HWCAP	Synthetic hwcap information, owner GNU
+	NoteTypeGNUHWCap NoteType = 2
+	//3	NT_GNU_BUILD_ID	Build ID bits as generated by ld --build-id, owner GNU
+	NoteTypeGNUBuildID NoteType = 3
+	//4	NT_GNU_GOLD_VERSION	Version note generated by GNU gold, owner GNU
+	NoteTypeGNUGoldVersion NoteType = 4
+	//5	NT_GNU_PROPERTY_TYPE_0	Program property, owner GNU
+	NoteTypeGNUPropertyType0 NoteType = 5
+	//4	Go build ID, owner Go
+	NoteTypeGoBuildID NoteType = 4
+	//0xcafe1a7e	NT_FDO_PACKAGING_METADATA	Package metadata JSON, owner FDO
+	NoteTypeFDOPackagingMetadata NoteType = 0xcafe1a7e
+)
+
+const (
+	NoteOwnerGNU = "GNU"
+	NoteOwnerGo  = "Go"
+	NoteOwnerFDO = "FDO"
+)
+
+type Note struct {
+	Name        string // owner of note, null terminator stripped
+	Type        NoteType
+	Description []byte
+
+	byteOrder binary.ByteOrder
+	class     ELFClass
+}
+
+func (n Note) String() string {
+	switch {
+	case n.Name == NoteOwnerGNU && n.Type == NoteTypeGNUABITag:
+		return "GNU ABI tag"
+	case n.Name == NoteOwnerGNU && n.Type == NoteTypeGNUHWCap:
+		return "GNU hwcap"
+	case n.Name == NoteOwnerGNU && n.Type == NoteTypeGNUBuildID:
+		return "GNU build ID"
+	case n.Name == NoteOwnerGNU && n.Type == NoteTypeGNUGoldVersion:
+		return "GNU gold version"
+	case n.Name == NoteOwnerGNU && n.Type == NoteTypeGNUPropertyType0:
+		return "GNU property"
+	case n.Name == NoteOwnerGo && n.Type == NoteTypeGoBuildID:
+		return "Go build ID"
+	case n.Name == NoteOwnerFDO && n.Type == NoteTypeFDOPackagingMetadata:
+		return "FDO packaging metadata"
+	}
+	return fmt.Sprintf("%v: 0x%08X", n.Name, uint32(n.Type))
+}
+
+var ErrInvalidNote = errors.New("invalid note")
+var ErrNoteNotFound = errors.New("note not found")
+
+// NoteReader iterates note records of single section or segment
+type NoteReader struct {
+	content   []byte
+	offset    uint64
+	align     uint64
+	byteOrder binary.ByteOrder
+	class     ELFClass
+}
+
+// Next returns next note or io.EOF when there are no more notes
+func (nr *NoteReader) Next() (Note, error) {
+	const headerSize = 12
+	if nr.offset >= uint64(len(nr.content)) {
+		return Note{}, io.EOF
+	}
+	rest := nr.content[nr.offset:]
+	if len(rest) < headerSize {
+		return Note{}, fmt.Errorf("%w at %v: truncated header", ErrInvalidNote, nr.offset)
+	}
+	nameSize := uint64(nr.byteOrder.Uint32(rest[0:]))
+	descSize := uint64(nr.byteOrder.Uint32(rest[4:]))
+	note := Note{
+		Type:      NoteType(nr.byteOrder.Uint32(rest[8:])),
+		byteOrder: nr.byteOrder,
+		class:     nr.class,
+	}
+
+	descOffset := alignUp(headerSize+nameSize, nr.align)
+	end := alignUp(descOffset+descSize, nr.align)
+	if descOffset+descSize > uint64(len(rest)) {
+		return Note{}, fmt.Errorf("%w at %v: name size %v and description size %v overflow content", ErrInvalidNote, nr.offset, nameSize, descSize)
+	}
+	note.Name = strings.TrimRight(string(rest[headerSize:headerSize+nameSize]), "\x00")
+	note.Description = rest[descOffset : descOffset+descSize]
+
+	nr.offset += end
+	return note, nil
+}
+
+// SectionNotes returns reader of notes in SHT_NOTE section
+func (f *File) SectionNotes(section *Section) (*NoteReader, error) {
+	if section.Type != SectionTypeNotes {
+		return nil, fmt.Errorf("%w section %v type is %v", ErrInvalidNote, section.Name, section.Type)
+	}
+	content, err := section.Data()
+	if err != nil {
+		return nil, fmt.Errorf("%w section %v read: %v", ErrInvalidNote, section.Name, err)
+	}
+	return f.newNoteReader(content, uint64(section.Align)), nil
+}
+
+// SegmentNotes returns reader of notes in PT_NOTE segment
+func (f *File) SegmentNotes(segment *Segment) (*NoteReader, error) {
+	if segment.Type != SegmentTypeAuxInfo {
+		return nil, fmt.Errorf("%w segment type is %v", ErrInvalidNote, segment.Type)
+	}
+	content, err := readAt(segment.reader, segment.FileOffset, segment.SizeInFile)
+	if err != nil {
+		return nil, fmt.Errorf("%w segment read: %v", ErrInvalidNote, err)
+	}
+	return f.newNoteReader(content, uint64(segment.Alignment)), nil
+}
+
+// Notes returns all notes of PT_NOTE segments followed by notes of SHT_NOTE sections not loaded by them, e.g. of
+// non-alloc sections or of relocatable files without segments
+func (f *File) Notes() ([]Note, error) {
+	var readers []*NoteReader
+	var segments []*Segment
+	for _, segment := range f.Segments {
+		if segment.Type != SegmentTypeAuxInfo {
+			continue
+		}
+		reader, err := f.SegmentNotes(segment)
+		if err != nil {
+			return nil, err
+		}
+		readers = append(readers, reader)
+		segments = append(segments, segment)
+	}
+	for _, section := range f.SectionsByType(SectionTypeNotes) {
+		if noteSegmentCovers(segments, section) {
+			continue
+		}
+		reader, err := f.SectionNotes(section)
+		if err != nil {
+			return nil, err
+		}
+		readers = append(readers, reader)
+	}
+
+	var notes []Note
+	for _, reader := range readers {
+		for {
+			note, err := reader.Next()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				return nil, err
+			}
+			notes = append(notes, note)
+		}
+	}
+	return notes, nil
+}
+
+// noteSegmentCovers tells whether file content of section is within one of PT_NOTE segments
+func noteSegmentCovers(segments []*Segment, section *Section) bool {
+	for _, segment := range segments {
+		if section.Offset >= segment.FileOffset && section.Size <= segment.SizeInFile &&
+			uint64(section.Offset-segment.FileOffset) <= segment.SizeInFile-section.Size {
+			return true
+		}
+	}
+	return false
+}
+
+func (f *File) findNote(name string, noteType NoteType) (Note, error) {
+	notes, err := f.Notes()
+	if err != nil {
+		return Note{}, err
+	}
+	for _, note := range notes {
+		if note.Name == name && note.Type == noteType {
+			return note, nil
+		}
+	}
+	return Note{}, fmt.Errorf("%w: %v type %v", ErrNoteNotFound, name, uint32(noteType))
+}
+
+// BuildID returns content of NT_GNU_BUILD_ID note
+func (f *File) BuildID() (BuildID, error) {
+	note, err := f.findNote(NoteOwnerGNU, NoteTypeGNUBuildID)
+	if err != nil {
+		return nil, err
+	}
+	return note.GNUBuildID()
+}
+
+// GoBuildID returns build ID note placed by Go linker
+func (f *File) GoBuildID() (string, error) {
+	note, err := f.findNote(NoteOwnerGo, NoteTypeGoBuildID)
+	if err != nil {
+		return "", err
+	}
+	return note.GoBuildID()
+}
+
+// PackageMetadata returns systemd .note.package metadata
+func (f *File) PackageMetadata() (PackageMetadata, error) {
+	note, err := f.findNote(NoteOwnerFDO, NoteTypeFDOPackagingMetadata)
+	if err != nil {
+		return PackageMetadata{}, err
+	}
+	return note.PackageMetadata()
+}
+
+func (f *File) newNoteReader(content []byte, align uint64) *NoteReader {
+	// notes are 4 byte aligned, unless section or segment explicitly requires 8 byte alignment (e.g. GNU properties)
+	if align != 8 {
+		align = 4
+	}
+	return &NoteReader{
+		content:   content,
+		align:     align,
+		byteOrder: f.ByteOrder(),
+		class:     f.Class,
+	}
+}
+
+func (n Note) is(name string, noteType NoteType) error {
+	if n.Name != name || n.Type != noteType {
+		return fmt.Errorf("%w: %v is not %v type %v", ErrInvalidNote, n, name, uint32(noteType))
+	}
+	return nil
+}
+
+type BuildID []byte
+
+func (bi BuildID) String() string {
+	return hex.EncodeToString(bi)
+}
+
+func (n Note) GNUBuildID() (BuildID, error) {
+	if err := n.is(NoteOwnerGNU, NoteTypeGNUBuildID); err != nil {
+		return nil, err
+	}
+	if len(n.Description) == 0 {
+		return nil, fmt.Errorf("%w empty build ID", ErrInvalidNote)
+	}
+	return BuildID(n.Description), nil
+}
+
+type ABITagOS uint32
+
+const (
+	ABITagOSLinux ABITagOS = iota
+	ABITagOSGNU
+	ABITagOSSolaris2
+	ABITagOSFreeBSD
+)
+
+func (os ABITagOS) String() string {
+	if os <= ABITagOSFreeBSD {
+		return [...]string{"Linux", "Hurd", "Solaris", "FreeBSD"}[os]
+	}
+	return fmt.Sprintf("unknown: %v", uint32(os))
+}
+
+// ABITag is minimal kernel version required by object
+type ABITag struct {
+	OS                  ABITagOS
+	Major, Minor, Patch uint32
+}
+
+func (at ABITag) String() string {
+	return fmt.Sprintf("%v %v.%v.%v", at.OS, at.Major, at.Minor, at.Patch)
+}
+
+func (n Note) GNUABITag() (ABITag, error) {
+	var tag ABITag
+	if err := n.is(NoteOwnerGNU, NoteTypeGNUABITag); err != nil {
+		return tag, err
+	}
+	if len(n.Description) < 16 {
+		return tag, fmt.Errorf("%w ABI tag size %v", ErrInvalidNote, len(n.Description))
+	}
+	tag.OS = ABITagOS(n.byteOrder.Uint32(n.Description[0:]))
+	tag.Major = n.byteOrder.Uint32(n.Description[4:])
+	tag.Minor = n.byteOrder.Uint32(n.Description[8:])
+	tag.Patch = n.byteOrder.Uint32(n.Description[12:])
+	return tag, nil
+}
+
+func (n Note) GNUGoldVersion() (string, error) {
+	if err := n.is(NoteOwnerGNU, NoteTypeGNUGoldVersion); err != nil {
+		return "", err
+	}
+	return strings.TrimRight(string(n.Description), "\x00"), nil
+}
+
+func (n Note) GoBuildID() (string, error) {
+	if err := n.is(NoteOwnerGo, NoteTypeGoBuildID); err != nil {
+		return "", err
+	}
+	return string(n.Description), nil
+}
+
+type GNUPropertyType uint32
+
+const (
+	//1	GNU_PROPERTY_STACK_SIZE	Stack size
+	GNUPropertyStackSize GNUPropertyType = 1
+	//2	GNU_PROPERTY_NO_COPY_ON_PROTECTED	No copy relocation on protected data symbol
+	GNUPropertyNoCopyOnProtected GNUPropertyType = 2
+	//0xb0008000	GNU_PROPERTY_1_NEEDED	Bitmask of features required by object (GNU_PROPERTY_1_NEEDED_*)
+	GNUProperty1Needed GNUPropertyType = 0xb0008000
+	//0xc0000000	GNU_PROPERTY_AARCH64_FEATURE_1_AND	AArch64 BTI and PAC
+	GNUPropertyAArch64Feature1And GNUPropertyType = 0xc0000000
+	//0xc0000002	GNU_PROPERTY_X86_FEATURE_1_AND	x86 IBT and SHSTK
+	GNUPropertyX86Feature1And GNUPropertyType = 0xc0000002
+	//0xc0008001	GNU_PROPERTY_X86_FEATURE_2_NEEDED
+	GNUPropertyX86Feature2Needed GNUPropertyType = 0xc0008001
+	//0xc0008002	GNU_PROPERTY_X86_ISA_1_NEEDED
+	GNUPropertyX86ISA1Needed GNUPropertyType = 0xc0008002
+	//0xc0010001	GNU_PROPERTY_X86_FEATURE_2_USED
+	GNUPropertyX86Feature2Used GNUPropertyType = 0xc0010001
+	//0xc0010002	GNU_PROPERTY_X86_ISA_1_USED
+	GNUPropertyX86ISA1Used GNUPropertyType = 0xc0010002
+)
+
+func (pt GNUPropertyType) String() string {
+	switch pt {
+	case GNUPropertyStackSize:
+		return "stack size"
+	case GNUPropertyNoCopyOnProtected:
+		return "no copy on protected"
+	case GNUProperty1Needed:
+		return "1_needed"
+	case GNUPropertyAArch64Feature1And:
+		return "AArch64 feature"
+	case GNUPropertyX86Feature1And:
+		return "x86 feature"
+	case GNUPropertyX86Feature2Needed:
+		return "x86 feature needed"
+	case GNUPropertyX86ISA1Needed:
+		return "x86 ISA needed"
+	case GNUPropertyX86Feature2Used:
+		return "x86 feature used"
+	case GNUPropertyX86ISA1Used:
+		return "x86 ISA used"
+	}
+	return fmt.Sprintf("unknown: 0x%08X", uint32(pt))
+}
+
+// GNU_PROPERTY_X86_FEATURE_1_AND bits
+const (
+	GNUPropertyX86FeatureIBT   = 0x01
+	GNUPropertyX86FeatureSHSTK = 0x02
+)
+
+// GNU_PROPERTY_AARCH64_FEATURE_1_AND bits
+const (
+	GNUPropertyAArch64FeatureBTI = 0x01
+	GNUPropertyAArch64FeaturePAC = 0x02
+	GNUPropertyAArch64FeatureGCS = 0x04
+)
+
+// GNU_PROPERTY_X86_ISA_1_* bits
+var gnuPropertyX86ISANames = [...]string{"x86-64-baseline", "x86-64-v2", "x86-64-v3", "x86-64-v4"}
+
+type GNUProperty struct {
+	Type GNUPropertyType
+	Data []byte
+
+	byteOrder binary.ByteOrder
+}
+
+// Uint32 returns property data as 4 byte value, which is how all bitmask properties are stored
+func (gp GNUProperty) Uint32() (uint32, bool) {
+	if len(gp.Data) != 4 {
+		return 0, false
+	}
+	return gp.byteOrder.Uint32(gp.Data), true
+}
+
+func (gp GNUProperty) String() string {
+	value, ok := gp.Uint32()
+	if !ok {
+		return fmt.Sprintf("%v: %x", gp.Type, gp.Data)
+	}
+	switch gp.Type {
+	case GNUPropertyX86ISA1Needed, GNUPropertyX86ISA1Used:
+		return fmt.Sprintf("%v: %v", gp.Type, flagNames(uint64(value), gnuPropertyX86ISANames[:]))
+	case GNUPropertyX86Feature1And:
+		return fmt.Sprintf("%v: %v", gp.Type, flagNames(uint64(value), []string{"IBT", "SHSTK"}))
+	case GNUPropertyAArch64Feature1And:
+		return fmt.Sprintf("%v: %v", gp.Type, flagNames(uint64(value), []string{"BTI", "PAC", "GCS"}))
+	}
+	return fmt.Sprintf("%v: 0x%X", gp.Type, value)
+}
+
+// GNUProperties decodes NT_GNU_PROPERTY_TYPE_0 note, property entries are aligned to native word size
+func (n Note) GNUProperties() ([]GNUProperty, error) {
+	if err := n.is(NoteOwnerGNU, NoteTypeGNUPropertyType0); err != nil {
+		return nil, err
+	}
+	align := uint64(4)
+	if n.class == ELFClass64 {
+		align = 8
+	}
+	var properties []GNUProperty
+	content := n.Description
+	for offset := uint64(0); offset < uint64(len(content)); {
+		if offset+8 > uint64(len(content)) {
+			return nil, fmt.Errorf("%w property at %v: truncated header", ErrInvalidNote, offset)
+		}
+		property := GNUProperty{
+			Type:      GNUPropertyType(n.byteOrder.Uint32(content[offset:])),
+			byteOrder: n.byteOrder,
+		}
+		size := uint64(n.byteOrder.Uint32(content[offset+4:]))
+		if offset+8+size > uint64(len(content)) {
+			return nil, fmt.Errorf("%w property at %v: data size %v overflows note", ErrInvalidNote, offset, size)
+		}
+		property.Data = content[offset+8 : offset+8+size]
+		properties = append(properties, property)
+		offset = alignUp(offset+8+size, align)
+	}
+	return properties, nil
+}
+
+// PackageMetadata is JSON payload of systemd package note
+type PackageMetadata struct {
+	Type         string `json:"type"`
+	OS           string `json:"os"`
+	OSVersion    string `json:"osVersion"`
+	Name         string `json:"name"`
+	Version      string `json:"version"`
+	Architecture string `json:"architecture"`
+	DebugInfoURL string `json:"debugInfoUrl"`
+	// Raw keeps all fields, including ones not listed above
+	Raw map[string]interface{} `json:"-"`
+}
+
+func (n Note) PackageMetadata() (PackageMetadata, error) {
+	var metadata PackageMetadata
+	if err := n.is(NoteOwnerFDO, NoteTypeFDOPackagingMetadata); err != nil {
+		return metadata, err
+	}
+	content := strings.TrimRight(string(n.Description), "\x00")
+	if err := json.Unmarshal([]byte(content), &metadata); err != nil {
+		return metadata, fmt.Errorf("%w package metadata: %v", ErrInvalidNote, err)
+	}
+	if err := json.Unmarshal([]byte(content), &metadata.Raw); err != nil {
+		return metadata, fmt.Errorf("%w package metadata: %v", ErrInvalidNote, err)
+	}
+	return metadata, nil
+}
+
+func alignUp(value, align uint64) uint64 {
+	if align < 2 {
+		return value
+	}
+	return (value + align - 1) &^ (align - 1)
+}
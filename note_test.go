@@ -0,0 +1,94 @@
+package elf
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNotesFromSegments(t *testing.T) {
+	file, err := Open(filepath.Join("testdata", "sample_linux_amd64"))
+	assert.NoError(t, err)
+	defer file.Close()
+
+	notes, err := file.Notes()
+	assert.NoError(t, err)
+	assert.Len(t, notes, 3)
+	assert.Equal(t, "GNU property", notes[0].String())
+	assert.Equal(t, "GNU build ID", notes[1].String())
+	assert.Equal(t, "GNU ABI tag", notes[2].String())
+
+	properties, err := notes[0].GNUProperties()
+	assert.NoError(t, err)
+	assert.Len(t, properties, 1)
+	assert.Equal(t, GNUPropertyX86ISA1Needed, properties[0].Type)
+	assert.Equal(t, "x86 ISA needed: x86-64-baseline", properties[0].String())
+
+	buildID, err := file.BuildID()
+	assert.NoError(t, err)
+	assert.Equal(t, "8b4b335e1e26552c1d9d3b34f3d78020eb1d9aa9", buildID.String())
+
+	tag, err := notes[2].GNUABITag()
+	assert.NoError(t, err)
+	assert.Equal(t, ABITag{OS: ABITagOSLinux, Major: 3, Minor: 2, Patch: 0}, tag)
+	assert.Equal(t, "Linux 3.2.0", tag.String())
+
+	_, err = notes[2].GNUBuildID()
+	assert.True(t, errors.Is(err, ErrInvalidNote))
+
+	_, err = file.GoBuildID()
+	assert.True(t, errors.Is(err, ErrNoteNotFound))
+}
+
+func TestSectionNotes(t *testing.T) {
+	file, err := Open(filepath.Join("testdata", "libsample_gold.so"))
+	assert.NoError(t, err)
+	defer file.Close()
+
+	// non-alloc gold version note follows notes of PT_NOTE segment, which are not repeated for their sections
+	notes, err := file.Notes()
+	assert.NoError(t, err)
+	assert.Len(t, notes, 3)
+	assert.Equal(t, "GNU build ID", notes[1].String())
+	version, err := notes[2].GNUGoldVersion()
+	assert.NoError(t, err)
+	assert.Equal(t, "gold 1.16", version)
+
+	reader, err := file.SectionNotes(file.Section(".note.gnu.gold-version"))
+	assert.NoError(t, err)
+	note, err := reader.Next()
+	assert.NoError(t, err)
+	assert.Equal(t, notes[2], note)
+
+	metadata, err := file.PackageMetadata()
+	assert.NoError(t, err)
+	assert.Equal(t, "deb", metadata.Type)
+	assert.Equal(t, "debian", metadata.OS)
+	assert.Equal(t, "12", metadata.OSVersion)
+	assert.Equal(t, "libsample", metadata.Name)
+	assert.Equal(t, "1.0-1", metadata.Version)
+	assert.Equal(t, "amd64", metadata.Architecture)
+	assert.Equal(t, "libsample", metadata.Raw["name"])
+
+	_, err = file.SectionNotes(file.Section(".text"))
+	assert.True(t, errors.Is(err, ErrInvalidNote))
+}
+
+func TestGoBuildID(t *testing.T) {
+	file, err := Open(filepath.Join("testdata", "helloworld_linux_ppc64"))
+	assert.NoError(t, err)
+	defer file.Close()
+
+	buildID, err := file.GoBuildID()
+	assert.NoError(t, err)
+	assert.Equal(t, "w9VYwwVNTzp1-UgiOmGy/A1wGFSRFFDXnoEE4hFB5/yrcpPMhICOuvUV7pBFcM/CgEud7s0MmNsae3dnZ7-", buildID)
+}
+
+func TestTruncatedNote(t *testing.T) {
+	file := &File{Header: Header{Class: ELFClass64, Endianess: LittleEndian}}
+	reader := file.newNoteReader([]byte{4, 0, 0, 0, 0x20, 0, 0, 0, 3, 0, 0, 0, 'G', 'N', 'U', 0, 1, 2}, 4)
+	_, err := reader.Next()
+	assert.True(t, errors.Is(err, ErrInvalidNote))
+}
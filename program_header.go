@@ -9,36 +9,116 @@ import (
 type SegmentType uint32
 
 const (
-	SegmentTypeNull SegmentType = iota
-	SegmentTypeLoad
-	SegmentTypeDynLink
-	SegmentTypeInterpreterInfo
-	SegmentTypeAuxInfo
-	SegmentTypeReserved
-	SegmentTypeProgramHeaderTable
-	SegmentTypeTLS
-	SegmentTypeLowOS    = 0x60000000
-	SegmentTypeGNUStack = 0x6474E551
-	SegmentTypePaxFlags = 0x65041580
-	SegmentTypeHiOS     = 0x6FFFFFFF
-	SegmentTypeLowProc  = 0x70000000
-	SegmentTypeHighProc = 0x7FFFFFFF
+	//0x0	PT_NULL	Program header table entry unused
+	SegmentTypeNull SegmentType = 0x0
+	//0x1	PT_LOAD	Loadable segment
+	SegmentTypeLoad SegmentType = 0x1
+	//0x2	PT_DYNAMIC	Dynamic linking information
+	SegmentTypeDynLink SegmentType = 0x2
+	//0x3	PT_INTERP	Interpreter information
+	SegmentTypeInterpreterInfo SegmentType = 0x3
+	//0x4	PT_NOTE	Auxiliary information
+	SegmentTypeAuxInfo SegmentType = 0x4
+	//0x5	PT_SHLIB	Reserved
+	SegmentTypeReserved SegmentType = 0x5
+	//0x6	PT_PHDR	Segment containing program header table itself
+	SegmentTypeProgramHeaderTable SegmentType = 0x6
+	//0x7	PT_TLS	Thread-Local Storage template
+	SegmentTypeTLS SegmentType = 0x7
+	//0x60000000	PT_LOOS	Start OS-specific
+	SegmentTypeLowOS SegmentType = 0x60000000
+	//0x6474E550	PT_GNU_EH_FRAME	Exception handling frame header
+	SegmentTypeGNUEHFrame SegmentType = 0x6474E550
+	//0x6474E551	PT_GNU_STACK	Stack executability
+	SegmentTypeGNUStack SegmentType = 0x6474E551
+	//0x6474E552	PT_GNU_RELRO	Read-only after relocation
+	SegmentTypeGNURelRO SegmentType = 0x6474E552
+	//0x6474E553	PT_GNU_PROPERTY	GNU property notes
+	SegmentTypeGNUProperty SegmentType = 0x6474E553
+	//0x6474E554	PT_GNU_SFRAME	Stack trace information
+	SegmentTypeGNUSFrame SegmentType = 0x6474E554
+	//0x6474E555	PT_GNU_MBIND_LO	Start of memory binding segments
+	SegmentTypeGNUMBindLow SegmentType = 0x6474E555
+	//0x6474F554	PT_GNU_MBIND_HI	End of memory binding segments
+	SegmentTypeGNUMBindHigh SegmentType = 0x6474F554
+	//0x65041580	PT_PAX_FLAGS	PaX security flags
+	SegmentTypePaxFlags SegmentType = 0x65041580
+	//0x65A3DBE5	PT_OPENBSD_MUTABLE	OpenBSD mutable .bss
+	SegmentTypeOpenBSDMutable SegmentType = 0x65A3DBE5
+	//0x65A3DBE6	PT_OPENBSD_RANDOMIZE	OpenBSD random data
+	SegmentTypeOpenBSDRandomize SegmentType = 0x65A3DBE6
+	//0x65A3DBE7	PT_OPENBSD_WXNEEDED	OpenBSD W^X violations
+	SegmentTypeOpenBSDWXNeeded SegmentType = 0x65A3DBE7
+	//0x65A3DBE8	PT_OPENBSD_NOBTCFI	OpenBSD no branch target CFI
+	SegmentTypeOpenBSDNoBTCFI SegmentType = 0x65A3DBE8
+	//0x65A3DBE9	PT_OPENBSD_SYSCALLS	OpenBSD system call sites
+	SegmentTypeOpenBSDSyscalls SegmentType = 0x65A3DBE9
+	//0x65A41BE6	PT_OPENBSD_BOOTDATA	OpenBSD boot arguments
+	SegmentTypeOpenBSDBootData SegmentType = 0x65A41BE6
+	//0x6FFFFFFA	PT_SUNWBSS	Sun specific segment
+	SegmentTypeSunBSS SegmentType = 0x6FFFFFFA
+	//0x6FFFFFFB	PT_SUNWSTACK	Sun stack segment
+	SegmentTypeSunStack SegmentType = 0x6FFFFFFB
+	//0x6FFFFFFF	PT_HIOS	End OS-specific
+	SegmentTypeHiOS SegmentType = 0x6FFFFFFF
+	//0x70000000	PT_LOPROC	Start processor-specific
+	SegmentTypeLowProc SegmentType = 0x70000000
+	//0x7FFFFFFF	PT_HIPROC	End processor-specific
+	SegmentTypeHighProc SegmentType = 0x7FFFFFFF
 )
 
+var segmentTypeNames = newConstantNames("SegmentType", []constantName{
+	{0x0, "PT_NULL", "SegmentTypeNull", "NULL"},
+	{0x1, "PT_LOAD", "SegmentTypeLoad", "LOAD"},
+	{0x2, "PT_DYNAMIC", "SegmentTypeDynLink", "DYNAMIC"},
+	{0x3, "PT_INTERP", "SegmentTypeInterpreterInfo", "INTERP"},
+	{0x4, "PT_NOTE", "SegmentTypeAuxInfo", "NOTE"},
+	{0x5, "PT_SHLIB", "SegmentTypeReserved", "SHLIB"},
+	{0x6, "PT_PHDR", "SegmentTypeProgramHeaderTable", "PHDR"},
+	{0x7, "PT_TLS", "SegmentTypeTLS", "TLS"},
+	{0x60000000, "PT_LOOS", "SegmentTypeLowOS", ""},
+	{0x6474E550, "PT_GNU_EH_FRAME", "SegmentTypeGNUEHFrame", "GNU_EH_FRAME"},
+	{0x6474E551, "PT_GNU_STACK", "SegmentTypeGNUStack", "GNU_STACK"},
+	{0x6474E552, "PT_GNU_RELRO", "SegmentTypeGNURelRO", "GNU_RELRO"},
+	{0x6474E553, "PT_GNU_PROPERTY", "SegmentTypeGNUProperty", "GNU_PROPERTY"},
+	{0x6474E554, "PT_GNU_SFRAME", "SegmentTypeGNUSFrame", "GNU_SFRAME"},
+	{0x6474E555, "PT_GNU_MBIND_LO", "SegmentTypeGNUMBindLow", "GNU_MBIND_LO"},
+	{0x6474F554, "PT_GNU_MBIND_HI", "SegmentTypeGNUMBindHigh", "GNU_MBIND_HI"},
+	{0x65041580, "PT_PAX_FLAGS", "SegmentTypePaxFlags", "PAX_FLAGS"},
+	{0x65A3DBE5, "PT_OPENBSD_MUTABLE", "SegmentTypeOpenBSDMutable", "OPENBSD_MUTABLE"},
+	{0x65A3DBE6, "PT_OPENBSD_RANDOMIZE", "SegmentTypeOpenBSDRandomize", "OPENBSD_RANDOMIZE"},
+	{0x65A3DBE7, "PT_OPENBSD_WXNEEDED", "SegmentTypeOpenBSDWXNeeded", "OPENBSD_WXNEEDED"},
+	{0x65A3DBE8, "PT_OPENBSD_NOBTCFI", "SegmentTypeOpenBSDNoBTCFI", "OPENBSD_NOBTCFI"},
+	{0x65A3DBE9, "PT_OPENBSD_SYSCALLS", "SegmentTypeOpenBSDSyscalls", "OPENBSD_SYSCALLS"},
+	{0x65A41BE6, "PT_OPENBSD_BOOTDATA", "SegmentTypeOpenBSDBootData", "OPENBSD_BOOTDATA"},
+	{0x6FFFFFFA, "PT_SUNWBSS", "SegmentTypeSunBSS", "SUNWBSS"},
+	{0x6FFFFFFB, "PT_SUNWSTACK", "SegmentTypeSunStack", "SUNWSTACK"},
+	{0x6FFFFFFF, "PT_HIOS", "SegmentTypeHiOS", ""},
+	{0x70000000, "PT_LOPROC", "SegmentTypeLowProc", ""},
+	{0x7FFFFFFF, "PT_HIPROC", "SegmentTypeHighProc", ""},
+})
+
 func (st SegmentType) String() string {
+	if text, ok := segmentTypeNames.text(uint64(st)); ok {
+		return text
+	}
 	switch {
-	case st < 7:
-		return [...]string{"null", "loadable", "dynamic link info", "interpreter info", "aux info", "reserverd", "prog hdr tbl", "TLS template"}[st]
-	case st == SegmentTypeGNUStack:
-		return "GUN stack"
-	case st == SegmentTypePaxFlags:
-		return "PAX flags"
 	case st >= SegmentTypeLowOS && st <= SegmentTypeHiOS:
-		return fmt.Sprintf("OS reserverd: 0x%04X", uint32(st))
+		return fmt.Sprintf("OS specific: 0x%08X", uint32(st))
 	case st >= SegmentTypeLowProc && st <= SegmentTypeHighProc:
-		return fmt.Sprintf("proc reserverd: 0x%04X", uint32(st))
+		return fmt.Sprintf("proc specific: 0x%08X", uint32(st))
 	}
-	return fmt.Sprintf("unknown segment type: 0x%04X", uint32(st))
+	return fmt.Sprintf("unknown: 0x%08X", uint32(st))
+}
+
+func (st SegmentType) GoString() string {
+	return segmentTypeNames.goString(uint64(st))
+}
+
+// ParseSegmentType resolves specification (PT_GNU_RELRO) or Go (SegmentTypeGNURelRO) constant name
+func ParseSegmentType(name string) (SegmentType, error) {
+	value, err := segmentTypeNames.parse(name)
+	return SegmentType(value), err
 }
 
 // PF_X 0x1 Execute
@@ -89,7 +169,8 @@ func (a Alignment) String() string {
 			return fmt.Sprintf("%v bits", i)
 		}
 	}
-	panic("i reached end of loop count")
+	// alignment of malformed file which is not power of two
+	return fmt.Sprintf("invalid: %v", uint64(a))
 }
 
 type ProgramHeader struct {
@@ -0,0 +1,159 @@
+package elf
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+)
+
+// relocation entry sizes as defined by the spec
+const (
+	relocationSize32 = 0x08
+	relocationSize64 = 0x10
+)
+
+type Relocation struct {
+	Offset      MemoryAddress  // 4 bytes on elf32 8 bytes on elf64 - offset in section for ET_REL, virtual address otherwise
+	Info        uint64         // 4 bytes on elf32 8 bytes on elf64 - raw symbol index and type
+	Addend      int64          // 4 bytes on elf32 8 bytes on elf64 - only for SHT_RELA, SHT_REL keeps addend in relocated place
+	HasAddend   bool           // entry is from SHT_RELA section
+	Type        RelocationType // decoded from Info according to Header.ISet
+	SymbolIndex uint32         // decoded from Info
+	Symbol      *Symbol        // resolved from symbol table linked by relocation section, nil for SymbolIndex 0
+	// MIPS64 entries carry up to three relocation types and special symbol in single entry
+	Type2         RelocationType
+	Type3         RelocationType
+	SpecialSymbol uint8
+	// ELF64 SPARC v9 entries keep signed type specific data in upper 24 bits of type, e.g. addend of R_SPARC_OLO10
+	TypeData int32
+}
+
+var ErrInvalidRelocation = errors.New("invalid relocation")
+
+// ReadRelocation reads single REL or RELA entry, only raw fields are filled - see Header.DecodeRelocationInfo
+func ReadRelocation(nativeReader NativeWordReader, withAddend bool) (Relocation, error) {
+	var relocation Relocation
+
+	wordVal, err := nativeReader.ReadNativeWord()
+	if err != nil {
+		return relocation, fmt.Errorf("%w offset read: %v", ErrInvalidRelocation, err)
+	}
+	relocation.Offset = MemoryAddress(wordVal)
+
+	wordVal, err = nativeReader.ReadNativeWord()
+	if err != nil {
+		return relocation, fmt.Errorf("%w info read: %v", ErrInvalidRelocation, err)
+	}
+	relocation.Info = wordVal
+
+	if withAddend {
+		wordVal, err = nativeReader.ReadNativeWord()
+		if err != nil {
+			return relocation, fmt.Errorf("%w addend read: %v", ErrInvalidRelocation, err)
+		}
+		if nativeReader.Class == ELFClass32 {
+			relocation.Addend = int64(int32(wordVal))
+		} else {
+			relocation.Addend = int64(wordVal)
+		}
+		relocation.HasAddend = true
+	}
+
+	return relocation, nil
+}
+
+// DecodeRelocationInfo splits raw Info field into symbol index and relocation types
+func (h Header) DecodeRelocationInfo(relocation *Relocation) {
+	info := relocation.Info
+	switch {
+	case h.Class == ELFClass32:
+		relocation.SymbolIndex = uint32(info >> 8)
+		relocation.Type = NewRelocationType(h.ISet, uint32(info&0xFF))
+	case h.ISet == ISMIPS:
+		// r_info on MIPS64 is 4 bytes of r_sym followed by single bytes of r_ssym, r_type3, r_type2 and r_type, each
+		// field in native byte order, so little endian files can't be decoded as single 8 byte word
+		var types [3]uint8
+		if h.Endianess == LittleEndian {
+			relocation.SymbolIndex = uint32(info)
+			relocation.SpecialSymbol = uint8(info >> 32)
+			types = [3]uint8{uint8(info >> 56), uint8(info >> 48), uint8(info >> 40)}
+		} else {
+			relocation.SymbolIndex = uint32(info >> 32)
+			relocation.SpecialSymbol = uint8(info >> 24)
+			types = [3]uint8{uint8(info), uint8(info >> 8), uint8(info >> 16)}
+		}
+		relocation.Type = NewRelocationType(h.ISet, uint32(types[0]))
+		relocation.Type2 = NewRelocationType(h.ISet, uint32(types[1]))
+		relocation.Type3 = NewRelocationType(h.ISet, uint32(types[2]))
+	case h.ISet == ISSPARCV9:
+		relocation.SymbolIndex = uint32(info >> 32)
+		relocation.Type = NewRelocationType(h.ISet, uint32(info&0xFF))
+		relocation.TypeData = int32(uint32(info>>8)&0xFFFFFF^0x800000) - 0x800000
+	default:
+		relocation.SymbolIndex = uint32(info >> 32)
+		relocation.Type = NewRelocationType(h.ISet, uint32(info))
+	}
+}
+
+// Relocations decodes all entries of SHT_REL or SHT_RELA section and resolves their symbols from linked symbol table
+func (f *File) Relocations(section *Section) ([]Relocation, error) {
+	var withAddend bool
+	switch section.Type {
+	case SectionTypeRelocEnt:
+		withAddend = true
+	case SectionTypeRelocEntNA:
+	default:
+		return nil, fmt.Errorf("%w section %v type is %v", ErrInvalidRelocation, section.Name, section.Type)
+	}
+
+	minSize := uint64(relocationSize32)
+	if f.Class == ELFClass64 {
+		minSize = relocationSize64
+	}
+	if withAddend {
+		// addend is additional native word
+		minSize += minSize / 2
+	}
+	entrySize := section.EntrySize
+	if entrySize == 0 {
+		entrySize = minSize
+	}
+	if entrySize < minSize {
+		return nil, fmt.Errorf("%w entry size %v is smaller than %v", ErrInvalidRelocation, entrySize, minSize)
+	}
+
+	var symbols []Symbol
+	// sh_link is 0 for relocations without symbols, e.g. RELATIVE only sections
+	if section.Link != 0 {
+		if int(section.Link) >= len(f.Sections) {
+			return nil, fmt.Errorf("%w symbol table index %v out of bounds: %v", ErrInvalidRelocation, section.Link, len(f.Sections))
+		}
+		var err error
+		symbols, err = f.SectionSymbols(f.Sections[section.Link])
+		if err != nil {
+			return nil, fmt.Errorf("%w symbols: %v", ErrInvalidRelocation, err)
+		}
+	}
+
+	content, err := section.Data()
+	if err != nil {
+		return nil, fmt.Errorf("%w read: %v", ErrInvalidRelocation, err)
+	}
+
+	relocations := make([]Relocation, 0, uint64(len(content))/entrySize)
+	for offset := uint64(0); offset+entrySize <= uint64(len(content)); offset += entrySize {
+		relocation, err := ReadRelocation(f.NativeReader(bytes.NewReader(content[offset:offset+entrySize])), withAddend)
+		if err != nil {
+			return nil, fmt.Errorf("relocation %v: %w", len(relocations), err)
+		}
+		f.DecodeRelocationInfo(&relocation)
+		if relocation.SymbolIndex != 0 {
+			if int(relocation.SymbolIndex) >= len(symbols) {
+				return nil, fmt.Errorf("%w relocation %v symbol index %v out of bounds: %v", ErrInvalidRelocation, len(relocations), relocation.SymbolIndex, len(symbols))
+			}
+			relocation.Symbol = &symbols[relocation.SymbolIndex]
+		}
+		relocations = append(relocations, relocation)
+	}
+	return relocations, nil
+}
@@ -0,0 +1,165 @@
+package elf
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRelocationsWithAddend(t *testing.T) {
+	file, err := Open(filepath.Join("testdata", "sample_linux_amd64.o"))
+	assert.NoError(t, err)
+	defer file.Close()
+
+	relocations, err := file.Relocations(file.Section(".rela.text"))
+	assert.NoError(t, err)
+	assert.Len(t, relocations, 26)
+
+	first := relocations[0]
+	assert.Equal(t, MemoryAddress(0x95), first.Offset)
+	assert.Equal(t, uint64(0x0000002800000004), first.Info)
+	assert.Equal(t, R_X86_64_PLT32, first.Type)
+	assert.Equal(t, "R_X86_64_PLT32", first.Type.String())
+	assert.Equal(t, uint32(0x28), first.SymbolIndex)
+	assert.Equal(t, "_Znwm", first.Symbol.Name)
+	assert.Equal(t, int64(-4), first.Addend)
+	assert.True(t, first.HasAddend)
+	assert.Nil(t, first.Type2)
+
+	debugInfo, err := file.Relocations(file.Section(".rela.debug_info"))
+	assert.NoError(t, err)
+	assert.Len(t, debugInfo, 2273)
+	assert.Equal(t, R_X86_64_32, debugInfo[1].Type)
+	assert.Equal(t, SymbolTypeSection, debugInfo[1].Symbol.Type)
+	assert.Equal(t, ".debug_str", file.Sections[debugInfo[1].Symbol.SectionIndex].Name)
+	assert.Equal(t, int64(0x6f74), debugInfo[1].Addend)
+}
+
+func TestRelocationsWithoutAddend(t *testing.T) {
+	file, err := Open(filepath.Join("testdata", "object_linux_386.o"))
+	assert.NoError(t, err)
+	defer file.Close()
+
+	relocations, err := file.Relocations(file.Section(".rel.text"))
+	assert.NoError(t, err)
+	assert.Len(t, relocations, 5)
+	assert.Equal(t, R_386_PC32, relocations[0].Type)
+	assert.Equal(t, "__x86.get_pc_thunk.ax", relocations[0].Symbol.Name)
+	assert.Equal(t, R_386_GOTPC, relocations[1].Type)
+	assert.Equal(t, R_386_GOT32X, relocations[4].Type)
+	assert.Equal(t, MemoryAddress(0x1b), relocations[4].Offset)
+	assert.Equal(t, "external_value", relocations[4].Symbol.Name)
+	assert.False(t, relocations[4].HasAddend)
+	assert.Equal(t, int64(0), relocations[4].Addend)
+
+	_, err = file.Relocations(file.Section(".text"))
+	assert.True(t, errors.Is(err, ErrInvalidRelocation))
+}
+
+func TestMIPS64RelocationInfo(t *testing.T) {
+	tcs := []struct {
+		endianess Endianess
+		order     binary.ByteOrder
+	}{
+		{LittleEndian, binary.LittleEndian},
+		{BigEndian, binary.BigEndian},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.endianess.String(), func(t *testing.T) {
+			// r_offset, r_sym = 7, r_ssym = 1, r_type3 = R_MIPS_NONE, r_type2 = R_MIPS_64, r_type = R_MIPS_GPREL32, r_addend
+			var entry bytes.Buffer
+			binary.Write(&entry, tc.order, uint64(0x10))
+			binary.Write(&entry, tc.order, uint32(7))
+			entry.Write([]byte{1, byte(R_MIPS_NONE), byte(R_MIPS_64), byte(R_MIPS_GPREL32)})
+			binary.Write(&entry, tc.order, int64(-8))
+
+			header := Header{Class: ELFClass64, Endianess: tc.endianess, ISet: ISMIPS}
+			relocation, err := ReadRelocation(header.NativeReader(&entry), true)
+			assert.NoError(t, err)
+			header.DecodeRelocationInfo(&relocation)
+
+			assert.Equal(t, MemoryAddress(0x10), relocation.Offset)
+			assert.Equal(t, uint32(7), relocation.SymbolIndex)
+			assert.Equal(t, uint8(1), relocation.SpecialSymbol)
+			assert.Equal(t, R_MIPS_GPREL32, relocation.Type)
+			assert.Equal(t, R_MIPS_64, relocation.Type2)
+			assert.Equal(t, R_MIPS_NONE, relocation.Type3)
+			assert.Equal(t, int64(-8), relocation.Addend)
+		})
+	}
+}
+
+func TestSPARCV9Relocations(t *testing.T) {
+	file, err := Open(filepath.Join("testdata", "relocation_linux_sparc64.o"))
+	assert.NoError(t, err)
+	defer file.Close()
+	assert.Equal(t, ISSPARCV9, file.ISet)
+
+	// compare with readelf -r
+	relocations, err := file.Relocations(file.Section(".rela.text"))
+	assert.NoError(t, err)
+	assert.Len(t, relocations, 3)
+	assert.Equal(t, R_SPARC_HI22, relocations[0].Type)
+	assert.Equal(t, R_SPARC_LO10, relocations[1].Type)
+	assert.Equal(t, MemoryAddress(4), relocations[1].Offset)
+	assert.Equal(t, R_SPARC_WDISP30, relocations[2].Type)
+	assert.Equal(t, "external_function", relocations[2].Symbol.Name)
+
+	data, err := file.Relocations(file.Section(".rela.data"))
+	assert.NoError(t, err)
+	assert.Len(t, data, 2)
+	assert.Equal(t, R_SPARC_64, data[0].Type)
+	assert.Equal(t, "external_value", data[0].Symbol.Name)
+	assert.Equal(t, R_SPARC_32, data[1].Type)
+	assert.Zero(t, data[1].TypeData)
+
+	// R_SPARC_OLO10 keeps its second addend in type data
+	relocation := Relocation{Info: 0x0000000500001021}
+	file.DecodeRelocationInfo(&relocation)
+	assert.Equal(t, uint32(5), relocation.SymbolIndex)
+	assert.Equal(t, R_SPARC_OLO10, relocation.Type)
+	assert.Equal(t, int32(0x10), relocation.TypeData)
+
+	// type data is signed 24 bit value
+	relocation = Relocation{Info: 0x00000005FFFFFC21}
+	file.DecodeRelocationInfo(&relocation)
+	assert.Equal(t, R_SPARC_OLO10, relocation.Type)
+	assert.Equal(t, int32(-4), relocation.TypeData)
+}
+
+func TestRelocationTypeStrings(t *testing.T) {
+	tcs := []struct {
+		machine InstructionSet
+		value   uint32
+		name    string
+	}{
+		{ISx86, 1, "R_386_32"},
+		{ISAmd64, 2, "R_X86_64_PC32"},
+		{ISARM, 2, "R_ARM_ABS32"},
+		{ISAArch64, 257, "R_AARCH64_ABS64"},
+		{ISPowerPC, 1, "R_PPC_ADDR32"},
+		{ISPowerPC64, 38, "R_PPC64_ADDR64"},
+		{ISS390WithS390x, 22, "R_390_64"},
+		{ISRISCV, 2, "R_RISCV_64"},
+		{ISSparc, 3, "R_SPARC_32"},
+		{ISMIPS, 2, "R_MIPS_32"},
+		{ISMIPSRS3LE, 2, "R_MIPS_32"},
+		{ISSPARC32PLUS, 3, "R_SPARC_32"},
+		{ISSPARCV9, 32, "R_SPARC_64"},
+		{ISAmd64, 1000, "unknown: 1000"},
+		{ISSuperH, 160, "R_SH_GOT32"},
+		{ISIA64, 0x27, "R_IA64_DIR64LSB"},
+		{ISTMS320C6000, 1, "R_C6000_ABS32"},
+		{ISIA64, 5, "unknown: 5"},
+		{ISAVR, 1, "unknown: 1"},
+	}
+	for _, tc := range tcs {
+		relocationType := NewRelocationType(tc.machine, tc.value)
+		assert.Equal(t, tc.name, relocationType.String())
+		assert.Equal(t, tc.value, relocationType.Value())
+	}
+}
@@ -0,0 +1,2248 @@
+package elf
+
+import "fmt"
+
+// RelocationType is machine specific type of relocation, concrete type depends on Header.ISet
+type RelocationType interface {
+	fmt.Stringer
+	Value() uint32
+}
+
+// RelocationTypeUnknown is used for machines without relocation type enumeration
+type RelocationTypeUnknown uint32
+
+func (rt RelocationTypeUnknown) Value() uint32 {
+	return uint32(rt)
+}
+
+func (rt RelocationTypeUnknown) String() string {
+	return fmt.Sprintf("unknown: %v", uint32(rt))
+}
+
+// NewRelocationType wraps raw relocation type value into enumeration of given machine
+func NewRelocationType(machine InstructionSet, value uint32) RelocationType {
+	switch machine {
+	case ISx86:
+		return RelocationType386(value)
+	case ISAmd64:
+		return RelocationTypeAMD64(value)
+	case ISARM:
+		return RelocationTypeARM(value)
+	case ISAArch64:
+		return RelocationTypeAArch64(value)
+	case ISPowerPC:
+		return RelocationTypePPC(value)
+	case ISPowerPC64:
+		return RelocationTypePPC64(value)
+	case ISS390WithS390x:
+		return RelocationTypeS390(value)
+	case ISRISCV:
+		return RelocationTypeRISCV(value)
+	case ISSparc, ISSPARC32PLUS, ISSPARCV9:
+		return RelocationTypeSPARC(value)
+	case ISMIPS, ISMIPSRS3LE:
+		return RelocationTypeMIPS(value)
+	case ISSuperH:
+		return RelocationTypeSH(value)
+	case ISIA64:
+		return RelocationTypeIA64(value)
+	case ISTMS320C6000:
+		return RelocationTypeC6000(value)
+	}
+	return RelocationTypeUnknown(value)
+}
+
+func relocationTypeName(value uint32, name string, ok bool) string {
+	if ok {
+		return name
+	}
+	return fmt.Sprintf("unknown: %v", value)
+}
+
+// RelocationType386 enumerates relocation types of x86
+type RelocationType386 uint32
+
+const (
+	R_386_NONE          RelocationType386 = 0  // No relocation
+	R_386_32            RelocationType386 = 1  // Add symbol value
+	R_386_PC32          RelocationType386 = 2  // Add PC-relative symbol value
+	R_386_GOT32         RelocationType386 = 3  // Add PC-relative GOT offset
+	R_386_PLT32         RelocationType386 = 4  // Add PC-relative PLT offset
+	R_386_COPY          RelocationType386 = 5  // Copy data from shared object
+	R_386_GLOB_DAT      RelocationType386 = 6  // Set GOT entry to data address
+	R_386_JMP_SLOT      RelocationType386 = 7  // Set GOT entry to code address
+	R_386_RELATIVE      RelocationType386 = 8  // Add load address of shared object
+	R_386_GOTOFF        RelocationType386 = 9  // Add GOT-relative symbol address
+	R_386_GOTPC         RelocationType386 = 10 // Add PC-relative GOT table address
+	R_386_32PLT         RelocationType386 = 11
+	R_386_TLS_TPOFF     RelocationType386 = 14 // Negative offset in static TLS block
+	R_386_TLS_IE        RelocationType386 = 15 // Absolute address of GOT for -ve static TLS
+	R_386_TLS_GOTIE     RelocationType386 = 16 // GOT entry for negative static TLS block
+	R_386_TLS_LE        RelocationType386 = 17 // Negative offset relative to static TLS
+	R_386_TLS_GD        RelocationType386 = 18 // 32 bit offset to GOT (index,off) pair
+	R_386_TLS_LDM       RelocationType386 = 19 // 32 bit offset to GOT (index,zero) pair
+	R_386_16            RelocationType386 = 20
+	R_386_PC16          RelocationType386 = 21
+	R_386_8             RelocationType386 = 22
+	R_386_PC8           RelocationType386 = 23
+	R_386_TLS_GD_32     RelocationType386 = 24 // 32 bit offset to GOT (index,off) pair
+	R_386_TLS_GD_PUSH   RelocationType386 = 25 // pushl instruction for Sun ABI GD sequence
+	R_386_TLS_GD_CALL   RelocationType386 = 26 // call instruction for Sun ABI GD sequence
+	R_386_TLS_GD_POP    RelocationType386 = 27 // popl instruction for Sun ABI GD sequence
+	R_386_TLS_LDM_32    RelocationType386 = 28 // 32 bit offset to GOT (index,zero) pair
+	R_386_TLS_LDM_PUSH  RelocationType386 = 29 // pushl instruction for Sun ABI LD sequence
+	R_386_TLS_LDM_CALL  RelocationType386 = 30 // call instruction for Sun ABI LD sequence
+	R_386_TLS_LDM_POP   RelocationType386 = 31 // popl instruction for Sun ABI LD sequence
+	R_386_TLS_LDO_32    RelocationType386 = 32 // 32 bit offset from start of TLS block
+	R_386_TLS_IE_32     RelocationType386 = 33 // 32 bit offset to GOT static TLS offset entry
+	R_386_TLS_LE_32     RelocationType386 = 34 // 32 bit offset within static TLS block
+	R_386_TLS_DTPMOD32  RelocationType386 = 35 // GOT entry containing TLS index
+	R_386_TLS_DTPOFF32  RelocationType386 = 36 // GOT entry containing TLS offset
+	R_386_TLS_TPOFF32   RelocationType386 = 37 // GOT entry of -ve static TLS offset
+	R_386_SIZE32        RelocationType386 = 38
+	R_386_TLS_GOTDESC   RelocationType386 = 39
+	R_386_TLS_DESC_CALL RelocationType386 = 40
+	R_386_TLS_DESC      RelocationType386 = 41
+	R_386_IRELATIVE     RelocationType386 = 42
+	R_386_GOT32X        RelocationType386 = 43
+)
+
+var relocationType386Names = map[RelocationType386]string{
+	R_386_NONE:          "R_386_NONE",
+	R_386_32:            "R_386_32",
+	R_386_PC32:          "R_386_PC32",
+	R_386_GOT32:         "R_386_GOT32",
+	R_386_PLT32:         "R_386_PLT32",
+	R_386_COPY:          "R_386_COPY",
+	R_386_GLOB_DAT:      "R_386_GLOB_DAT",
+	R_386_JMP_SLOT:      "R_386_JMP_SLOT",
+	R_386_RELATIVE:      "R_386_RELATIVE",
+	R_386_GOTOFF:        "R_386_GOTOFF",
+	R_386_GOTPC:         "R_386_GOTPC",
+	R_386_32PLT:         "R_386_32PLT",
+	R_386_TLS_TPOFF:     "R_386_TLS_TPOFF",
+	R_386_TLS_IE:        "R_386_TLS_IE",
+	R_386_TLS_GOTIE:     "R_386_TLS_GOTIE",
+	R_386_TLS_LE:        "R_386_TLS_LE",
+	R_386_TLS_GD:        "R_386_TLS_GD",
+	R_386_TLS_LDM:       "R_386_TLS_LDM",
+	R_386_16:            "R_386_16",
+	R_386_PC16:          "R_386_PC16",
+	R_386_8:             "R_386_8",
+	R_386_PC8:           "R_386_PC8",
+	R_386_TLS_GD_32:     "R_386_TLS_GD_32",
+	R_386_TLS_GD_PUSH:   "R_386_TLS_GD_PUSH",
+	R_386_TLS_GD_CALL:   "R_386_TLS_GD_CALL",
+	R_386_TLS_GD_POP:    "R_386_TLS_GD_POP",
+	R_386_TLS_LDM_32:    "R_386_TLS_LDM_32",
+	R_386_TLS_LDM_PUSH:  "R_386_TLS_LDM_PUSH",
+	R_386_TLS_LDM_CALL:  "R_386_TLS_LDM_CALL",
+	R_386_TLS_LDM_POP:   "R_386_TLS_LDM_POP",
+	R_386_TLS_LDO_32:    "R_386_TLS_LDO_32",
+	R_386_TLS_IE_32:     "R_386_TLS_IE_32",
+	R_386_TLS_LE_32:     "R_386_TLS_LE_32",
+	R_386_TLS_DTPMOD32:  "R_386_TLS_DTPMOD32",
+	R_386_TLS_DTPOFF32:  "R_386_TLS_DTPOFF32",
+	R_386_TLS_TPOFF32:   "R_386_TLS_TPOFF32",
+	R_386_SIZE32:        "R_386_SIZE32",
+	R_386_TLS_GOTDESC:   "R_386_TLS_GOTDESC",
+	R_386_TLS_DESC_CALL: "R_386_TLS_DESC_CALL",
+	R_386_TLS_DESC:      "R_386_TLS_DESC",
+	R_386_IRELATIVE:     "R_386_IRELATIVE",
+	R_386_GOT32X:        "R_386_GOT32X",
+}
+
+func (rt RelocationType386) Value() uint32 {
+	return uint32(rt)
+}
+
+func (rt RelocationType386) String() string {
+	name, ok := relocationType386Names[rt]
+	return relocationTypeName(uint32(rt), name, ok)
+}
+
+// RelocationTypeAMD64 enumerates relocation types of amd64
+type RelocationTypeAMD64 uint32
+
+const (
+	R_X86_64_NONE            RelocationTypeAMD64 = 0  // No relocation
+	R_X86_64_64              RelocationTypeAMD64 = 1  // Add 64 bit symbol value
+	R_X86_64_PC32            RelocationTypeAMD64 = 2  // PC-relative 32 bit signed sym value
+	R_X86_64_GOT32           RelocationTypeAMD64 = 3  // PC-relative 32 bit GOT offset
+	R_X86_64_PLT32           RelocationTypeAMD64 = 4  // PC-relative 32 bit PLT offset
+	R_X86_64_COPY            RelocationTypeAMD64 = 5  // Copy data from shared object
+	R_X86_64_GLOB_DAT        RelocationTypeAMD64 = 6  // Set GOT entry to data address
+	R_X86_64_JMP_SLOT        RelocationTypeAMD64 = 7  // Set GOT entry to code address
+	R_X86_64_RELATIVE        RelocationTypeAMD64 = 8  // Add load address of shared object
+	R_X86_64_GOTPCREL        RelocationTypeAMD64 = 9  // Add 32 bit signed pcrel offset to GOT
+	R_X86_64_32              RelocationTypeAMD64 = 10 // Add 32 bit zero extended symbol value
+	R_X86_64_32S             RelocationTypeAMD64 = 11 // Add 32 bit sign extended symbol value
+	R_X86_64_16              RelocationTypeAMD64 = 12 // Add 16 bit zero extended symbol value
+	R_X86_64_PC16            RelocationTypeAMD64 = 13 // Add 16 bit signed extended pc relative symbol value
+	R_X86_64_8               RelocationTypeAMD64 = 14 // Add 8 bit zero extended symbol value
+	R_X86_64_PC8             RelocationTypeAMD64 = 15 // Add 8 bit signed extended pc relative symbol value
+	R_X86_64_DTPMOD64        RelocationTypeAMD64 = 16 // ID of module containing symbol
+	R_X86_64_DTPOFF64        RelocationTypeAMD64 = 17 // Offset in TLS block
+	R_X86_64_TPOFF64         RelocationTypeAMD64 = 18 // Offset in static TLS block
+	R_X86_64_TLSGD           RelocationTypeAMD64 = 19 // PC relative offset to GD GOT entry
+	R_X86_64_TLSLD           RelocationTypeAMD64 = 20 // PC relative offset to LD GOT entry
+	R_X86_64_DTPOFF32        RelocationTypeAMD64 = 21 // Offset in TLS block
+	R_X86_64_GOTTPOFF        RelocationTypeAMD64 = 22 // PC relative offset to IE GOT entry
+	R_X86_64_TPOFF32         RelocationTypeAMD64 = 23 // Offset in static TLS block
+	R_X86_64_PC64            RelocationTypeAMD64 = 24 // PC relative 64-bit sign extended symbol value
+	R_X86_64_GOTOFF64        RelocationTypeAMD64 = 25
+	R_X86_64_GOTPC32         RelocationTypeAMD64 = 26
+	R_X86_64_GOT64           RelocationTypeAMD64 = 27
+	R_X86_64_GOTPCREL64      RelocationTypeAMD64 = 28
+	R_X86_64_GOTPC64         RelocationTypeAMD64 = 29
+	R_X86_64_GOTPLT64        RelocationTypeAMD64 = 30
+	R_X86_64_PLTOFF64        RelocationTypeAMD64 = 31
+	R_X86_64_SIZE32          RelocationTypeAMD64 = 32
+	R_X86_64_SIZE64          RelocationTypeAMD64 = 33
+	R_X86_64_GOTPC32_TLSDESC RelocationTypeAMD64 = 34
+	R_X86_64_TLSDESC_CALL    RelocationTypeAMD64 = 35
+	R_X86_64_TLSDESC         RelocationTypeAMD64 = 36
+	R_X86_64_IRELATIVE       RelocationTypeAMD64 = 37
+	R_X86_64_RELATIVE64      RelocationTypeAMD64 = 38
+	R_X86_64_PC32_BND        RelocationTypeAMD64 = 39
+	R_X86_64_PLT32_BND       RelocationTypeAMD64 = 40
+	R_X86_64_GOTPCRELX       RelocationTypeAMD64 = 41
+	R_X86_64_REX_GOTPCRELX   RelocationTypeAMD64 = 42
+)
+
+var relocationTypeAMD64Names = map[RelocationTypeAMD64]string{
+	R_X86_64_NONE:            "R_X86_64_NONE",
+	R_X86_64_64:              "R_X86_64_64",
+	R_X86_64_PC32:            "R_X86_64_PC32",
+	R_X86_64_GOT32:           "R_X86_64_GOT32",
+	R_X86_64_PLT32:           "R_X86_64_PLT32",
+	R_X86_64_COPY:            "R_X86_64_COPY",
+	R_X86_64_GLOB_DAT:        "R_X86_64_GLOB_DAT",
+	R_X86_64_JMP_SLOT:        "R_X86_64_JMP_SLOT",
+	R_X86_64_RELATIVE:        "R_X86_64_RELATIVE",
+	R_X86_64_GOTPCREL:        "R_X86_64_GOTPCREL",
+	R_X86_64_32:              "R_X86_64_32",
+	R_X86_64_32S:             "R_X86_64_32S",
+	R_X86_64_16:              "R_X86_64_16",
+	R_X86_64_PC16:            "R_X86_64_PC16",
+	R_X86_64_8:               "R_X86_64_8",
+	R_X86_64_PC8:             "R_X86_64_PC8",
+	R_X86_64_DTPMOD64:        "R_X86_64_DTPMOD64",
+	R_X86_64_DTPOFF64:        "R_X86_64_DTPOFF64",
+	R_X86_64_TPOFF64:         "R_X86_64_TPOFF64",
+	R_X86_64_TLSGD:           "R_X86_64_TLSGD",
+	R_X86_64_TLSLD:           "R_X86_64_TLSLD",
+	R_X86_64_DTPOFF32:        "R_X86_64_DTPOFF32",
+	R_X86_64_GOTTPOFF:        "R_X86_64_GOTTPOFF",
+	R_X86_64_TPOFF32:         "R_X86_64_TPOFF32",
+	R_X86_64_PC64:            "R_X86_64_PC64",
+	R_X86_64_GOTOFF64:        "R_X86_64_GOTOFF64",
+	R_X86_64_GOTPC32:         "R_X86_64_GOTPC32",
+	R_X86_64_GOT64:           "R_X86_64_GOT64",
+	R_X86_64_GOTPCREL64:      "R_X86_64_GOTPCREL64",
+	R_X86_64_GOTPC64:         "R_X86_64_GOTPC64",
+	R_X86_64_GOTPLT64:        "R_X86_64_GOTPLT64",
+	R_X86_64_PLTOFF64:        "R_X86_64_PLTOFF64",
+	R_X86_64_SIZE32:          "R_X86_64_SIZE32",
+	R_X86_64_SIZE64:          "R_X86_64_SIZE64",
+	R_X86_64_GOTPC32_TLSDESC: "R_X86_64_GOTPC32_TLSDESC",
+	R_X86_64_TLSDESC_CALL:    "R_X86_64_TLSDESC_CALL",
+	R_X86_64_TLSDESC:         "R_X86_64_TLSDESC",
+	R_X86_64_IRELATIVE:       "R_X86_64_IRELATIVE",
+	R_X86_64_RELATIVE64:      "R_X86_64_RELATIVE64",
+	R_X86_64_PC32_BND:        "R_X86_64_PC32_BND",
+	R_X86_64_PLT32_BND:       "R_X86_64_PLT32_BND",
+	R_X86_64_GOTPCRELX:       "R_X86_64_GOTPCRELX",
+	R_X86_64_REX_GOTPCRELX:   "R_X86_64_REX_GOTPCRELX",
+}
+
+func (rt RelocationTypeAMD64) Value() uint32 {
+	return uint32(rt)
+}
+
+func (rt RelocationTypeAMD64) String() string {
+	name, ok := relocationTypeAMD64Names[rt]
+	return relocationTypeName(uint32(rt), name, ok)
+}
+
+// RelocationTypeARM enumerates relocation types of ARM
+type RelocationTypeARM uint32
+
+const (
+	R_ARM_NONE               RelocationTypeARM = 0 // No relocation
+	R_ARM_PC24               RelocationTypeARM = 1
+	R_ARM_ABS32              RelocationTypeARM = 2
+	R_ARM_REL32              RelocationTypeARM = 3
+	R_ARM_PC13               RelocationTypeARM = 4
+	R_ARM_ABS16              RelocationTypeARM = 5
+	R_ARM_ABS12              RelocationTypeARM = 6
+	R_ARM_THM_ABS5           RelocationTypeARM = 7
+	R_ARM_ABS8               RelocationTypeARM = 8
+	R_ARM_SBREL32            RelocationTypeARM = 9
+	R_ARM_THM_PC22           RelocationTypeARM = 10
+	R_ARM_THM_PC8            RelocationTypeARM = 11
+	R_ARM_AMP_VCALL9         RelocationTypeARM = 12
+	R_ARM_SWI24              RelocationTypeARM = 13
+	R_ARM_THM_SWI8           RelocationTypeARM = 14
+	R_ARM_XPC25              RelocationTypeARM = 15
+	R_ARM_THM_XPC22          RelocationTypeARM = 16
+	R_ARM_TLS_DTPMOD32       RelocationTypeARM = 17
+	R_ARM_TLS_DTPOFF32       RelocationTypeARM = 18
+	R_ARM_TLS_TPOFF32        RelocationTypeARM = 19
+	R_ARM_COPY               RelocationTypeARM = 20 // Copy data from shared object
+	R_ARM_GLOB_DAT           RelocationTypeARM = 21 // Set GOT entry to data address
+	R_ARM_JUMP_SLOT          RelocationTypeARM = 22 // Set GOT entry to code address
+	R_ARM_RELATIVE           RelocationTypeARM = 23 // Add load address of shared object
+	R_ARM_GOTOFF             RelocationTypeARM = 24 // Add GOT-relative symbol address
+	R_ARM_GOTPC              RelocationTypeARM = 25 // Add PC-relative GOT table address
+	R_ARM_GOT32              RelocationTypeARM = 26 // Add PC-relative GOT offset
+	R_ARM_PLT32              RelocationTypeARM = 27 // Add PC-relative PLT offset
+	R_ARM_CALL               RelocationTypeARM = 28
+	R_ARM_JUMP24             RelocationTypeARM = 29
+	R_ARM_THM_JUMP24         RelocationTypeARM = 30
+	R_ARM_BASE_ABS           RelocationTypeARM = 31
+	R_ARM_ALU_PCREL_7_0      RelocationTypeARM = 32
+	R_ARM_ALU_PCREL_15_8     RelocationTypeARM = 33
+	R_ARM_ALU_PCREL_23_15    RelocationTypeARM = 34
+	R_ARM_LDR_SBREL_11_10_NC RelocationTypeARM = 35
+	R_ARM_ALU_SBREL_19_12_NC RelocationTypeARM = 36
+	R_ARM_ALU_SBREL_27_20_CK RelocationTypeARM = 37
+	R_ARM_TARGET1            RelocationTypeARM = 38
+	R_ARM_SBREL31            RelocationTypeARM = 39
+	R_ARM_V4BX               RelocationTypeARM = 40
+	R_ARM_TARGET2            RelocationTypeARM = 41
+	R_ARM_PREL31             RelocationTypeARM = 42
+	R_ARM_MOVW_ABS_NC        RelocationTypeARM = 43
+	R_ARM_MOVT_ABS           RelocationTypeARM = 44
+	R_ARM_MOVW_PREL_NC       RelocationTypeARM = 45
+	R_ARM_MOVT_PREL          RelocationTypeARM = 46
+	R_ARM_THM_MOVW_ABS_NC    RelocationTypeARM = 47
+	R_ARM_THM_MOVT_ABS       RelocationTypeARM = 48
+	R_ARM_THM_MOVW_PREL_NC   RelocationTypeARM = 49
+	R_ARM_THM_MOVT_PREL      RelocationTypeARM = 50
+	R_ARM_THM_JUMP19         RelocationTypeARM = 51
+	R_ARM_THM_JUMP6          RelocationTypeARM = 52
+	R_ARM_THM_ALU_PREL_11_0  RelocationTypeARM = 53
+	R_ARM_THM_PC12           RelocationTypeARM = 54
+	R_ARM_ABS32_NOI          RelocationTypeARM = 55
+	R_ARM_REL32_NOI          RelocationTypeARM = 56
+	R_ARM_ALU_PC_G0_NC       RelocationTypeARM = 57
+	R_ARM_ALU_PC_G0          RelocationTypeARM = 58
+	R_ARM_ALU_PC_G1_NC       RelocationTypeARM = 59
+	R_ARM_ALU_PC_G1          RelocationTypeARM = 60
+	R_ARM_ALU_PC_G2          RelocationTypeARM = 61
+	R_ARM_LDR_PC_G1          RelocationTypeARM = 62
+	R_ARM_LDR_PC_G2          RelocationTypeARM = 63
+	R_ARM_LDRS_PC_G0         RelocationTypeARM = 64
+	R_ARM_LDRS_PC_G1         RelocationTypeARM = 65
+	R_ARM_LDRS_PC_G2         RelocationTypeARM = 66
+	R_ARM_LDC_PC_G0          RelocationTypeARM = 67
+	R_ARM_LDC_PC_G1          RelocationTypeARM = 68
+	R_ARM_LDC_PC_G2          RelocationTypeARM = 69
+	R_ARM_ALU_SB_G0_NC       RelocationTypeARM = 70
+	R_ARM_ALU_SB_G0          RelocationTypeARM = 71
+	R_ARM_ALU_SB_G1_NC       RelocationTypeARM = 72
+	R_ARM_ALU_SB_G1          RelocationTypeARM = 73
+	R_ARM_ALU_SB_G2          RelocationTypeARM = 74
+	R_ARM_LDR_SB_G0          RelocationTypeARM = 75
+	R_ARM_LDR_SB_G1          RelocationTypeARM = 76
+	R_ARM_LDR_SB_G2          RelocationTypeARM = 77
+	R_ARM_LDRS_SB_G0         RelocationTypeARM = 78
+	R_ARM_LDRS_SB_G1         RelocationTypeARM = 79
+	R_ARM_LDRS_SB_G2         RelocationTypeARM = 80
+	R_ARM_LDC_SB_G0          RelocationTypeARM = 81
+	R_ARM_LDC_SB_G1          RelocationTypeARM = 82
+	R_ARM_LDC_SB_G2          RelocationTypeARM = 83
+	R_ARM_MOVW_BREL_NC       RelocationTypeARM = 84
+	R_ARM_MOVT_BREL          RelocationTypeARM = 85
+	R_ARM_MOVW_BREL          RelocationTypeARM = 86
+	R_ARM_THM_MOVW_BREL_NC   RelocationTypeARM = 87
+	R_ARM_THM_MOVT_BREL      RelocationTypeARM = 88
+	R_ARM_THM_MOVW_BREL      RelocationTypeARM = 89
+	R_ARM_TLS_GOTDESC        RelocationTypeARM = 90
+	R_ARM_TLS_CALL           RelocationTypeARM = 91
+	R_ARM_TLS_DESCSEQ        RelocationTypeARM = 92
+	R_ARM_THM_TLS_CALL       RelocationTypeARM = 93
+	R_ARM_PLT32_ABS          RelocationTypeARM = 94
+	R_ARM_GOT_ABS            RelocationTypeARM = 95
+	R_ARM_GOT_PREL           RelocationTypeARM = 96
+	R_ARM_GOT_BREL12         RelocationTypeARM = 97
+	R_ARM_GOTOFF12           RelocationTypeARM = 98
+	R_ARM_GOTRELAX           RelocationTypeARM = 99
+	R_ARM_GNU_VTENTRY        RelocationTypeARM = 100
+	R_ARM_GNU_VTINHERIT      RelocationTypeARM = 101
+	R_ARM_THM_JUMP11         RelocationTypeARM = 102
+	R_ARM_THM_JUMP8          RelocationTypeARM = 103
+	R_ARM_TLS_GD32           RelocationTypeARM = 104
+	R_ARM_TLS_LDM32          RelocationTypeARM = 105
+	R_ARM_TLS_LDO32          RelocationTypeARM = 106
+	R_ARM_TLS_IE32           RelocationTypeARM = 107
+	R_ARM_TLS_LE32           RelocationTypeARM = 108
+	R_ARM_TLS_LDO12          RelocationTypeARM = 109
+	R_ARM_TLS_LE12           RelocationTypeARM = 110
+	R_ARM_TLS_IE12GP         RelocationTypeARM = 111
+	R_ARM_PRIVATE_0          RelocationTypeARM = 112
+	R_ARM_PRIVATE_1          RelocationTypeARM = 113
+	R_ARM_PRIVATE_2          RelocationTypeARM = 114
+	R_ARM_PRIVATE_3          RelocationTypeARM = 115
+	R_ARM_PRIVATE_4          RelocationTypeARM = 116
+	R_ARM_PRIVATE_5          RelocationTypeARM = 117
+	R_ARM_PRIVATE_6          RelocationTypeARM = 118
+	R_ARM_PRIVATE_7          RelocationTypeARM = 119
+	R_ARM_PRIVATE_8          RelocationTypeARM = 120
+	R_ARM_PRIVATE_9          RelocationTypeARM = 121
+	R_ARM_PRIVATE_10         RelocationTypeARM = 122
+	R_ARM_PRIVATE_11         RelocationTypeARM = 123
+	R_ARM_PRIVATE_12         RelocationTypeARM = 124
+	R_ARM_PRIVATE_13         RelocationTypeARM = 125
+	R_ARM_PRIVATE_14         RelocationTypeARM = 126
+	R_ARM_PRIVATE_15         RelocationTypeARM = 127
+	R_ARM_ME_TOO             RelocationTypeARM = 128
+	R_ARM_THM_TLS_DESCSEQ16  RelocationTypeARM = 129
+	R_ARM_THM_TLS_DESCSEQ32  RelocationTypeARM = 130
+	R_ARM_THM_GOT_BREL12     RelocationTypeARM = 131
+	R_ARM_THM_ALU_ABS_G0_NC  RelocationTypeARM = 132
+	R_ARM_THM_ALU_ABS_G1_NC  RelocationTypeARM = 133
+	R_ARM_THM_ALU_ABS_G2_NC  RelocationTypeARM = 134
+	R_ARM_THM_ALU_ABS_G3     RelocationTypeARM = 135
+	R_ARM_IRELATIVE          RelocationTypeARM = 160
+	R_ARM_RXPC25             RelocationTypeARM = 249
+	R_ARM_RSBREL32           RelocationTypeARM = 250
+	R_ARM_THM_RPC22          RelocationTypeARM = 251
+	R_ARM_RREL32             RelocationTypeARM = 252
+	R_ARM_RABS32             RelocationTypeARM = 253
+	R_ARM_RPC24              RelocationTypeARM = 254
+	R_ARM_RBASE              RelocationTypeARM = 255
+)
+
+var relocationTypeARMNames = map[RelocationTypeARM]string{
+	R_ARM_NONE:               "R_ARM_NONE",
+	R_ARM_PC24:               "R_ARM_PC24",
+	R_ARM_ABS32:              "R_ARM_ABS32",
+	R_ARM_REL32:              "R_ARM_REL32",
+	R_ARM_PC13:               "R_ARM_PC13",
+	R_ARM_ABS16:              "R_ARM_ABS16",
+	R_ARM_ABS12:              "R_ARM_ABS12",
+	R_ARM_THM_ABS5:           "R_ARM_THM_ABS5",
+	R_ARM_ABS8:               "R_ARM_ABS8",
+	R_ARM_SBREL32:            "R_ARM_SBREL32",
+	R_ARM_THM_PC22:           "R_ARM_THM_PC22",
+	R_ARM_THM_PC8:            "R_ARM_THM_PC8",
+	R_ARM_AMP_VCALL9:         "R_ARM_AMP_VCALL9",
+	R_ARM_SWI24:              "R_ARM_SWI24",
+	R_ARM_THM_SWI8:           "R_ARM_THM_SWI8",
+	R_ARM_XPC25:              "R_ARM_XPC25",
+	R_ARM_THM_XPC22:          "R_ARM_THM_XPC22",
+	R_ARM_TLS_DTPMOD32:       "R_ARM_TLS_DTPMOD32",
+	R_ARM_TLS_DTPOFF32:       "R_ARM_TLS_DTPOFF32",
+	R_ARM_TLS_TPOFF32:        "R_ARM_TLS_TPOFF32",
+	R_ARM_COPY:               "R_ARM_COPY",
+	R_ARM_GLOB_DAT:           "R_ARM_GLOB_DAT",
+	R_ARM_JUMP_SLOT:          "R_ARM_JUMP_SLOT",
+	R_ARM_RELATIVE:           "R_ARM_RELATIVE",
+	R_ARM_GOTOFF:             "R_ARM_GOTOFF",
+	R_ARM_GOTPC:              "R_ARM_GOTPC",
+	R_ARM_GOT32:              "R_ARM_GOT32",
+	R_ARM_PLT32:              "R_ARM_PLT32",
+	R_ARM_CALL:               "R_ARM_CALL",
+	R_ARM_JUMP24:             "R_ARM_JUMP24",
+	R_ARM_THM_JUMP24:         "R_ARM_THM_JUMP24",
+	R_ARM_BASE_ABS:           "R_ARM_BASE_ABS",
+	R_ARM_ALU_PCREL_7_0:      "R_ARM_ALU_PCREL_7_0",
+	R_ARM_ALU_PCREL_15_8:     "R_ARM_ALU_PCREL_15_8",
+	R_ARM_ALU_PCREL_23_15:    "R_ARM_ALU_PCREL_23_15",
+	R_ARM_LDR_SBREL_11_10_NC: "R_ARM_LDR_SBREL_11_10_NC",
+	R_ARM_ALU_SBREL_19_12_NC: "R_ARM_ALU_SBREL_19_12_NC",
+	R_ARM_ALU_SBREL_27_20_CK: "R_ARM_ALU_SBREL_27_20_CK",
+	R_ARM_TARGET1:            "R_ARM_TARGET1",
+	R_ARM_SBREL31:            "R_ARM_SBREL31",
+	R_ARM_V4BX:               "R_ARM_V4BX",
+	R_ARM_TARGET2:            "R_ARM_TARGET2",
+	R_ARM_PREL31:             "R_ARM_PREL31",
+	R_ARM_MOVW_ABS_NC:        "R_ARM_MOVW_ABS_NC",
+	R_ARM_MOVT_ABS:           "R_ARM_MOVT_ABS",
+	R_ARM_MOVW_PREL_NC:       "R_ARM_MOVW_PREL_NC",
+	R_ARM_MOVT_PREL:          "R_ARM_MOVT_PREL",
+	R_ARM_THM_MOVW_ABS_NC:    "R_ARM_THM_MOVW_ABS_NC",
+	R_ARM_THM_MOVT_ABS:       "R_ARM_THM_MOVT_ABS",
+	R_ARM_THM_MOVW_PREL_NC:   "R_ARM_THM_MOVW_PREL_NC",
+	R_ARM_THM_MOVT_PREL:      "R_ARM_THM_MOVT_PREL",
+	R_ARM_THM_JUMP19:         "R_ARM_THM_JUMP19",
+	R_ARM_THM_JUMP6:          "R_ARM_THM_JUMP6",
+	R_ARM_THM_ALU_PREL_11_0:  "R_ARM_THM_ALU_PREL_11_0",
+	R_ARM_THM_PC12:           "R_ARM_THM_PC12",
+	R_ARM_ABS32_NOI:          "R_ARM_ABS32_NOI",
+	R_ARM_REL32_NOI:          "R_ARM_REL32_NOI",
+	R_ARM_ALU_PC_G0_NC:       "R_ARM_ALU_PC_G0_NC",
+	R_ARM_ALU_PC_G0:          "R_ARM_ALU_PC_G0",
+	R_ARM_ALU_PC_G1_NC:       "R_ARM_ALU_PC_G1_NC",
+	R_ARM_ALU_PC_G1:          "R_ARM_ALU_PC_G1",
+	R_ARM_ALU_PC_G2:          "R_ARM_ALU_PC_G2",
+	R_ARM_LDR_PC_G1:          "R_ARM_LDR_PC_G1",
+	R_ARM_LDR_PC_G2:          "R_ARM_LDR_PC_G2",
+	R_ARM_LDRS_PC_G0:         "R_ARM_LDRS_PC_G0",
+	R_ARM_LDRS_PC_G1:         "R_ARM_LDRS_PC_G1",
+	R_ARM_LDRS_PC_G2:         "R_ARM_LDRS_PC_G2",
+	R_ARM_LDC_PC_G0:          "R_ARM_LDC_PC_G0",
+	R_ARM_LDC_PC_G1:          "R_ARM_LDC_PC_G1",
+	R_ARM_LDC_PC_G2:          "R_ARM_LDC_PC_G2",
+	R_ARM_ALU_SB_G0_NC:       "R_ARM_ALU_SB_G0_NC",
+	R_ARM_ALU_SB_G0:          "R_ARM_ALU_SB_G0",
+	R_ARM_ALU_SB_G1_NC:       "R_ARM_ALU_SB_G1_NC",
+	R_ARM_ALU_SB_G1:          "R_ARM_ALU_SB_G1",
+	R_ARM_ALU_SB_G2:          "R_ARM_ALU_SB_G2",
+	R_ARM_LDR_SB_G0:          "R_ARM_LDR_SB_G0",
+	R_ARM_LDR_SB_G1:          "R_ARM_LDR_SB_G1",
+	R_ARM_LDR_SB_G2:          "R_ARM_LDR_SB_G2",
+	R_ARM_LDRS_SB_G0:         "R_ARM_LDRS_SB_G0",
+	R_ARM_LDRS_SB_G1:         "R_ARM_LDRS_SB_G1",
+	R_ARM_LDRS_SB_G2:         "R_ARM_LDRS_SB_G2",
+	R_ARM_LDC_SB_G0:          "R_ARM_LDC_SB_G0",
+	R_ARM_LDC_SB_G1:          "R_ARM_LDC_SB_G1",
+	R_ARM_LDC_SB_G2:          "R_ARM_LDC_SB_G2",
+	R_ARM_MOVW_BREL_NC:       "R_ARM_MOVW_BREL_NC",
+	R_ARM_MOVT_BREL:          "R_ARM_MOVT_BREL",
+	R_ARM_MOVW_BREL:          "R_ARM_MOVW_BREL",
+	R_ARM_THM_MOVW_BREL_NC:   "R_ARM_THM_MOVW_BREL_NC",
+	R_ARM_THM_MOVT_BREL:      "R_ARM_THM_MOVT_BREL",
+	R_ARM_THM_MOVW_BREL:      "R_ARM_THM_MOVW_BREL",
+	R_ARM_TLS_GOTDESC:        "R_ARM_TLS_GOTDESC",
+	R_ARM_TLS_CALL:           "R_ARM_TLS_CALL",
+	R_ARM_TLS_DESCSEQ:        "R_ARM_TLS_DESCSEQ",
+	R_ARM_THM_TLS_CALL:       "R_ARM_THM_TLS_CALL",
+	R_ARM_PLT32_ABS:          "R_ARM_PLT32_ABS",
+	R_ARM_GOT_ABS:            "R_ARM_GOT_ABS",
+	R_ARM_GOT_PREL:           "R_ARM_GOT_PREL",
+	R_ARM_GOT_BREL12:         "R_ARM_GOT_BREL12",
+	R_ARM_GOTOFF12:           "R_ARM_GOTOFF12",
+	R_ARM_GOTRELAX:           "R_ARM_GOTRELAX",
+	R_ARM_GNU_VTENTRY:        "R_ARM_GNU_VTENTRY",
+	R_ARM_GNU_VTINHERIT:      "R_ARM_GNU_VTINHERIT",
+	R_ARM_THM_JUMP11:         "R_ARM_THM_JUMP11",
+	R_ARM_THM_JUMP8:          "R_ARM_THM_JUMP8",
+	R_ARM_TLS_GD32:           "R_ARM_TLS_GD32",
+	R_ARM_TLS_LDM32:          "R_ARM_TLS_LDM32",
+	R_ARM_TLS_LDO32:          "R_ARM_TLS_LDO32",
+	R_ARM_TLS_IE32:           "R_ARM_TLS_IE32",
+	R_ARM_TLS_LE32:           "R_ARM_TLS_LE32",
+	R_ARM_TLS_LDO12:          "R_ARM_TLS_LDO12",
+	R_ARM_TLS_LE12:           "R_ARM_TLS_LE12",
+	R_ARM_TLS_IE12GP:         "R_ARM_TLS_IE12GP",
+	R_ARM_PRIVATE_0:          "R_ARM_PRIVATE_0",
+	R_ARM_PRIVATE_1:          "R_ARM_PRIVATE_1",
+	R_ARM_PRIVATE_2:          "R_ARM_PRIVATE_2",
+	R_ARM_PRIVATE_3:          "R_ARM_PRIVATE_3",
+	R_ARM_PRIVATE_4:          "R_ARM_PRIVATE_4",
+	R_ARM_PRIVATE_5:          "R_ARM_PRIVATE_5",
+	R_ARM_PRIVATE_6:          "R_ARM_PRIVATE_6",
+	R_ARM_PRIVATE_7:          "R_ARM_PRIVATE_7",
+	R_ARM_PRIVATE_8:          "R_ARM_PRIVATE_8",
+	R_ARM_PRIVATE_9:          "R_ARM_PRIVATE_9",
+	R_ARM_PRIVATE_10:         "R_ARM_PRIVATE_10",
+	R_ARM_PRIVATE_11:         "R_ARM_PRIVATE_11",
+	R_ARM_PRIVATE_12:         "R_ARM_PRIVATE_12",
+	R_ARM_PRIVATE_13:         "R_ARM_PRIVATE_13",
+	R_ARM_PRIVATE_14:         "R_ARM_PRIVATE_14",
+	R_ARM_PRIVATE_15:         "R_ARM_PRIVATE_15",
+	R_ARM_ME_TOO:             "R_ARM_ME_TOO",
+	R_ARM_THM_TLS_DESCSEQ16:  "R_ARM_THM_TLS_DESCSEQ16",
+	R_ARM_THM_TLS_DESCSEQ32:  "R_ARM_THM_TLS_DESCSEQ32",
+	R_ARM_THM_GOT_BREL12:     "R_ARM_THM_GOT_BREL12",
+	R_ARM_THM_ALU_ABS_G0_NC:  "R_ARM_THM_ALU_ABS_G0_NC",
+	R_ARM_THM_ALU_ABS_G1_NC:  "R_ARM_THM_ALU_ABS_G1_NC",
+	R_ARM_THM_ALU_ABS_G2_NC:  "R_ARM_THM_ALU_ABS_G2_NC",
+	R_ARM_THM_ALU_ABS_G3:     "R_ARM_THM_ALU_ABS_G3",
+	R_ARM_IRELATIVE:          "R_ARM_IRELATIVE",
+	R_ARM_RXPC25:             "R_ARM_RXPC25",
+	R_ARM_RSBREL32:           "R_ARM_RSBREL32",
+	R_ARM_THM_RPC22:          "R_ARM_THM_RPC22",
+	R_ARM_RREL32:             "R_ARM_RREL32",
+	R_ARM_RABS32:             "R_ARM_RABS32",
+	R_ARM_RPC24:              "R_ARM_RPC24",
+	R_ARM_RBASE:              "R_ARM_RBASE",
+}
+
+func (rt RelocationTypeARM) Value() uint32 {
+	return uint32(rt)
+}
+
+func (rt RelocationTypeARM) String() string {
+	name, ok := relocationTypeARMNames[rt]
+	return relocationTypeName(uint32(rt), name, ok)
+}
+
+// RelocationTypeAArch64 enumerates relocation types of AArch64
+type RelocationTypeAArch64 uint32
+
+const (
+	R_AARCH64_NONE                            RelocationTypeAArch64 = 0
+	R_AARCH64_P32_ABS32                       RelocationTypeAArch64 = 1
+	R_AARCH64_P32_ABS16                       RelocationTypeAArch64 = 2
+	R_AARCH64_P32_PREL32                      RelocationTypeAArch64 = 3
+	R_AARCH64_P32_PREL16                      RelocationTypeAArch64 = 4
+	R_AARCH64_P32_MOVW_UABS_G0                RelocationTypeAArch64 = 5
+	R_AARCH64_P32_MOVW_UABS_G0_NC             RelocationTypeAArch64 = 6
+	R_AARCH64_P32_MOVW_UABS_G1                RelocationTypeAArch64 = 7
+	R_AARCH64_P32_MOVW_SABS_G0                RelocationTypeAArch64 = 8
+	R_AARCH64_P32_LD_PREL_LO19                RelocationTypeAArch64 = 9
+	R_AARCH64_P32_ADR_PREL_LO21               RelocationTypeAArch64 = 10
+	R_AARCH64_P32_ADR_PREL_PG_HI21            RelocationTypeAArch64 = 11
+	R_AARCH64_P32_ADD_ABS_LO12_NC             RelocationTypeAArch64 = 12
+	R_AARCH64_P32_LDST8_ABS_LO12_NC           RelocationTypeAArch64 = 13
+	R_AARCH64_P32_LDST16_ABS_LO12_NC          RelocationTypeAArch64 = 14
+	R_AARCH64_P32_LDST32_ABS_LO12_NC          RelocationTypeAArch64 = 15
+	R_AARCH64_P32_LDST64_ABS_LO12_NC          RelocationTypeAArch64 = 16
+	R_AARCH64_P32_LDST128_ABS_LO12_NC         RelocationTypeAArch64 = 17
+	R_AARCH64_P32_TSTBR14                     RelocationTypeAArch64 = 18
+	R_AARCH64_P32_CONDBR19                    RelocationTypeAArch64 = 19
+	R_AARCH64_P32_JUMP26                      RelocationTypeAArch64 = 20
+	R_AARCH64_P32_CALL26                      RelocationTypeAArch64 = 21
+	R_AARCH64_P32_GOT_LD_PREL19               RelocationTypeAArch64 = 25
+	R_AARCH64_P32_ADR_GOT_PAGE                RelocationTypeAArch64 = 26
+	R_AARCH64_P32_LD32_GOT_LO12_NC            RelocationTypeAArch64 = 27
+	R_AARCH64_P32_TLSGD_ADR_PAGE21            RelocationTypeAArch64 = 81
+	R_AARCH64_P32_TLSGD_ADD_LO12_NC           RelocationTypeAArch64 = 82
+	R_AARCH64_P32_TLSIE_ADR_GOTTPREL_PAGE21   RelocationTypeAArch64 = 103
+	R_AARCH64_P32_TLSIE_LD32_GOTTPREL_LO12_NC RelocationTypeAArch64 = 104
+	R_AARCH64_P32_TLSIE_LD_GOTTPREL_PREL19    RelocationTypeAArch64 = 105
+	R_AARCH64_P32_TLSLE_MOVW_TPREL_G1         RelocationTypeAArch64 = 106
+	R_AARCH64_P32_TLSLE_MOVW_TPREL_G0         RelocationTypeAArch64 = 107
+	R_AARCH64_P32_TLSLE_MOVW_TPREL_G0_NC      RelocationTypeAArch64 = 108
+	R_AARCH64_P32_TLSLE_ADD_TPREL_HI12        RelocationTypeAArch64 = 109
+	R_AARCH64_P32_TLSLE_ADD_TPREL_LO12        RelocationTypeAArch64 = 110
+	R_AARCH64_P32_TLSLE_ADD_TPREL_LO12_NC     RelocationTypeAArch64 = 111
+	R_AARCH64_P32_TLSDESC_LD_PREL19           RelocationTypeAArch64 = 122
+	R_AARCH64_P32_TLSDESC_ADR_PREL21          RelocationTypeAArch64 = 123
+	R_AARCH64_P32_TLSDESC_ADR_PAGE21          RelocationTypeAArch64 = 124
+	R_AARCH64_P32_TLSDESC_LD32_LO12_NC        RelocationTypeAArch64 = 125
+	R_AARCH64_P32_TLSDESC_ADD_LO12_NC         RelocationTypeAArch64 = 126
+	R_AARCH64_P32_TLSDESC_CALL                RelocationTypeAArch64 = 127
+	R_AARCH64_P32_COPY                        RelocationTypeAArch64 = 180
+	R_AARCH64_P32_GLOB_DAT                    RelocationTypeAArch64 = 181
+	R_AARCH64_P32_JUMP_SLOT                   RelocationTypeAArch64 = 182
+	R_AARCH64_P32_RELATIVE                    RelocationTypeAArch64 = 183
+	R_AARCH64_P32_TLS_DTPMOD                  RelocationTypeAArch64 = 184
+	R_AARCH64_P32_TLS_DTPREL                  RelocationTypeAArch64 = 185
+	R_AARCH64_P32_TLS_TPREL                   RelocationTypeAArch64 = 186
+	R_AARCH64_P32_TLSDESC                     RelocationTypeAArch64 = 187
+	R_AARCH64_P32_IRELATIVE                   RelocationTypeAArch64 = 188
+	R_AARCH64_NULL                            RelocationTypeAArch64 = 256
+	R_AARCH64_ABS64                           RelocationTypeAArch64 = 257
+	R_AARCH64_ABS32                           RelocationTypeAArch64 = 258
+	R_AARCH64_ABS16                           RelocationTypeAArch64 = 259
+	R_AARCH64_PREL64                          RelocationTypeAArch64 = 260
+	R_AARCH64_PREL32                          RelocationTypeAArch64 = 261
+	R_AARCH64_PREL16                          RelocationTypeAArch64 = 262
+	R_AARCH64_MOVW_UABS_G0                    RelocationTypeAArch64 = 263
+	R_AARCH64_MOVW_UABS_G0_NC                 RelocationTypeAArch64 = 264
+	R_AARCH64_MOVW_UABS_G1                    RelocationTypeAArch64 = 265
+	R_AARCH64_MOVW_UABS_G1_NC                 RelocationTypeAArch64 = 266
+	R_AARCH64_MOVW_UABS_G2                    RelocationTypeAArch64 = 267
+	R_AARCH64_MOVW_UABS_G2_NC                 RelocationTypeAArch64 = 268
+	R_AARCH64_MOVW_UABS_G3                    RelocationTypeAArch64 = 269
+	R_AARCH64_MOVW_SABS_G0                    RelocationTypeAArch64 = 270
+	R_AARCH64_MOVW_SABS_G1                    RelocationTypeAArch64 = 271
+	R_AARCH64_MOVW_SABS_G2                    RelocationTypeAArch64 = 272
+	R_AARCH64_LD_PREL_LO19                    RelocationTypeAArch64 = 273
+	R_AARCH64_ADR_PREL_LO21                   RelocationTypeAArch64 = 274
+	R_AARCH64_ADR_PREL_PG_HI21                RelocationTypeAArch64 = 275
+	R_AARCH64_ADR_PREL_PG_HI21_NC             RelocationTypeAArch64 = 276
+	R_AARCH64_ADD_ABS_LO12_NC                 RelocationTypeAArch64 = 277
+	R_AARCH64_LDST8_ABS_LO12_NC               RelocationTypeAArch64 = 278
+	R_AARCH64_TSTBR14                         RelocationTypeAArch64 = 279
+	R_AARCH64_CONDBR19                        RelocationTypeAArch64 = 280
+	R_AARCH64_JUMP26                          RelocationTypeAArch64 = 282
+	R_AARCH64_CALL26                          RelocationTypeAArch64 = 283
+	R_AARCH64_LDST16_ABS_LO12_NC              RelocationTypeAArch64 = 284
+	R_AARCH64_LDST32_ABS_LO12_NC              RelocationTypeAArch64 = 285
+	R_AARCH64_LDST64_ABS_LO12_NC              RelocationTypeAArch64 = 286
+	R_AARCH64_LDST128_ABS_LO12_NC             RelocationTypeAArch64 = 299
+	R_AARCH64_GOT_LD_PREL19                   RelocationTypeAArch64 = 309
+	R_AARCH64_LD64_GOTOFF_LO15                RelocationTypeAArch64 = 310
+	R_AARCH64_ADR_GOT_PAGE                    RelocationTypeAArch64 = 311
+	R_AARCH64_LD64_GOT_LO12_NC                RelocationTypeAArch64 = 312
+	R_AARCH64_LD64_GOTPAGE_LO15               RelocationTypeAArch64 = 313
+	R_AARCH64_TLSGD_ADR_PREL21                RelocationTypeAArch64 = 512
+	R_AARCH64_TLSGD_ADR_PAGE21                RelocationTypeAArch64 = 513
+	R_AARCH64_TLSGD_ADD_LO12_NC               RelocationTypeAArch64 = 514
+	R_AARCH64_TLSGD_MOVW_G1                   RelocationTypeAArch64 = 515
+	R_AARCH64_TLSGD_MOVW_G0_NC                RelocationTypeAArch64 = 516
+	R_AARCH64_TLSLD_ADR_PREL21                RelocationTypeAArch64 = 517
+	R_AARCH64_TLSLD_ADR_PAGE21                RelocationTypeAArch64 = 518
+	R_AARCH64_TLSIE_MOVW_GOTTPREL_G1          RelocationTypeAArch64 = 539
+	R_AARCH64_TLSIE_MOVW_GOTTPREL_G0_NC       RelocationTypeAArch64 = 540
+	R_AARCH64_TLSIE_ADR_GOTTPREL_PAGE21       RelocationTypeAArch64 = 541
+	R_AARCH64_TLSIE_LD64_GOTTPREL_LO12_NC     RelocationTypeAArch64 = 542
+	R_AARCH64_TLSIE_LD_GOTTPREL_PREL19        RelocationTypeAArch64 = 543
+	R_AARCH64_TLSLE_MOVW_TPREL_G2             RelocationTypeAArch64 = 544
+	R_AARCH64_TLSLE_MOVW_TPREL_G1             RelocationTypeAArch64 = 545
+	R_AARCH64_TLSLE_MOVW_TPREL_G1_NC          RelocationTypeAArch64 = 546
+	R_AARCH64_TLSLE_MOVW_TPREL_G0             RelocationTypeAArch64 = 547
+	R_AARCH64_TLSLE_MOVW_TPREL_G0_NC          RelocationTypeAArch64 = 548
+	R_AARCH64_TLSLE_ADD_TPREL_HI12            RelocationTypeAArch64 = 549
+	R_AARCH64_TLSLE_ADD_TPREL_LO12            RelocationTypeAArch64 = 550
+	R_AARCH64_TLSLE_ADD_TPREL_LO12_NC         RelocationTypeAArch64 = 551
+	R_AARCH64_TLSDESC_LD_PREL19               RelocationTypeAArch64 = 560
+	R_AARCH64_TLSDESC_ADR_PREL21              RelocationTypeAArch64 = 561
+	R_AARCH64_TLSDESC_ADR_PAGE21              RelocationTypeAArch64 = 562
+	R_AARCH64_TLSDESC_LD64_LO12_NC            RelocationTypeAArch64 = 563
+	R_AARCH64_TLSDESC_ADD_LO12_NC             RelocationTypeAArch64 = 564
+	R_AARCH64_TLSDESC_OFF_G1                  RelocationTypeAArch64 = 565
+	R_AARCH64_TLSDESC_OFF_G0_NC               RelocationTypeAArch64 = 566
+	R_AARCH64_TLSDESC_LDR                     RelocationTypeAArch64 = 567
+	R_AARCH64_TLSDESC_ADD                     RelocationTypeAArch64 = 568
+	R_AARCH64_TLSDESC_CALL                    RelocationTypeAArch64 = 569
+	R_AARCH64_TLSLE_LDST128_TPREL_LO12        RelocationTypeAArch64 = 570
+	R_AARCH64_TLSLE_LDST128_TPREL_LO12_NC     RelocationTypeAArch64 = 571
+	R_AARCH64_TLSLD_LDST128_DTPREL_LO12       RelocationTypeAArch64 = 572
+	R_AARCH64_TLSLD_LDST128_DTPREL_LO12_NC    RelocationTypeAArch64 = 573
+	R_AARCH64_COPY                            RelocationTypeAArch64 = 1024
+	R_AARCH64_GLOB_DAT                        RelocationTypeAArch64 = 1025
+	R_AARCH64_JUMP_SLOT                       RelocationTypeAArch64 = 1026
+	R_AARCH64_RELATIVE                        RelocationTypeAArch64 = 1027
+	R_AARCH64_TLS_DTPMOD64                    RelocationTypeAArch64 = 1028
+	R_AARCH64_TLS_DTPREL64                    RelocationTypeAArch64 = 1029
+	R_AARCH64_TLS_TPREL64                     RelocationTypeAArch64 = 1030
+	R_AARCH64_TLSDESC                         RelocationTypeAArch64 = 1031
+	R_AARCH64_IRELATIVE                       RelocationTypeAArch64 = 1032
+)
+
+var relocationTypeAArch64Names = map[RelocationTypeAArch64]string{
+	R_AARCH64_NONE:                            "R_AARCH64_NONE",
+	R_AARCH64_P32_ABS32:                       "R_AARCH64_P32_ABS32",
+	R_AARCH64_P32_ABS16:                       "R_AARCH64_P32_ABS16",
+	R_AARCH64_P32_PREL32:                      "R_AARCH64_P32_PREL32",
+	R_AARCH64_P32_PREL16:                      "R_AARCH64_P32_PREL16",
+	R_AARCH64_P32_MOVW_UABS_G0:                "R_AARCH64_P32_MOVW_UABS_G0",
+	R_AARCH64_P32_MOVW_UABS_G0_NC:             "R_AARCH64_P32_MOVW_UABS_G0_NC",
+	R_AARCH64_P32_MOVW_UABS_G1:                "R_AARCH64_P32_MOVW_UABS_G1",
+	R_AARCH64_P32_MOVW_SABS_G0:                "R_AARCH64_P32_MOVW_SABS_G0",
+	R_AARCH64_P32_LD_PREL_LO19:                "R_AARCH64_P32_LD_PREL_LO19",
+	R_AARCH64_P32_ADR_PREL_LO21:               "R_AARCH64_P32_ADR_PREL_LO21",
+	R_AARCH64_P32_ADR_PREL_PG_HI21:            "R_AARCH64_P32_ADR_PREL_PG_HI21",
+	R_AARCH64_P32_ADD_ABS_LO12_NC:             "R_AARCH64_P32_ADD_ABS_LO12_NC",
+	R_AARCH64_P32_LDST8_ABS_LO12_NC:           "R_AARCH64_P32_LDST8_ABS_LO12_NC",
+	R_AARCH64_P32_LDST16_ABS_LO12_NC:          "R_AARCH64_P32_LDST16_ABS_LO12_NC",
+	R_AARCH64_P32_LDST32_ABS_LO12_NC:          "R_AARCH64_P32_LDST32_ABS_LO12_NC",
+	R_AARCH64_P32_LDST64_ABS_LO12_NC:          "R_AARCH64_P32_LDST64_ABS_LO12_NC",
+	R_AARCH64_P32_LDST128_ABS_LO12_NC:         "R_AARCH64_P32_LDST128_ABS_LO12_NC",
+	R_AARCH64_P32_TSTBR14:                     "R_AARCH64_P32_TSTBR14",
+	R_AARCH64_P32_CONDBR19:                    "R_AARCH64_P32_CONDBR19",
+	R_AARCH64_P32_JUMP26:                      "R_AARCH64_P32_JUMP26",
+	R_AARCH64_P32_CALL26:                      "R_AARCH64_P32_CALL26",
+	R_AARCH64_P32_GOT_LD_PREL19:               "R_AARCH64_P32_GOT_LD_PREL19",
+	R_AARCH64_P32_ADR_GOT_PAGE:                "R_AARCH64_P32_ADR_GOT_PAGE",
+	R_AARCH64_P32_LD32_GOT_LO12_NC:            "R_AARCH64_P32_LD32_GOT_LO12_NC",
+	R_AARCH64_P32_TLSGD_ADR_PAGE21:            "R_AARCH64_P32_TLSGD_ADR_PAGE21",
+	R_AARCH64_P32_TLSGD_ADD_LO12_NC:           "R_AARCH64_P32_TLSGD_ADD_LO12_NC",
+	R_AARCH64_P32_TLSIE_ADR_GOTTPREL_PAGE21:   "R_AARCH64_P32_TLSIE_ADR_GOTTPREL_PAGE21",
+	R_AARCH64_P32_TLSIE_LD32_GOTTPREL_LO12_NC: "R_AARCH64_P32_TLSIE_LD32_GOTTPREL_LO12_NC",
+	R_AARCH64_P32_TLSIE_LD_GOTTPREL_PREL19:    "R_AARCH64_P32_TLSIE_LD_GOTTPREL_PREL19",
+	R_AARCH64_P32_TLSLE_MOVW_TPREL_G1:         "R_AARCH64_P32_TLSLE_MOVW_TPREL_G1",
+	R_AARCH64_P32_TLSLE_MOVW_TPREL_G0:         "R_AARCH64_P32_TLSLE_MOVW_TPREL_G0",
+	R_AARCH64_P32_TLSLE_MOVW_TPREL_G0_NC:      "R_AARCH64_P32_TLSLE_MOVW_TPREL_G0_NC",
+	R_AARCH64_P32_TLSLE_ADD_TPREL_HI12:        "R_AARCH64_P32_TLSLE_ADD_TPREL_HI12",
+	R_AARCH64_P32_TLSLE_ADD_TPREL_LO12:        "R_AARCH64_P32_TLSLE_ADD_TPREL_LO12",
+	R_AARCH64_P32_TLSLE_ADD_TPREL_LO12_NC:     "R_AARCH64_P32_TLSLE_ADD_TPREL_LO12_NC",
+	R_AARCH64_P32_TLSDESC_LD_PREL19:           "R_AARCH64_P32_TLSDESC_LD_PREL19",
+	R_AARCH64_P32_TLSDESC_ADR_PREL21:          "R_AARCH64_P32_TLSDESC_ADR_PREL21",
+	R_AARCH64_P32_TLSDESC_ADR_PAGE21:          "R_AARCH64_P32_TLSDESC_ADR_PAGE21",
+	R_AARCH64_P32_TLSDESC_LD32_LO12_NC:        "R_AARCH64_P32_TLSDESC_LD32_LO12_NC",
+	R_AARCH64_P32_TLSDESC_ADD_LO12_NC:         "R_AARCH64_P32_TLSDESC_ADD_LO12_NC",
+	R_AARCH64_P32_TLSDESC_CALL:                "R_AARCH64_P32_TLSDESC_CALL",
+	R_AARCH64_P32_COPY:                        "R_AARCH64_P32_COPY",
+	R_AARCH64_P32_GLOB_DAT:                    "R_AARCH64_P32_GLOB_DAT",
+	R_AARCH64_P32_JUMP_SLOT:                   "R_AARCH64_P32_JUMP_SLOT",
+	R_AARCH64_P32_RELATIVE:                    "R_AARCH64_P32_RELATIVE",
+	R_AARCH64_P32_TLS_DTPMOD:                  "R_AARCH64_P32_TLS_DTPMOD",
+	R_AARCH64_P32_TLS_DTPREL:                  "R_AARCH64_P32_TLS_DTPREL",
+	R_AARCH64_P32_TLS_TPREL:                   "R_AARCH64_P32_TLS_TPREL",
+	R_AARCH64_P32_TLSDESC:                     "R_AARCH64_P32_TLSDESC",
+	R_AARCH64_P32_IRELATIVE:                   "R_AARCH64_P32_IRELATIVE",
+	R_AARCH64_NULL:                            "R_AARCH64_NULL",
+	R_AARCH64_ABS64:                           "R_AARCH64_ABS64",
+	R_AARCH64_ABS32:                           "R_AARCH64_ABS32",
+	R_AARCH64_ABS16:                           "R_AARCH64_ABS16",
+	R_AARCH64_PREL64:                          "R_AARCH64_PREL64",
+	R_AARCH64_PREL32:                          "R_AARCH64_PREL32",
+	R_AARCH64_PREL16:                          "R_AARCH64_PREL16",
+	R_AARCH64_MOVW_UABS_G0:                    "R_AARCH64_MOVW_UABS_G0",
+	R_AARCH64_MOVW_UABS_G0_NC:                 "R_AARCH64_MOVW_UABS_G0_NC",
+	R_AARCH64_MOVW_UABS_G1:                    "R_AARCH64_MOVW_UABS_G1",
+	R_AARCH64_MOVW_UABS_G1_NC:                 "R_AARCH64_MOVW_UABS_G1_NC",
+	R_AARCH64_MOVW_UABS_G2:                    "R_AARCH64_MOVW_UABS_G2",
+	R_AARCH64_MOVW_UABS_G2_NC:                 "R_AARCH64_MOVW_UABS_G2_NC",
+	R_AARCH64_MOVW_UABS_G3:                    "R_AARCH64_MOVW_UABS_G3",
+	R_AARCH64_MOVW_SABS_G0:                    "R_AARCH64_MOVW_SABS_G0",
+	R_AARCH64_MOVW_SABS_G1:                    "R_AARCH64_MOVW_SABS_G1",
+	R_AARCH64_MOVW_SABS_G2:                    "R_AARCH64_MOVW_SABS_G2",
+	R_AARCH64_LD_PREL_LO19:                    "R_AARCH64_LD_PREL_LO19",
+	R_AARCH64_ADR_PREL_LO21:                   "R_AARCH64_ADR_PREL_LO21",
+	R_AARCH64_ADR_PREL_PG_HI21:                "R_AARCH64_ADR_PREL_PG_HI21",
+	R_AARCH64_ADR_PREL_PG_HI21_NC:             "R_AARCH64_ADR_PREL_PG_HI21_NC",
+	R_AARCH64_ADD_ABS_LO12_NC:                 "R_AARCH64_ADD_ABS_LO12_NC",
+	R_AARCH64_LDST8_ABS_LO12_NC:               "R_AARCH64_LDST8_ABS_LO12_NC",
+	R_AARCH64_TSTBR14:                         "R_AARCH64_TSTBR14",
+	R_AARCH64_CONDBR19:                        "R_AARCH64_CONDBR19",
+	R_AARCH64_JUMP26:                          "R_AARCH64_JUMP26",
+	R_AARCH64_CALL26:                          "R_AARCH64_CALL26",
+	R_AARCH64_LDST16_ABS_LO12_NC:              "R_AARCH64_LDST16_ABS_LO12_NC",
+	R_AARCH64_LDST32_ABS_LO12_NC:              "R_AARCH64_LDST32_ABS_LO12_NC",
+	R_AARCH64_LDST64_ABS_LO12_NC:              "R_AARCH64_LDST64_ABS_LO12_NC",
+	R_AARCH64_LDST128_ABS_LO12_NC:             "R_AARCH64_LDST128_ABS_LO12_NC",
+	R_AARCH64_GOT_LD_PREL19:                   "R_AARCH64_GOT_LD_PREL19",
+	R_AARCH64_LD64_GOTOFF_LO15:                "R_AARCH64_LD64_GOTOFF_LO15",
+	R_AARCH64_ADR_GOT_PAGE:                    "R_AARCH64_ADR_GOT_PAGE",
+	R_AARCH64_LD64_GOT_LO12_NC:                "R_AARCH64_LD64_GOT_LO12_NC",
+	R_AARCH64_LD64_GOTPAGE_LO15:               "R_AARCH64_LD64_GOTPAGE_LO15",
+	R_AARCH64_TLSGD_ADR_PREL21:                "R_AARCH64_TLSGD_ADR_PREL21",
+	R_AARCH64_TLSGD_ADR_PAGE21:                "R_AARCH64_TLSGD_ADR_PAGE21",
+	R_AARCH64_TLSGD_ADD_LO12_NC:               "R_AARCH64_TLSGD_ADD_LO12_NC",
+	R_AARCH64_TLSGD_MOVW_G1:                   "R_AARCH64_TLSGD_MOVW_G1",
+	R_AARCH64_TLSGD_MOVW_G0_NC:                "R_AARCH64_TLSGD_MOVW_G0_NC",
+	R_AARCH64_TLSLD_ADR_PREL21:                "R_AARCH64_TLSLD_ADR_PREL21",
+	R_AARCH64_TLSLD_ADR_PAGE21:                "R_AARCH64_TLSLD_ADR_PAGE21",
+	R_AARCH64_TLSIE_MOVW_GOTTPREL_G1:          "R_AARCH64_TLSIE_MOVW_GOTTPREL_G1",
+	R_AARCH64_TLSIE_MOVW_GOTTPREL_G0_NC:       "R_AARCH64_TLSIE_MOVW_GOTTPREL_G0_NC",
+	R_AARCH64_TLSIE_ADR_GOTTPREL_PAGE21:       "R_AARCH64_TLSIE_ADR_GOTTPREL_PAGE21",
+	R_AARCH64_TLSIE_LD64_GOTTPREL_LO12_NC:     "R_AARCH64_TLSIE_LD64_GOTTPREL_LO12_NC",
+	R_AARCH64_TLSIE_LD_GOTTPREL_PREL19:        "R_AARCH64_TLSIE_LD_GOTTPREL_PREL19",
+	R_AARCH64_TLSLE_MOVW_TPREL_G2:             "R_AARCH64_TLSLE_MOVW_TPREL_G2",
+	R_AARCH64_TLSLE_MOVW_TPREL_G1:             "R_AARCH64_TLSLE_MOVW_TPREL_G1",
+	R_AARCH64_TLSLE_MOVW_TPREL_G1_NC:          "R_AARCH64_TLSLE_MOVW_TPREL_G1_NC",
+	R_AARCH64_TLSLE_MOVW_TPREL_G0:             "R_AARCH64_TLSLE_MOVW_TPREL_G0",
+	R_AARCH64_TLSLE_MOVW_TPREL_G0_NC:          "R_AARCH64_TLSLE_MOVW_TPREL_G0_NC",
+	R_AARCH64_TLSLE_ADD_TPREL_HI12:            "R_AARCH64_TLSLE_ADD_TPREL_HI12",
+	R_AARCH64_TLSLE_ADD_TPREL_LO12:            "R_AARCH64_TLSLE_ADD_TPREL_LO12",
+	R_AARCH64_TLSLE_ADD_TPREL_LO12_NC:         "R_AARCH64_TLSLE_ADD_TPREL_LO12_NC",
+	R_AARCH64_TLSDESC_LD_PREL19:               "R_AARCH64_TLSDESC_LD_PREL19",
+	R_AARCH64_TLSDESC_ADR_PREL21:              "R_AARCH64_TLSDESC_ADR_PREL21",
+	R_AARCH64_TLSDESC_ADR_PAGE21:              "R_AARCH64_TLSDESC_ADR_PAGE21",
+	R_AARCH64_TLSDESC_LD64_LO12_NC:            "R_AARCH64_TLSDESC_LD64_LO12_NC",
+	R_AARCH64_TLSDESC_ADD_LO12_NC:             "R_AARCH64_TLSDESC_ADD_LO12_NC",
+	R_AARCH64_TLSDESC_OFF_G1:                  "R_AARCH64_TLSDESC_OFF_G1",
+	R_AARCH64_TLSDESC_OFF_G0_NC:               "R_AARCH64_TLSDESC_OFF_G0_NC",
+	R_AARCH64_TLSDESC_LDR:                     "R_AARCH64_TLSDESC_LDR",
+	R_AARCH64_TLSDESC_ADD:                     "R_AARCH64_TLSDESC_ADD",
+	R_AARCH64_TLSDESC_CALL:                    "R_AARCH64_TLSDESC_CALL",
+	R_AARCH64_TLSLE_LDST128_TPREL_LO12:        "R_AARCH64_TLSLE_LDST128_TPREL_LO12",
+	R_AARCH64_TLSLE_LDST128_TPREL_LO12_NC:     "R_AARCH64_TLSLE_LDST128_TPREL_LO12_NC",
+	R_AARCH64_TLSLD_LDST128_DTPREL_LO12:       "R_AARCH64_TLSLD_LDST128_DTPREL_LO12",
+	R_AARCH64_TLSLD_LDST128_DTPREL_LO12_NC:    "R_AARCH64_TLSLD_LDST128_DTPREL_LO12_NC",
+	R_AARCH64_COPY:                            "R_AARCH64_COPY",
+	R_AARCH64_GLOB_DAT:                        "R_AARCH64_GLOB_DAT",
+	R_AARCH64_JUMP_SLOT:                       "R_AARCH64_JUMP_SLOT",
+	R_AARCH64_RELATIVE:                        "R_AARCH64_RELATIVE",
+	R_AARCH64_TLS_DTPMOD64:                    "R_AARCH64_TLS_DTPMOD64",
+	R_AARCH64_TLS_DTPREL64:                    "R_AARCH64_TLS_DTPREL64",
+	R_AARCH64_TLS_TPREL64:                     "R_AARCH64_TLS_TPREL64",
+	R_AARCH64_TLSDESC:                         "R_AARCH64_TLSDESC",
+	R_AARCH64_IRELATIVE:                       "R_AARCH64_IRELATIVE",
+}
+
+func (rt RelocationTypeAArch64) Value() uint32 {
+	return uint32(rt)
+}
+
+func (rt RelocationTypeAArch64) String() string {
+	name, ok := relocationTypeAArch64Names[rt]
+	return relocationTypeName(uint32(rt), name, ok)
+}
+
+// RelocationTypePPC enumerates relocation types of PowerPC
+type RelocationTypePPC uint32
+
+const (
+	R_PPC_NONE            RelocationTypePPC = 0  // R_POWERPC_NONE
+	R_PPC_ADDR32          RelocationTypePPC = 1  // R_POWERPC_ADDR32
+	R_PPC_ADDR24          RelocationTypePPC = 2  // R_POWERPC_ADDR24
+	R_PPC_ADDR16          RelocationTypePPC = 3  // R_POWERPC_ADDR16
+	R_PPC_ADDR16_LO       RelocationTypePPC = 4  // R_POWERPC_ADDR16_LO
+	R_PPC_ADDR16_HI       RelocationTypePPC = 5  // R_POWERPC_ADDR16_HI
+	R_PPC_ADDR16_HA       RelocationTypePPC = 6  // R_POWERPC_ADDR16_HA
+	R_PPC_ADDR14          RelocationTypePPC = 7  // R_POWERPC_ADDR14
+	R_PPC_ADDR14_BRTAKEN  RelocationTypePPC = 8  // R_POWERPC_ADDR14_BRTAKEN
+	R_PPC_ADDR14_BRNTAKEN RelocationTypePPC = 9  // R_POWERPC_ADDR14_BRNTAKEN
+	R_PPC_REL24           RelocationTypePPC = 10 // R_POWERPC_REL24
+	R_PPC_REL14           RelocationTypePPC = 11 // R_POWERPC_REL14
+	R_PPC_REL14_BRTAKEN   RelocationTypePPC = 12 // R_POWERPC_REL14_BRTAKEN
+	R_PPC_REL14_BRNTAKEN  RelocationTypePPC = 13 // R_POWERPC_REL14_BRNTAKEN
+	R_PPC_GOT16           RelocationTypePPC = 14 // R_POWERPC_GOT16
+	R_PPC_GOT16_LO        RelocationTypePPC = 15 // R_POWERPC_GOT16_LO
+	R_PPC_GOT16_HI        RelocationTypePPC = 16 // R_POWERPC_GOT16_HI
+	R_PPC_GOT16_HA        RelocationTypePPC = 17 // R_POWERPC_GOT16_HA
+	R_PPC_PLTREL24        RelocationTypePPC = 18
+	R_PPC_COPY            RelocationTypePPC = 19 // R_POWERPC_COPY
+	R_PPC_GLOB_DAT        RelocationTypePPC = 20 // R_POWERPC_GLOB_DAT
+	R_PPC_JMP_SLOT        RelocationTypePPC = 21 // R_POWERPC_JMP_SLOT
+	R_PPC_RELATIVE        RelocationTypePPC = 22 // R_POWERPC_RELATIVE
+	R_PPC_LOCAL24PC       RelocationTypePPC = 23
+	R_PPC_UADDR32         RelocationTypePPC = 24 // R_POWERPC_UADDR32
+	R_PPC_UADDR16         RelocationTypePPC = 25 // R_POWERPC_UADDR16
+	R_PPC_REL32           RelocationTypePPC = 26 // R_POWERPC_REL32
+	R_PPC_PLT32           RelocationTypePPC = 27 // R_POWERPC_PLT32
+	R_PPC_PLTREL32        RelocationTypePPC = 28 // R_POWERPC_PLTREL32
+	R_PPC_PLT16_LO        RelocationTypePPC = 29 // R_POWERPC_PLT16_LO
+	R_PPC_PLT16_HI        RelocationTypePPC = 30 // R_POWERPC_PLT16_HI
+	R_PPC_PLT16_HA        RelocationTypePPC = 31 // R_POWERPC_PLT16_HA
+	R_PPC_SDAREL16        RelocationTypePPC = 32
+	R_PPC_SECTOFF         RelocationTypePPC = 33 // R_POWERPC_SECTOFF
+	R_PPC_SECTOFF_LO      RelocationTypePPC = 34 // R_POWERPC_SECTOFF_LO
+	R_PPC_SECTOFF_HI      RelocationTypePPC = 35 // R_POWERPC_SECTOFF_HI
+	R_PPC_SECTOFF_HA      RelocationTypePPC = 36 // R_POWERPC_SECTOFF_HA
+	R_PPC_TLS             RelocationTypePPC = 67 // R_POWERPC_TLS
+	R_PPC_DTPMOD32        RelocationTypePPC = 68 // R_POWERPC_DTPMOD32
+	R_PPC_TPREL16         RelocationTypePPC = 69 // R_POWERPC_TPREL16
+	R_PPC_TPREL16_LO      RelocationTypePPC = 70 // R_POWERPC_TPREL16_LO
+	R_PPC_TPREL16_HI      RelocationTypePPC = 71 // R_POWERPC_TPREL16_HI
+	R_PPC_TPREL16_HA      RelocationTypePPC = 72 // R_POWERPC_TPREL16_HA
+	R_PPC_TPREL32         RelocationTypePPC = 73 // R_POWERPC_TPREL32
+	R_PPC_DTPREL16        RelocationTypePPC = 74 // R_POWERPC_DTPREL16
+	R_PPC_DTPREL16_LO     RelocationTypePPC = 75 // R_POWERPC_DTPREL16_LO
+	R_PPC_DTPREL16_HI     RelocationTypePPC = 76 // R_POWERPC_DTPREL16_HI
+	R_PPC_DTPREL16_HA     RelocationTypePPC = 77 // R_POWERPC_DTPREL16_HA
+	R_PPC_DTPREL32        RelocationTypePPC = 78 // R_POWERPC_DTPREL32
+	R_PPC_GOT_TLSGD16     RelocationTypePPC = 79 // R_POWERPC_GOT_TLSGD16
+	R_PPC_GOT_TLSGD16_LO  RelocationTypePPC = 80 // R_POWERPC_GOT_TLSGD16_LO
+	R_PPC_GOT_TLSGD16_HI  RelocationTypePPC = 81 // R_POWERPC_GOT_TLSGD16_HI
+	R_PPC_GOT_TLSGD16_HA  RelocationTypePPC = 82 // R_POWERPC_GOT_TLSGD16_HA
+	R_PPC_GOT_TLSLD16     RelocationTypePPC = 83 // R_POWERPC_GOT_TLSLD16
+	R_PPC_GOT_TLSLD16_LO  RelocationTypePPC = 84 // R_POWERPC_GOT_TLSLD16_LO
+	R_PPC_GOT_TLSLD16_HI  RelocationTypePPC = 85 // R_POWERPC_GOT_TLSLD16_HI
+	R_PPC_GOT_TLSLD16_HA  RelocationTypePPC = 86 // R_POWERPC_GOT_TLSLD16_HA
+	R_PPC_GOT_TPREL16     RelocationTypePPC = 87 // R_POWERPC_GOT_TPREL16
+	R_PPC_GOT_TPREL16_LO  RelocationTypePPC = 88 // R_POWERPC_GOT_TPREL16_LO
+	R_PPC_GOT_TPREL16_HI  RelocationTypePPC = 89 // R_POWERPC_GOT_TPREL16_HI
+	R_PPC_GOT_TPREL16_HA  RelocationTypePPC = 90 // R_POWERPC_GOT_TPREL16_HA
+	R_PPC_EMB_NADDR32     RelocationTypePPC = 101
+	R_PPC_EMB_NADDR16     RelocationTypePPC = 102
+	R_PPC_EMB_NADDR16_LO  RelocationTypePPC = 103
+	R_PPC_EMB_NADDR16_HI  RelocationTypePPC = 104
+	R_PPC_EMB_NADDR16_HA  RelocationTypePPC = 105
+	R_PPC_EMB_SDAI16      RelocationTypePPC = 106
+	R_PPC_EMB_SDA2I16     RelocationTypePPC = 107
+	R_PPC_EMB_SDA2REL     RelocationTypePPC = 108
+	R_PPC_EMB_SDA21       RelocationTypePPC = 109
+	R_PPC_EMB_MRKREF      RelocationTypePPC = 110
+	R_PPC_EMB_RELSEC16    RelocationTypePPC = 111
+	R_PPC_EMB_RELST_LO    RelocationTypePPC = 112
+	R_PPC_EMB_RELST_HI    RelocationTypePPC = 113
+	R_PPC_EMB_RELST_HA    RelocationTypePPC = 114
+	R_PPC_EMB_BIT_FLD     RelocationTypePPC = 115
+	R_PPC_EMB_RELSDA      RelocationTypePPC = 116
+)
+
+var relocationTypePPCNames = map[RelocationTypePPC]string{
+	R_PPC_NONE:            "R_PPC_NONE",
+	R_PPC_ADDR32:          "R_PPC_ADDR32",
+	R_PPC_ADDR24:          "R_PPC_ADDR24",
+	R_PPC_ADDR16:          "R_PPC_ADDR16",
+	R_PPC_ADDR16_LO:       "R_PPC_ADDR16_LO",
+	R_PPC_ADDR16_HI:       "R_PPC_ADDR16_HI",
+	R_PPC_ADDR16_HA:       "R_PPC_ADDR16_HA",
+	R_PPC_ADDR14:          "R_PPC_ADDR14",
+	R_PPC_ADDR14_BRTAKEN:  "R_PPC_ADDR14_BRTAKEN",
+	R_PPC_ADDR14_BRNTAKEN: "R_PPC_ADDR14_BRNTAKEN",
+	R_PPC_REL24:           "R_PPC_REL24",
+	R_PPC_REL14:           "R_PPC_REL14",
+	R_PPC_REL14_BRTAKEN:   "R_PPC_REL14_BRTAKEN",
+	R_PPC_REL14_BRNTAKEN:  "R_PPC_REL14_BRNTAKEN",
+	R_PPC_GOT16:           "R_PPC_GOT16",
+	R_PPC_GOT16_LO:        "R_PPC_GOT16_LO",
+	R_PPC_GOT16_HI:        "R_PPC_GOT16_HI",
+	R_PPC_GOT16_HA:        "R_PPC_GOT16_HA",
+	R_PPC_PLTREL24:        "R_PPC_PLTREL24",
+	R_PPC_COPY:            "R_PPC_COPY",
+	R_PPC_GLOB_DAT:        "R_PPC_GLOB_DAT",
+	R_PPC_JMP_SLOT:        "R_PPC_JMP_SLOT",
+	R_PPC_RELATIVE:        "R_PPC_RELATIVE",
+	R_PPC_LOCAL24PC:       "R_PPC_LOCAL24PC",
+	R_PPC_UADDR32:         "R_PPC_UADDR32",
+	R_PPC_UADDR16:         "R_PPC_UADDR16",
+	R_PPC_REL32:           "R_PPC_REL32",
+	R_PPC_PLT32:           "R_PPC_PLT32",
+	R_PPC_PLTREL32:        "R_PPC_PLTREL32",
+	R_PPC_PLT16_LO:        "R_PPC_PLT16_LO",
+	R_PPC_PLT16_HI:        "R_PPC_PLT16_HI",
+	R_PPC_PLT16_HA:        "R_PPC_PLT16_HA",
+	R_PPC_SDAREL16:        "R_PPC_SDAREL16",
+	R_PPC_SECTOFF:         "R_PPC_SECTOFF",
+	R_PPC_SECTOFF_LO:      "R_PPC_SECTOFF_LO",
+	R_PPC_SECTOFF_HI:      "R_PPC_SECTOFF_HI",
+	R_PPC_SECTOFF_HA:      "R_PPC_SECTOFF_HA",
+	R_PPC_TLS:             "R_PPC_TLS",
+	R_PPC_DTPMOD32:        "R_PPC_DTPMOD32",
+	R_PPC_TPREL16:         "R_PPC_TPREL16",
+	R_PPC_TPREL16_LO:      "R_PPC_TPREL16_LO",
+	R_PPC_TPREL16_HI:      "R_PPC_TPREL16_HI",
+	R_PPC_TPREL16_HA:      "R_PPC_TPREL16_HA",
+	R_PPC_TPREL32:         "R_PPC_TPREL32",
+	R_PPC_DTPREL16:        "R_PPC_DTPREL16",
+	R_PPC_DTPREL16_LO:     "R_PPC_DTPREL16_LO",
+	R_PPC_DTPREL16_HI:     "R_PPC_DTPREL16_HI",
+	R_PPC_DTPREL16_HA:     "R_PPC_DTPREL16_HA",
+	R_PPC_DTPREL32:        "R_PPC_DTPREL32",
+	R_PPC_GOT_TLSGD16:     "R_PPC_GOT_TLSGD16",
+	R_PPC_GOT_TLSGD16_LO:  "R_PPC_GOT_TLSGD16_LO",
+	R_PPC_GOT_TLSGD16_HI:  "R_PPC_GOT_TLSGD16_HI",
+	R_PPC_GOT_TLSGD16_HA:  "R_PPC_GOT_TLSGD16_HA",
+	R_PPC_GOT_TLSLD16:     "R_PPC_GOT_TLSLD16",
+	R_PPC_GOT_TLSLD16_LO:  "R_PPC_GOT_TLSLD16_LO",
+	R_PPC_GOT_TLSLD16_HI:  "R_PPC_GOT_TLSLD16_HI",
+	R_PPC_GOT_TLSLD16_HA:  "R_PPC_GOT_TLSLD16_HA",
+	R_PPC_GOT_TPREL16:     "R_PPC_GOT_TPREL16",
+	R_PPC_GOT_TPREL16_LO:  "R_PPC_GOT_TPREL16_LO",
+	R_PPC_GOT_TPREL16_HI:  "R_PPC_GOT_TPREL16_HI",
+	R_PPC_GOT_TPREL16_HA:  "R_PPC_GOT_TPREL16_HA",
+	R_PPC_EMB_NADDR32:     "R_PPC_EMB_NADDR32",
+	R_PPC_EMB_NADDR16:     "R_PPC_EMB_NADDR16",
+	R_PPC_EMB_NADDR16_LO:  "R_PPC_EMB_NADDR16_LO",
+	R_PPC_EMB_NADDR16_HI:  "R_PPC_EMB_NADDR16_HI",
+	R_PPC_EMB_NADDR16_HA:  "R_PPC_EMB_NADDR16_HA",
+	R_PPC_EMB_SDAI16:      "R_PPC_EMB_SDAI16",
+	R_PPC_EMB_SDA2I16:     "R_PPC_EMB_SDA2I16",
+	R_PPC_EMB_SDA2REL:     "R_PPC_EMB_SDA2REL",
+	R_PPC_EMB_SDA21:       "R_PPC_EMB_SDA21",
+	R_PPC_EMB_MRKREF:      "R_PPC_EMB_MRKREF",
+	R_PPC_EMB_RELSEC16:    "R_PPC_EMB_RELSEC16",
+	R_PPC_EMB_RELST_LO:    "R_PPC_EMB_RELST_LO",
+	R_PPC_EMB_RELST_HI:    "R_PPC_EMB_RELST_HI",
+	R_PPC_EMB_RELST_HA:    "R_PPC_EMB_RELST_HA",
+	R_PPC_EMB_BIT_FLD:     "R_PPC_EMB_BIT_FLD",
+	R_PPC_EMB_RELSDA:      "R_PPC_EMB_RELSDA",
+}
+
+func (rt RelocationTypePPC) Value() uint32 {
+	return uint32(rt)
+}
+
+func (rt RelocationTypePPC) String() string {
+	name, ok := relocationTypePPCNames[rt]
+	return relocationTypeName(uint32(rt), name, ok)
+}
+
+// RelocationTypePPC64 enumerates relocation types of PowerPC (64-bit)
+type RelocationTypePPC64 uint32
+
+const (
+	R_PPC64_NONE               RelocationTypePPC64 = 0  // R_POWERPC_NONE
+	R_PPC64_ADDR32             RelocationTypePPC64 = 1  // R_POWERPC_ADDR32
+	R_PPC64_ADDR24             RelocationTypePPC64 = 2  // R_POWERPC_ADDR24
+	R_PPC64_ADDR16             RelocationTypePPC64 = 3  // R_POWERPC_ADDR16
+	R_PPC64_ADDR16_LO          RelocationTypePPC64 = 4  // R_POWERPC_ADDR16_LO
+	R_PPC64_ADDR16_HI          RelocationTypePPC64 = 5  // R_POWERPC_ADDR16_HI
+	R_PPC64_ADDR16_HA          RelocationTypePPC64 = 6  // R_POWERPC_ADDR16_HA
+	R_PPC64_ADDR14             RelocationTypePPC64 = 7  // R_POWERPC_ADDR14
+	R_PPC64_ADDR14_BRTAKEN     RelocationTypePPC64 = 8  // R_POWERPC_ADDR14_BRTAKEN
+	R_PPC64_ADDR14_BRNTAKEN    RelocationTypePPC64 = 9  // R_POWERPC_ADDR14_BRNTAKEN
+	R_PPC64_REL24              RelocationTypePPC64 = 10 // R_POWERPC_REL24
+	R_PPC64_REL14              RelocationTypePPC64 = 11 // R_POWERPC_REL14
+	R_PPC64_REL14_BRTAKEN      RelocationTypePPC64 = 12 // R_POWERPC_REL14_BRTAKEN
+	R_PPC64_REL14_BRNTAKEN     RelocationTypePPC64 = 13 // R_POWERPC_REL14_BRNTAKEN
+	R_PPC64_GOT16              RelocationTypePPC64 = 14 // R_POWERPC_GOT16
+	R_PPC64_GOT16_LO           RelocationTypePPC64 = 15 // R_POWERPC_GOT16_LO
+	R_PPC64_GOT16_HI           RelocationTypePPC64 = 16 // R_POWERPC_GOT16_HI
+	R_PPC64_GOT16_HA           RelocationTypePPC64 = 17 // R_POWERPC_GOT16_HA
+	R_PPC64_COPY               RelocationTypePPC64 = 19 // R_POWERPC_COPY
+	R_PPC64_GLOB_DAT           RelocationTypePPC64 = 20 // R_POWERPC_GLOB_DAT
+	R_PPC64_JMP_SLOT           RelocationTypePPC64 = 21 // R_POWERPC_JMP_SLOT
+	R_PPC64_RELATIVE           RelocationTypePPC64 = 22 // R_POWERPC_RELATIVE
+	R_PPC64_UADDR32            RelocationTypePPC64 = 24 // R_POWERPC_UADDR32
+	R_PPC64_UADDR16            RelocationTypePPC64 = 25 // R_POWERPC_UADDR16
+	R_PPC64_REL32              RelocationTypePPC64 = 26 // R_POWERPC_REL32
+	R_PPC64_PLT32              RelocationTypePPC64 = 27 // R_POWERPC_PLT32
+	R_PPC64_PLTREL32           RelocationTypePPC64 = 28 // R_POWERPC_PLTREL32
+	R_PPC64_PLT16_LO           RelocationTypePPC64 = 29 // R_POWERPC_PLT16_LO
+	R_PPC64_PLT16_HI           RelocationTypePPC64 = 30 // R_POWERPC_PLT16_HI
+	R_PPC64_PLT16_HA           RelocationTypePPC64 = 31 // R_POWERPC_PLT16_HA
+	R_PPC64_SECTOFF            RelocationTypePPC64 = 33 // R_POWERPC_SECTOFF
+	R_PPC64_SECTOFF_LO         RelocationTypePPC64 = 34 // R_POWERPC_SECTOFF_LO
+	R_PPC64_SECTOFF_HI         RelocationTypePPC64 = 35 // R_POWERPC_SECTOFF_HI
+	R_PPC64_SECTOFF_HA         RelocationTypePPC64 = 36 // R_POWERPC_SECTOFF_HA
+	R_PPC64_REL30              RelocationTypePPC64 = 37 // R_POWERPC_ADDR30
+	R_PPC64_ADDR64             RelocationTypePPC64 = 38
+	R_PPC64_ADDR16_HIGHER      RelocationTypePPC64 = 39
+	R_PPC64_ADDR16_HIGHERA     RelocationTypePPC64 = 40
+	R_PPC64_ADDR16_HIGHEST     RelocationTypePPC64 = 41
+	R_PPC64_ADDR16_HIGHESTA    RelocationTypePPC64 = 42
+	R_PPC64_UADDR64            RelocationTypePPC64 = 43
+	R_PPC64_REL64              RelocationTypePPC64 = 44
+	R_PPC64_PLT64              RelocationTypePPC64 = 45
+	R_PPC64_PLTREL64           RelocationTypePPC64 = 46
+	R_PPC64_TOC16              RelocationTypePPC64 = 47
+	R_PPC64_TOC16_LO           RelocationTypePPC64 = 48
+	R_PPC64_TOC16_HI           RelocationTypePPC64 = 49
+	R_PPC64_TOC16_HA           RelocationTypePPC64 = 50
+	R_PPC64_TOC                RelocationTypePPC64 = 51
+	R_PPC64_PLTGOT16           RelocationTypePPC64 = 52
+	R_PPC64_PLTGOT16_LO        RelocationTypePPC64 = 53
+	R_PPC64_PLTGOT16_HI        RelocationTypePPC64 = 54
+	R_PPC64_PLTGOT16_HA        RelocationTypePPC64 = 55
+	R_PPC64_ADDR16_DS          RelocationTypePPC64 = 56
+	R_PPC64_ADDR16_LO_DS       RelocationTypePPC64 = 57
+	R_PPC64_GOT16_DS           RelocationTypePPC64 = 58
+	R_PPC64_GOT16_LO_DS        RelocationTypePPC64 = 59
+	R_PPC64_PLT16_LO_DS        RelocationTypePPC64 = 60
+	R_PPC64_SECTOFF_DS         RelocationTypePPC64 = 61
+	R_PPC64_SECTOFF_LO_DS      RelocationTypePPC64 = 62
+	R_PPC64_TOC16_DS           RelocationTypePPC64 = 63
+	R_PPC64_TOC16_LO_DS        RelocationTypePPC64 = 64
+	R_PPC64_PLTGOT16_DS        RelocationTypePPC64 = 65
+	R_PPC64_PLTGOT_LO_DS       RelocationTypePPC64 = 66
+	R_PPC64_TLS                RelocationTypePPC64 = 67 // R_POWERPC_TLS
+	R_PPC64_DTPMOD64           RelocationTypePPC64 = 68 // R_POWERPC_DTPMOD64
+	R_PPC64_TPREL16            RelocationTypePPC64 = 69 // R_POWERPC_TPREL16
+	R_PPC64_TPREL16_LO         RelocationTypePPC64 = 70 // R_POWERPC_TPREL16_LO
+	R_PPC64_TPREL16_HI         RelocationTypePPC64 = 71 // R_POWERPC_TPREL16_HI
+	R_PPC64_TPREL16_HA         RelocationTypePPC64 = 72 // R_POWERPC_TPREL16_HA
+	R_PPC64_TPREL64            RelocationTypePPC64 = 73 // R_POWERPC_TPREL64
+	R_PPC64_DTPREL16           RelocationTypePPC64 = 74 // R_POWERPC_DTPREL16
+	R_PPC64_DTPREL16_LO        RelocationTypePPC64 = 75 // R_POWERPC_DTPREL16_LO
+	R_PPC64_DTPREL16_HI        RelocationTypePPC64 = 76 // R_POWERPC_DTPREL16_HI
+	R_PPC64_DTPREL16_HA        RelocationTypePPC64 = 77 // R_POWERPC_DTPREL16_HA
+	R_PPC64_DTPREL64           RelocationTypePPC64 = 78 // R_POWERPC_DTPREL64
+	R_PPC64_GOT_TLSGD16        RelocationTypePPC64 = 79 // R_POWERPC_GOT_TLSGD16
+	R_PPC64_GOT_TLSGD16_LO     RelocationTypePPC64 = 80 // R_POWERPC_GOT_TLSGD16_LO
+	R_PPC64_GOT_TLSGD16_HI     RelocationTypePPC64 = 81 // R_POWERPC_GOT_TLSGD16_HI
+	R_PPC64_GOT_TLSGD16_HA     RelocationTypePPC64 = 82 // R_POWERPC_GOT_TLSGD16_HA
+	R_PPC64_GOT_TLSLD16        RelocationTypePPC64 = 83 // R_POWERPC_GOT_TLSLD16
+	R_PPC64_GOT_TLSLD16_LO     RelocationTypePPC64 = 84 // R_POWERPC_GOT_TLSLD16_LO
+	R_PPC64_GOT_TLSLD16_HI     RelocationTypePPC64 = 85 // R_POWERPC_GOT_TLSLD16_HI
+	R_PPC64_GOT_TLSLD16_HA     RelocationTypePPC64 = 86 // R_POWERPC_GOT_TLSLD16_HA
+	R_PPC64_GOT_TPREL16_DS     RelocationTypePPC64 = 87 // R_POWERPC_GOT_TPREL16_DS
+	R_PPC64_GOT_TPREL16_LO_DS  RelocationTypePPC64 = 88 // R_POWERPC_GOT_TPREL16_LO_DS
+	R_PPC64_GOT_TPREL16_HI     RelocationTypePPC64 = 89 // R_POWERPC_GOT_TPREL16_HI
+	R_PPC64_GOT_TPREL16_HA     RelocationTypePPC64 = 90 // R_POWERPC_GOT_TPREL16_HA
+	R_PPC64_GOT_DTPREL16_DS    RelocationTypePPC64 = 91 // R_POWERPC_GOT_DTPREL16_DS
+	R_PPC64_GOT_DTPREL16_LO_DS RelocationTypePPC64 = 92 // R_POWERPC_GOT_DTPREL16_LO_DS
+	R_PPC64_GOT_DTPREL16_HI    RelocationTypePPC64 = 93 // R_POWERPC_GOT_DTPREL16_HI
+	R_PPC64_GOT_DTPREL16_HA    RelocationTypePPC64 = 94 // R_POWERPC_GOT_DTPREL16_HA
+	R_PPC64_TPREL16_DS         RelocationTypePPC64 = 95
+	R_PPC64_TPREL16_LO_DS      RelocationTypePPC64 = 96
+	R_PPC64_TPREL16_HIGHER     RelocationTypePPC64 = 97
+	R_PPC64_TPREL16_HIGHERA    RelocationTypePPC64 = 98
+	R_PPC64_TPREL16_HIGHEST    RelocationTypePPC64 = 99
+	R_PPC64_TPREL16_HIGHESTA   RelocationTypePPC64 = 100
+	R_PPC64_DTPREL16_DS        RelocationTypePPC64 = 101
+	R_PPC64_DTPREL16_LO_DS     RelocationTypePPC64 = 102
+	R_PPC64_DTPREL16_HIGHER    RelocationTypePPC64 = 103
+	R_PPC64_DTPREL16_HIGHERA   RelocationTypePPC64 = 104
+	R_PPC64_DTPREL16_HIGHEST   RelocationTypePPC64 = 105
+	R_PPC64_DTPREL16_HIGHESTA  RelocationTypePPC64 = 106
+	R_PPC64_TLSGD              RelocationTypePPC64 = 107
+	R_PPC64_TLSLD              RelocationTypePPC64 = 108
+	R_PPC64_TOCSAVE            RelocationTypePPC64 = 109
+	R_PPC64_ADDR16_HIGH        RelocationTypePPC64 = 110
+	R_PPC64_ADDR16_HIGHA       RelocationTypePPC64 = 111
+	R_PPC64_TPREL16_HIGH       RelocationTypePPC64 = 112
+	R_PPC64_TPREL16_HIGHA      RelocationTypePPC64 = 113
+	R_PPC64_DTPREL16_HIGH      RelocationTypePPC64 = 114
+	R_PPC64_DTPREL16_HIGHA     RelocationTypePPC64 = 115
+	R_PPC64_REL24_NOTOC        RelocationTypePPC64 = 116
+	R_PPC64_ADDR64_LOCAL       RelocationTypePPC64 = 117
+	R_PPC64_ENTRY              RelocationTypePPC64 = 118
+	R_PPC64_PLTSEQ             RelocationTypePPC64 = 119
+	R_PPC64_PLTCALL            RelocationTypePPC64 = 120
+	R_PPC64_PLTSEQ_NOTOC       RelocationTypePPC64 = 121
+	R_PPC64_PLTCALL_NOTOC      RelocationTypePPC64 = 122
+	R_PPC64_PCREL_OPT          RelocationTypePPC64 = 123
+	R_PPC64_REL24_P9NOTOC      RelocationTypePPC64 = 124
+	R_PPC64_D34                RelocationTypePPC64 = 128
+	R_PPC64_D34_LO             RelocationTypePPC64 = 129
+	R_PPC64_D34_HI30           RelocationTypePPC64 = 130
+	R_PPC64_D34_HA30           RelocationTypePPC64 = 131
+	R_PPC64_PCREL34            RelocationTypePPC64 = 132
+	R_PPC64_GOT_PCREL34        RelocationTypePPC64 = 133
+	R_PPC64_PLT_PCREL34        RelocationTypePPC64 = 134
+	R_PPC64_PLT_PCREL34_NOTOC  RelocationTypePPC64 = 135
+	R_PPC64_ADDR16_HIGHER34    RelocationTypePPC64 = 136
+	R_PPC64_ADDR16_HIGHERA34   RelocationTypePPC64 = 137
+	R_PPC64_ADDR16_HIGHEST34   RelocationTypePPC64 = 138
+	R_PPC64_ADDR16_HIGHESTA34  RelocationTypePPC64 = 139
+	R_PPC64_REL16_HIGHER34     RelocationTypePPC64 = 140
+	R_PPC64_REL16_HIGHERA34    RelocationTypePPC64 = 141
+	R_PPC64_REL16_HIGHEST34    RelocationTypePPC64 = 142
+	R_PPC64_REL16_HIGHESTA34   RelocationTypePPC64 = 143
+	R_PPC64_D28                RelocationTypePPC64 = 144
+	R_PPC64_PCREL28            RelocationTypePPC64 = 145
+	R_PPC64_TPREL34            RelocationTypePPC64 = 146
+	R_PPC64_DTPREL34           RelocationTypePPC64 = 147
+	R_PPC64_GOT_TLSGD_PCREL34  RelocationTypePPC64 = 148
+	R_PPC64_GOT_TLSLD_PCREL34  RelocationTypePPC64 = 149
+	R_PPC64_GOT_TPREL_PCREL34  RelocationTypePPC64 = 150
+	R_PPC64_GOT_DTPREL_PCREL34 RelocationTypePPC64 = 151
+	R_PPC64_REL16_HIGH         RelocationTypePPC64 = 240
+	R_PPC64_REL16_HIGHA        RelocationTypePPC64 = 241
+	R_PPC64_REL16_HIGHER       RelocationTypePPC64 = 242
+	R_PPC64_REL16_HIGHERA      RelocationTypePPC64 = 243
+	R_PPC64_REL16_HIGHEST      RelocationTypePPC64 = 244
+	R_PPC64_REL16_HIGHESTA     RelocationTypePPC64 = 245
+	R_PPC64_REL16DX_HA         RelocationTypePPC64 = 246 // R_POWERPC_REL16DX_HA
+	R_PPC64_JMP_IREL           RelocationTypePPC64 = 247
+	R_PPC64_IRELATIVE          RelocationTypePPC64 = 248 // R_POWERPC_IRELATIVE
+	R_PPC64_REL16              RelocationTypePPC64 = 249 // R_POWERPC_REL16
+	R_PPC64_REL16_LO           RelocationTypePPC64 = 250 // R_POWERPC_REL16_LO
+	R_PPC64_REL16_HI           RelocationTypePPC64 = 251 // R_POWERPC_REL16_HI
+	R_PPC64_REL16_HA           RelocationTypePPC64 = 252 // R_POWERPC_REL16_HA
+	R_PPC64_GNU_VTINHERIT      RelocationTypePPC64 = 253
+	R_PPC64_GNU_VTENTRY        RelocationTypePPC64 = 254
+)
+
+var relocationTypePPC64Names = map[RelocationTypePPC64]string{
+	R_PPC64_NONE:               "R_PPC64_NONE",
+	R_PPC64_ADDR32:             "R_PPC64_ADDR32",
+	R_PPC64_ADDR24:             "R_PPC64_ADDR24",
+	R_PPC64_ADDR16:             "R_PPC64_ADDR16",
+	R_PPC64_ADDR16_LO:          "R_PPC64_ADDR16_LO",
+	R_PPC64_ADDR16_HI:          "R_PPC64_ADDR16_HI",
+	R_PPC64_ADDR16_HA:          "R_PPC64_ADDR16_HA",
+	R_PPC64_ADDR14:             "R_PPC64_ADDR14",
+	R_PPC64_ADDR14_BRTAKEN:     "R_PPC64_ADDR14_BRTAKEN",
+	R_PPC64_ADDR14_BRNTAKEN:    "R_PPC64_ADDR14_BRNTAKEN",
+	R_PPC64_REL24:              "R_PPC64_REL24",
+	R_PPC64_REL14:              "R_PPC64_REL14",
+	R_PPC64_REL14_BRTAKEN:      "R_PPC64_REL14_BRTAKEN",
+	R_PPC64_REL14_BRNTAKEN:     "R_PPC64_REL14_BRNTAKEN",
+	R_PPC64_GOT16:              "R_PPC64_GOT16",
+	R_PPC64_GOT16_LO:           "R_PPC64_GOT16_LO",
+	R_PPC64_GOT16_HI:           "R_PPC64_GOT16_HI",
+	R_PPC64_GOT16_HA:           "R_PPC64_GOT16_HA",
+	R_PPC64_COPY:               "R_PPC64_COPY",
+	R_PPC64_GLOB_DAT:           "R_PPC64_GLOB_DAT",
+	R_PPC64_JMP_SLOT:           "R_PPC64_JMP_SLOT",
+	R_PPC64_RELATIVE:           "R_PPC64_RELATIVE",
+	R_PPC64_UADDR32:            "R_PPC64_UADDR32",
+	R_PPC64_UADDR16:            "R_PPC64_UADDR16",
+	R_PPC64_REL32:              "R_PPC64_REL32",
+	R_PPC64_PLT32:              "R_PPC64_PLT32",
+	R_PPC64_PLTREL32:           "R_PPC64_PLTREL32",
+	R_PPC64_PLT16_LO:           "R_PPC64_PLT16_LO",
+	R_PPC64_PLT16_HI:           "R_PPC64_PLT16_HI",
+	R_PPC64_PLT16_HA:           "R_PPC64_PLT16_HA",
+	R_PPC64_SECTOFF:            "R_PPC64_SECTOFF",
+	R_PPC64_SECTOFF_LO:         "R_PPC64_SECTOFF_LO",
+	R_PPC64_SECTOFF_HI:         "R_PPC64_SECTOFF_HI",
+	R_PPC64_SECTOFF_HA:         "R_PPC64_SECTOFF_HA",
+	R_PPC64_REL30:              "R_PPC64_REL30",
+	R_PPC64_ADDR64:             "R_PPC64_ADDR64",
+	R_PPC64_ADDR16_HIGHER:      "R_PPC64_ADDR16_HIGHER",
+	R_PPC64_ADDR16_HIGHERA:     "R_PPC64_ADDR16_HIGHERA",
+	R_PPC64_ADDR16_HIGHEST:     "R_PPC64_ADDR16_HIGHEST",
+	R_PPC64_ADDR16_HIGHESTA:    "R_PPC64_ADDR16_HIGHESTA",
+	R_PPC64_UADDR64:            "R_PPC64_UADDR64",
+	R_PPC64_REL64:              "R_PPC64_REL64",
+	R_PPC64_PLT64:              "R_PPC64_PLT64",
+	R_PPC64_PLTREL64:           "R_PPC64_PLTREL64",
+	R_PPC64_TOC16:              "R_PPC64_TOC16",
+	R_PPC64_TOC16_LO:           "R_PPC64_TOC16_LO",
+	R_PPC64_TOC16_HI:           "R_PPC64_TOC16_HI",
+	R_PPC64_TOC16_HA:           "R_PPC64_TOC16_HA",
+	R_PPC64_TOC:                "R_PPC64_TOC",
+	R_PPC64_PLTGOT16:           "R_PPC64_PLTGOT16",
+	R_PPC64_PLTGOT16_LO:        "R_PPC64_PLTGOT16_LO",
+	R_PPC64_PLTGOT16_HI:        "R_PPC64_PLTGOT16_HI",
+	R_PPC64_PLTGOT16_HA:        "R_PPC64_PLTGOT16_HA",
+	R_PPC64_ADDR16_DS:          "R_PPC64_ADDR16_DS",
+	R_PPC64_ADDR16_LO_DS:       "R_PPC64_ADDR16_LO_DS",
+	R_PPC64_GOT16_DS:           "R_PPC64_GOT16_DS",
+	R_PPC64_GOT16_LO_DS:        "R_PPC64_GOT16_LO_DS",
+	R_PPC64_PLT16_LO_DS:        "R_PPC64_PLT16_LO_DS",
+	R_PPC64_SECTOFF_DS:         "R_PPC64_SECTOFF_DS",
+	R_PPC64_SECTOFF_LO_DS:      "R_PPC64_SECTOFF_LO_DS",
+	R_PPC64_TOC16_DS:           "R_PPC64_TOC16_DS",
+	R_PPC64_TOC16_LO_DS:        "R_PPC64_TOC16_LO_DS",
+	R_PPC64_PLTGOT16_DS:        "R_PPC64_PLTGOT16_DS",
+	R_PPC64_PLTGOT_LO_DS:       "R_PPC64_PLTGOT_LO_DS",
+	R_PPC64_TLS:                "R_PPC64_TLS",
+	R_PPC64_DTPMOD64:           "R_PPC64_DTPMOD64",
+	R_PPC64_TPREL16:            "R_PPC64_TPREL16",
+	R_PPC64_TPREL16_LO:         "R_PPC64_TPREL16_LO",
+	R_PPC64_TPREL16_HI:         "R_PPC64_TPREL16_HI",
+	R_PPC64_TPREL16_HA:         "R_PPC64_TPREL16_HA",
+	R_PPC64_TPREL64:            "R_PPC64_TPREL64",
+	R_PPC64_DTPREL16:           "R_PPC64_DTPREL16",
+	R_PPC64_DTPREL16_LO:        "R_PPC64_DTPREL16_LO",
+	R_PPC64_DTPREL16_HI:        "R_PPC64_DTPREL16_HI",
+	R_PPC64_DTPREL16_HA:        "R_PPC64_DTPREL16_HA",
+	R_PPC64_DTPREL64:           "R_PPC64_DTPREL64",
+	R_PPC64_GOT_TLSGD16:        "R_PPC64_GOT_TLSGD16",
+	R_PPC64_GOT_TLSGD16_LO:     "R_PPC64_GOT_TLSGD16_LO",
+	R_PPC64_GOT_TLSGD16_HI:     "R_PPC64_GOT_TLSGD16_HI",
+	R_PPC64_GOT_TLSGD16_HA:     "R_PPC64_GOT_TLSGD16_HA",
+	R_PPC64_GOT_TLSLD16:        "R_PPC64_GOT_TLSLD16",
+	R_PPC64_GOT_TLSLD16_LO:     "R_PPC64_GOT_TLSLD16_LO",
+	R_PPC64_GOT_TLSLD16_HI:     "R_PPC64_GOT_TLSLD16_HI",
+	R_PPC64_GOT_TLSLD16_HA:     "R_PPC64_GOT_TLSLD16_HA",
+	R_PPC64_GOT_TPREL16_DS:     "R_PPC64_GOT_TPREL16_DS",
+	R_PPC64_GOT_TPREL16_LO_DS:  "R_PPC64_GOT_TPREL16_LO_DS",
+	R_PPC64_GOT_TPREL16_HI:     "R_PPC64_GOT_TPREL16_HI",
+	R_PPC64_GOT_TPREL16_HA:     "R_PPC64_GOT_TPREL16_HA",
+	R_PPC64_GOT_DTPREL16_DS:    "R_PPC64_GOT_DTPREL16_DS",
+	R_PPC64_GOT_DTPREL16_LO_DS: "R_PPC64_GOT_DTPREL16_LO_DS",
+	R_PPC64_GOT_DTPREL16_HI:    "R_PPC64_GOT_DTPREL16_HI",
+	R_PPC64_GOT_DTPREL16_HA:    "R_PPC64_GOT_DTPREL16_HA",
+	R_PPC64_TPREL16_DS:         "R_PPC64_TPREL16_DS",
+	R_PPC64_TPREL16_LO_DS:      "R_PPC64_TPREL16_LO_DS",
+	R_PPC64_TPREL16_HIGHER:     "R_PPC64_TPREL16_HIGHER",
+	R_PPC64_TPREL16_HIGHERA:    "R_PPC64_TPREL16_HIGHERA",
+	R_PPC64_TPREL16_HIGHEST:    "R_PPC64_TPREL16_HIGHEST",
+	R_PPC64_TPREL16_HIGHESTA:   "R_PPC64_TPREL16_HIGHESTA",
+	R_PPC64_DTPREL16_DS:        "R_PPC64_DTPREL16_DS",
+	R_PPC64_DTPREL16_LO_DS:     "R_PPC64_DTPREL16_LO_DS",
+	R_PPC64_DTPREL16_HIGHER:    "R_PPC64_DTPREL16_HIGHER",
+	R_PPC64_DTPREL16_HIGHERA:   "R_PPC64_DTPREL16_HIGHERA",
+	R_PPC64_DTPREL16_HIGHEST:   "R_PPC64_DTPREL16_HIGHEST",
+	R_PPC64_DTPREL16_HIGHESTA:  "R_PPC64_DTPREL16_HIGHESTA",
+	R_PPC64_TLSGD:              "R_PPC64_TLSGD",
+	R_PPC64_TLSLD:              "R_PPC64_TLSLD",
+	R_PPC64_TOCSAVE:            "R_PPC64_TOCSAVE",
+	R_PPC64_ADDR16_HIGH:        "R_PPC64_ADDR16_HIGH",
+	R_PPC64_ADDR16_HIGHA:       "R_PPC64_ADDR16_HIGHA",
+	R_PPC64_TPREL16_HIGH:       "R_PPC64_TPREL16_HIGH",
+	R_PPC64_TPREL16_HIGHA:      "R_PPC64_TPREL16_HIGHA",
+	R_PPC64_DTPREL16_HIGH:      "R_PPC64_DTPREL16_HIGH",
+	R_PPC64_DTPREL16_HIGHA:     "R_PPC64_DTPREL16_HIGHA",
+	R_PPC64_REL24_NOTOC:        "R_PPC64_REL24_NOTOC",
+	R_PPC64_ADDR64_LOCAL:       "R_PPC64_ADDR64_LOCAL",
+	R_PPC64_ENTRY:              "R_PPC64_ENTRY",
+	R_PPC64_PLTSEQ:             "R_PPC64_PLTSEQ",
+	R_PPC64_PLTCALL:            "R_PPC64_PLTCALL",
+	R_PPC64_PLTSEQ_NOTOC:       "R_PPC64_PLTSEQ_NOTOC",
+	R_PPC64_PLTCALL_NOTOC:      "R_PPC64_PLTCALL_NOTOC",
+	R_PPC64_PCREL_OPT:          "R_PPC64_PCREL_OPT",
+	R_PPC64_REL24_P9NOTOC:      "R_PPC64_REL24_P9NOTOC",
+	R_PPC64_D34:                "R_PPC64_D34",
+	R_PPC64_D34_LO:             "R_PPC64_D34_LO",
+	R_PPC64_D34_HI30:           "R_PPC64_D34_HI30",
+	R_PPC64_D34_HA30:           "R_PPC64_D34_HA30",
+	R_PPC64_PCREL34:            "R_PPC64_PCREL34",
+	R_PPC64_GOT_PCREL34:        "R_PPC64_GOT_PCREL34",
+	R_PPC64_PLT_PCREL34:        "R_PPC64_PLT_PCREL34",
+	R_PPC64_PLT_PCREL34_NOTOC:  "R_PPC64_PLT_PCREL34_NOTOC",
+	R_PPC64_ADDR16_HIGHER34:    "R_PPC64_ADDR16_HIGHER34",
+	R_PPC64_ADDR16_HIGHERA34:   "R_PPC64_ADDR16_HIGHERA34",
+	R_PPC64_ADDR16_HIGHEST34:   "R_PPC64_ADDR16_HIGHEST34",
+	R_PPC64_ADDR16_HIGHESTA34:  "R_PPC64_ADDR16_HIGHESTA34",
+	R_PPC64_REL16_HIGHER34:     "R_PPC64_REL16_HIGHER34",
+	R_PPC64_REL16_HIGHERA34:    "R_PPC64_REL16_HIGHERA34",
+	R_PPC64_REL16_HIGHEST34:    "R_PPC64_REL16_HIGHEST34",
+	R_PPC64_REL16_HIGHESTA34:   "R_PPC64_REL16_HIGHESTA34",
+	R_PPC64_D28:                "R_PPC64_D28",
+	R_PPC64_PCREL28:            "R_PPC64_PCREL28",
+	R_PPC64_TPREL34:            "R_PPC64_TPREL34",
+	R_PPC64_DTPREL34:           "R_PPC64_DTPREL34",
+	R_PPC64_GOT_TLSGD_PCREL34:  "R_PPC64_GOT_TLSGD_PCREL34",
+	R_PPC64_GOT_TLSLD_PCREL34:  "R_PPC64_GOT_TLSLD_PCREL34",
+	R_PPC64_GOT_TPREL_PCREL34:  "R_PPC64_GOT_TPREL_PCREL34",
+	R_PPC64_GOT_DTPREL_PCREL34: "R_PPC64_GOT_DTPREL_PCREL34",
+	R_PPC64_REL16_HIGH:         "R_PPC64_REL16_HIGH",
+	R_PPC64_REL16_HIGHA:        "R_PPC64_REL16_HIGHA",
+	R_PPC64_REL16_HIGHER:       "R_PPC64_REL16_HIGHER",
+	R_PPC64_REL16_HIGHERA:      "R_PPC64_REL16_HIGHERA",
+	R_PPC64_REL16_HIGHEST:      "R_PPC64_REL16_HIGHEST",
+	R_PPC64_REL16_HIGHESTA:     "R_PPC64_REL16_HIGHESTA",
+	R_PPC64_REL16DX_HA:         "R_PPC64_REL16DX_HA",
+	R_PPC64_JMP_IREL:           "R_PPC64_JMP_IREL",
+	R_PPC64_IRELATIVE:          "R_PPC64_IRELATIVE",
+	R_PPC64_REL16:              "R_PPC64_REL16",
+	R_PPC64_REL16_LO:           "R_PPC64_REL16_LO",
+	R_PPC64_REL16_HI:           "R_PPC64_REL16_HI",
+	R_PPC64_REL16_HA:           "R_PPC64_REL16_HA",
+	R_PPC64_GNU_VTINHERIT:      "R_PPC64_GNU_VTINHERIT",
+	R_PPC64_GNU_VTENTRY:        "R_PPC64_GNU_VTENTRY",
+}
+
+func (rt RelocationTypePPC64) Value() uint32 {
+	return uint32(rt)
+}
+
+func (rt RelocationTypePPC64) String() string {
+	name, ok := relocationTypePPC64Names[rt]
+	return relocationTypeName(uint32(rt), name, ok)
+}
+
+// RelocationTypeS390 enumerates relocation types of S390 and S390x
+type RelocationTypeS390 uint32
+
+const (
+	R_390_NONE        RelocationTypeS390 = 0
+	R_390_8           RelocationTypeS390 = 1
+	R_390_12          RelocationTypeS390 = 2
+	R_390_16          RelocationTypeS390 = 3
+	R_390_32          RelocationTypeS390 = 4
+	R_390_PC32        RelocationTypeS390 = 5
+	R_390_GOT12       RelocationTypeS390 = 6
+	R_390_GOT32       RelocationTypeS390 = 7
+	R_390_PLT32       RelocationTypeS390 = 8
+	R_390_COPY        RelocationTypeS390 = 9
+	R_390_GLOB_DAT    RelocationTypeS390 = 10
+	R_390_JMP_SLOT    RelocationTypeS390 = 11
+	R_390_RELATIVE    RelocationTypeS390 = 12
+	R_390_GOTOFF      RelocationTypeS390 = 13
+	R_390_GOTPC       RelocationTypeS390 = 14
+	R_390_GOT16       RelocationTypeS390 = 15
+	R_390_PC16        RelocationTypeS390 = 16
+	R_390_PC16DBL     RelocationTypeS390 = 17
+	R_390_PLT16DBL    RelocationTypeS390 = 18
+	R_390_PC32DBL     RelocationTypeS390 = 19
+	R_390_PLT32DBL    RelocationTypeS390 = 20
+	R_390_GOTPCDBL    RelocationTypeS390 = 21
+	R_390_64          RelocationTypeS390 = 22
+	R_390_PC64        RelocationTypeS390 = 23
+	R_390_GOT64       RelocationTypeS390 = 24
+	R_390_PLT64       RelocationTypeS390 = 25
+	R_390_GOTENT      RelocationTypeS390 = 26
+	R_390_GOTOFF16    RelocationTypeS390 = 27
+	R_390_GOTOFF64    RelocationTypeS390 = 28
+	R_390_GOTPLT12    RelocationTypeS390 = 29
+	R_390_GOTPLT16    RelocationTypeS390 = 30
+	R_390_GOTPLT32    RelocationTypeS390 = 31
+	R_390_GOTPLT64    RelocationTypeS390 = 32
+	R_390_GOTPLTENT   RelocationTypeS390 = 33
+	R_390_GOTPLTOFF16 RelocationTypeS390 = 34
+	R_390_GOTPLTOFF32 RelocationTypeS390 = 35
+	R_390_GOTPLTOFF64 RelocationTypeS390 = 36
+	R_390_TLS_LOAD    RelocationTypeS390 = 37
+	R_390_TLS_GDCALL  RelocationTypeS390 = 38
+	R_390_TLS_LDCALL  RelocationTypeS390 = 39
+	R_390_TLS_GD32    RelocationTypeS390 = 40
+	R_390_TLS_GD64    RelocationTypeS390 = 41
+	R_390_TLS_GOTIE12 RelocationTypeS390 = 42
+	R_390_TLS_GOTIE32 RelocationTypeS390 = 43
+	R_390_TLS_GOTIE64 RelocationTypeS390 = 44
+	R_390_TLS_LDM32   RelocationTypeS390 = 45
+	R_390_TLS_LDM64   RelocationTypeS390 = 46
+	R_390_TLS_IE32    RelocationTypeS390 = 47
+	R_390_TLS_IE64    RelocationTypeS390 = 48
+	R_390_TLS_IEENT   RelocationTypeS390 = 49
+	R_390_TLS_LE32    RelocationTypeS390 = 50
+	R_390_TLS_LE64    RelocationTypeS390 = 51
+	R_390_TLS_LDO32   RelocationTypeS390 = 52
+	R_390_TLS_LDO64   RelocationTypeS390 = 53
+	R_390_TLS_DTPMOD  RelocationTypeS390 = 54
+	R_390_TLS_DTPOFF  RelocationTypeS390 = 55
+	R_390_TLS_TPOFF   RelocationTypeS390 = 56
+	R_390_20          RelocationTypeS390 = 57
+	R_390_GOT20       RelocationTypeS390 = 58
+	R_390_GOTPLT20    RelocationTypeS390 = 59
+	R_390_TLS_GOTIE20 RelocationTypeS390 = 60
+)
+
+var relocationTypeS390Names = map[RelocationTypeS390]string{
+	R_390_NONE:        "R_390_NONE",
+	R_390_8:           "R_390_8",
+	R_390_12:          "R_390_12",
+	R_390_16:          "R_390_16",
+	R_390_32:          "R_390_32",
+	R_390_PC32:        "R_390_PC32",
+	R_390_GOT12:       "R_390_GOT12",
+	R_390_GOT32:       "R_390_GOT32",
+	R_390_PLT32:       "R_390_PLT32",
+	R_390_COPY:        "R_390_COPY",
+	R_390_GLOB_DAT:    "R_390_GLOB_DAT",
+	R_390_JMP_SLOT:    "R_390_JMP_SLOT",
+	R_390_RELATIVE:    "R_390_RELATIVE",
+	R_390_GOTOFF:      "R_390_GOTOFF",
+	R_390_GOTPC:       "R_390_GOTPC",
+	R_390_GOT16:       "R_390_GOT16",
+	R_390_PC16:        "R_390_PC16",
+	R_390_PC16DBL:     "R_390_PC16DBL",
+	R_390_PLT16DBL:    "R_390_PLT16DBL",
+	R_390_PC32DBL:     "R_390_PC32DBL",
+	R_390_PLT32DBL:    "R_390_PLT32DBL",
+	R_390_GOTPCDBL:    "R_390_GOTPCDBL",
+	R_390_64:          "R_390_64",
+	R_390_PC64:        "R_390_PC64",
+	R_390_GOT64:       "R_390_GOT64",
+	R_390_PLT64:       "R_390_PLT64",
+	R_390_GOTENT:      "R_390_GOTENT",
+	R_390_GOTOFF16:    "R_390_GOTOFF16",
+	R_390_GOTOFF64:    "R_390_GOTOFF64",
+	R_390_GOTPLT12:    "R_390_GOTPLT12",
+	R_390_GOTPLT16:    "R_390_GOTPLT16",
+	R_390_GOTPLT32:    "R_390_GOTPLT32",
+	R_390_GOTPLT64:    "R_390_GOTPLT64",
+	R_390_GOTPLTENT:   "R_390_GOTPLTENT",
+	R_390_GOTPLTOFF16: "R_390_GOTPLTOFF16",
+	R_390_GOTPLTOFF32: "R_390_GOTPLTOFF32",
+	R_390_GOTPLTOFF64: "R_390_GOTPLTOFF64",
+	R_390_TLS_LOAD:    "R_390_TLS_LOAD",
+	R_390_TLS_GDCALL:  "R_390_TLS_GDCALL",
+	R_390_TLS_LDCALL:  "R_390_TLS_LDCALL",
+	R_390_TLS_GD32:    "R_390_TLS_GD32",
+	R_390_TLS_GD64:    "R_390_TLS_GD64",
+	R_390_TLS_GOTIE12: "R_390_TLS_GOTIE12",
+	R_390_TLS_GOTIE32: "R_390_TLS_GOTIE32",
+	R_390_TLS_GOTIE64: "R_390_TLS_GOTIE64",
+	R_390_TLS_LDM32:   "R_390_TLS_LDM32",
+	R_390_TLS_LDM64:   "R_390_TLS_LDM64",
+	R_390_TLS_IE32:    "R_390_TLS_IE32",
+	R_390_TLS_IE64:    "R_390_TLS_IE64",
+	R_390_TLS_IEENT:   "R_390_TLS_IEENT",
+	R_390_TLS_LE32:    "R_390_TLS_LE32",
+	R_390_TLS_LE64:    "R_390_TLS_LE64",
+	R_390_TLS_LDO32:   "R_390_TLS_LDO32",
+	R_390_TLS_LDO64:   "R_390_TLS_LDO64",
+	R_390_TLS_DTPMOD:  "R_390_TLS_DTPMOD",
+	R_390_TLS_DTPOFF:  "R_390_TLS_DTPOFF",
+	R_390_TLS_TPOFF:   "R_390_TLS_TPOFF",
+	R_390_20:          "R_390_20",
+	R_390_GOT20:       "R_390_GOT20",
+	R_390_GOTPLT20:    "R_390_GOTPLT20",
+	R_390_TLS_GOTIE20: "R_390_TLS_GOTIE20",
+}
+
+func (rt RelocationTypeS390) Value() uint32 {
+	return uint32(rt)
+}
+
+func (rt RelocationTypeS390) String() string {
+	name, ok := relocationTypeS390Names[rt]
+	return relocationTypeName(uint32(rt), name, ok)
+}
+
+// RelocationTypeRISCV enumerates relocation types of RISC-V
+type RelocationTypeRISCV uint32
+
+const (
+	R_RISCV_NONE              RelocationTypeRISCV = 0  // No relocation
+	R_RISCV_32                RelocationTypeRISCV = 1  // Add 32 bit zero extended symbol value
+	R_RISCV_64                RelocationTypeRISCV = 2  // Add 64 bit symbol value
+	R_RISCV_RELATIVE          RelocationTypeRISCV = 3  // Add load address of shared object
+	R_RISCV_COPY              RelocationTypeRISCV = 4  // Copy data from shared object
+	R_RISCV_JUMP_SLOT         RelocationTypeRISCV = 5  // Set GOT entry to code address
+	R_RISCV_TLS_DTPMOD32      RelocationTypeRISCV = 6  // 32 bit ID of module containing symbol
+	R_RISCV_TLS_DTPMOD64      RelocationTypeRISCV = 7  // ID of module containing symbol
+	R_RISCV_TLS_DTPREL32      RelocationTypeRISCV = 8  // 32 bit relative offset in TLS block
+	R_RISCV_TLS_DTPREL64      RelocationTypeRISCV = 9  // Relative offset in TLS block
+	R_RISCV_TLS_TPREL32       RelocationTypeRISCV = 10 // 32 bit relative offset in static TLS block
+	R_RISCV_TLS_TPREL64       RelocationTypeRISCV = 11 // Relative offset in static TLS block
+	R_RISCV_BRANCH            RelocationTypeRISCV = 16 // PC-relative branch
+	R_RISCV_JAL               RelocationTypeRISCV = 17 // PC-relative jump
+	R_RISCV_CALL              RelocationTypeRISCV = 18 // PC-relative call
+	R_RISCV_CALL_PLT          RelocationTypeRISCV = 19 // PC-relative call (PLT)
+	R_RISCV_GOT_HI20          RelocationTypeRISCV = 20 // PC-relative GOT reference
+	R_RISCV_TLS_GOT_HI20      RelocationTypeRISCV = 21 // PC-relative TLS IE GOT offset
+	R_RISCV_TLS_GD_HI20       RelocationTypeRISCV = 22 // PC-relative TLS GD reference
+	R_RISCV_PCREL_HI20        RelocationTypeRISCV = 23 // PC-relative reference
+	R_RISCV_PCREL_LO12_I      RelocationTypeRISCV = 24 // PC-relative reference
+	R_RISCV_PCREL_LO12_S      RelocationTypeRISCV = 25 // PC-relative reference
+	R_RISCV_HI20              RelocationTypeRISCV = 26 // Absolute address
+	R_RISCV_LO12_I            RelocationTypeRISCV = 27 // Absolute address
+	R_RISCV_LO12_S            RelocationTypeRISCV = 28 // Absolute address
+	R_RISCV_TPREL_HI20        RelocationTypeRISCV = 29 // TLS LE thread offset
+	R_RISCV_TPREL_LO12_I      RelocationTypeRISCV = 30 // TLS LE thread offset
+	R_RISCV_TPREL_LO12_S      RelocationTypeRISCV = 31 // TLS LE thread offset
+	R_RISCV_TPREL_ADD         RelocationTypeRISCV = 32 // TLS LE thread usage
+	R_RISCV_ADD8              RelocationTypeRISCV = 33 // 8-bit label addition
+	R_RISCV_ADD16             RelocationTypeRISCV = 34 // 16-bit label addition
+	R_RISCV_ADD32             RelocationTypeRISCV = 35 // 32-bit label addition
+	R_RISCV_ADD64             RelocationTypeRISCV = 36 // 64-bit label addition
+	R_RISCV_SUB8              RelocationTypeRISCV = 37 // 8-bit label subtraction
+	R_RISCV_SUB16             RelocationTypeRISCV = 38 // 16-bit label subtraction
+	R_RISCV_SUB32             RelocationTypeRISCV = 39 // 32-bit label subtraction
+	R_RISCV_SUB64             RelocationTypeRISCV = 40 // 64-bit label subtraction
+	R_RISCV_GNU_VTINHERIT     RelocationTypeRISCV = 41 // GNU C++ vtable hierarchy
+	R_RISCV_GNU_VTENTRY       RelocationTypeRISCV = 42 // GNU C++ vtable member usage
+	R_RISCV_ALIGN             RelocationTypeRISCV = 43 // Alignment statement
+	R_RISCV_RVC_BRANCH        RelocationTypeRISCV = 44 // PC-relative branch offset
+	R_RISCV_RVC_JUMP          RelocationTypeRISCV = 45 // PC-relative jump offset
+	R_RISCV_RVC_LUI           RelocationTypeRISCV = 46 // Absolute address
+	R_RISCV_GPREL_I           RelocationTypeRISCV = 47 // GP-relative reference
+	R_RISCV_GPREL_S           RelocationTypeRISCV = 48 // GP-relative reference
+	R_RISCV_TPREL_I           RelocationTypeRISCV = 49 // TP-relative TLS LE load
+	R_RISCV_TPREL_S           RelocationTypeRISCV = 50 // TP-relative TLS LE store
+	R_RISCV_RELAX             RelocationTypeRISCV = 51 // Instruction pair can be relaxed
+	R_RISCV_SUB6              RelocationTypeRISCV = 52 // Local label subtraction
+	R_RISCV_SET6              RelocationTypeRISCV = 53 // Local label subtraction
+	R_RISCV_SET8              RelocationTypeRISCV = 54 // Local label subtraction
+	R_RISCV_SET16             RelocationTypeRISCV = 55 // Local label subtraction
+	R_RISCV_SET32             RelocationTypeRISCV = 56 // Local label subtraction
+	R_RISCV_32_PCREL          RelocationTypeRISCV = 57 // 32-bit PC relative
+	R_RISCV_IRELATIVE         RelocationTypeRISCV = 58 // Relocation against a non-preemptible ifunc
+	R_RISCV_PLT32             RelocationTypeRISCV = 59 // 32-bit relative offset to a function or its PLT entry
+	R_RISCV_SET_ULEB128       RelocationTypeRISCV = 60 // Local label assignment in ULEB128 encoding
+	R_RISCV_SUB_ULEB128       RelocationTypeRISCV = 61 // Local label subtraction in ULEB128 encoding
+	R_RISCV_TLSDESC_HI20      RelocationTypeRISCV = 62 // PC-relative TLS descriptor
+	R_RISCV_TLSDESC_LOAD_LO12 RelocationTypeRISCV = 63
+	R_RISCV_TLSDESC_ADD_LO12  RelocationTypeRISCV = 64
+	R_RISCV_TLSDESC_CALL      RelocationTypeRISCV = 65
+)
+
+var relocationTypeRISCVNames = map[RelocationTypeRISCV]string{
+	R_RISCV_NONE:              "R_RISCV_NONE",
+	R_RISCV_32:                "R_RISCV_32",
+	R_RISCV_64:                "R_RISCV_64",
+	R_RISCV_RELATIVE:          "R_RISCV_RELATIVE",
+	R_RISCV_COPY:              "R_RISCV_COPY",
+	R_RISCV_JUMP_SLOT:         "R_RISCV_JUMP_SLOT",
+	R_RISCV_TLS_DTPMOD32:      "R_RISCV_TLS_DTPMOD32",
+	R_RISCV_TLS_DTPMOD64:      "R_RISCV_TLS_DTPMOD64",
+	R_RISCV_TLS_DTPREL32:      "R_RISCV_TLS_DTPREL32",
+	R_RISCV_TLS_DTPREL64:      "R_RISCV_TLS_DTPREL64",
+	R_RISCV_TLS_TPREL32:       "R_RISCV_TLS_TPREL32",
+	R_RISCV_TLS_TPREL64:       "R_RISCV_TLS_TPREL64",
+	R_RISCV_BRANCH:            "R_RISCV_BRANCH",
+	R_RISCV_JAL:               "R_RISCV_JAL",
+	R_RISCV_CALL:              "R_RISCV_CALL",
+	R_RISCV_CALL_PLT:          "R_RISCV_CALL_PLT",
+	R_RISCV_GOT_HI20:          "R_RISCV_GOT_HI20",
+	R_RISCV_TLS_GOT_HI20:      "R_RISCV_TLS_GOT_HI20",
+	R_RISCV_TLS_GD_HI20:       "R_RISCV_TLS_GD_HI20",
+	R_RISCV_PCREL_HI20:        "R_RISCV_PCREL_HI20",
+	R_RISCV_PCREL_LO12_I:      "R_RISCV_PCREL_LO12_I",
+	R_RISCV_PCREL_LO12_S:      "R_RISCV_PCREL_LO12_S",
+	R_RISCV_HI20:              "R_RISCV_HI20",
+	R_RISCV_LO12_I:            "R_RISCV_LO12_I",
+	R_RISCV_LO12_S:            "R_RISCV_LO12_S",
+	R_RISCV_TPREL_HI20:        "R_RISCV_TPREL_HI20",
+	R_RISCV_TPREL_LO12_I:      "R_RISCV_TPREL_LO12_I",
+	R_RISCV_TPREL_LO12_S:      "R_RISCV_TPREL_LO12_S",
+	R_RISCV_TPREL_ADD:         "R_RISCV_TPREL_ADD",
+	R_RISCV_ADD8:              "R_RISCV_ADD8",
+	R_RISCV_ADD16:             "R_RISCV_ADD16",
+	R_RISCV_ADD32:             "R_RISCV_ADD32",
+	R_RISCV_ADD64:             "R_RISCV_ADD64",
+	R_RISCV_SUB8:              "R_RISCV_SUB8",
+	R_RISCV_SUB16:             "R_RISCV_SUB16",
+	R_RISCV_SUB32:             "R_RISCV_SUB32",
+	R_RISCV_SUB64:             "R_RISCV_SUB64",
+	R_RISCV_GNU_VTINHERIT:     "R_RISCV_GNU_VTINHERIT",
+	R_RISCV_GNU_VTENTRY:       "R_RISCV_GNU_VTENTRY",
+	R_RISCV_ALIGN:             "R_RISCV_ALIGN",
+	R_RISCV_RVC_BRANCH:        "R_RISCV_RVC_BRANCH",
+	R_RISCV_RVC_JUMP:          "R_RISCV_RVC_JUMP",
+	R_RISCV_RVC_LUI:           "R_RISCV_RVC_LUI",
+	R_RISCV_GPREL_I:           "R_RISCV_GPREL_I",
+	R_RISCV_GPREL_S:           "R_RISCV_GPREL_S",
+	R_RISCV_TPREL_I:           "R_RISCV_TPREL_I",
+	R_RISCV_TPREL_S:           "R_RISCV_TPREL_S",
+	R_RISCV_RELAX:             "R_RISCV_RELAX",
+	R_RISCV_SUB6:              "R_RISCV_SUB6",
+	R_RISCV_SET6:              "R_RISCV_SET6",
+	R_RISCV_SET8:              "R_RISCV_SET8",
+	R_RISCV_SET16:             "R_RISCV_SET16",
+	R_RISCV_SET32:             "R_RISCV_SET32",
+	R_RISCV_32_PCREL:          "R_RISCV_32_PCREL",
+	R_RISCV_IRELATIVE:         "R_RISCV_IRELATIVE",
+	R_RISCV_PLT32:             "R_RISCV_PLT32",
+	R_RISCV_SET_ULEB128:       "R_RISCV_SET_ULEB128",
+	R_RISCV_SUB_ULEB128:       "R_RISCV_SUB_ULEB128",
+	R_RISCV_TLSDESC_HI20:      "R_RISCV_TLSDESC_HI20",
+	R_RISCV_TLSDESC_LOAD_LO12: "R_RISCV_TLSDESC_LOAD_LO12",
+	R_RISCV_TLSDESC_ADD_LO12:  "R_RISCV_TLSDESC_ADD_LO12",
+	R_RISCV_TLSDESC_CALL:      "R_RISCV_TLSDESC_CALL",
+}
+
+func (rt RelocationTypeRISCV) Value() uint32 {
+	return uint32(rt)
+}
+
+func (rt RelocationTypeRISCV) String() string {
+	name, ok := relocationTypeRISCVNames[rt]
+	return relocationTypeName(uint32(rt), name, ok)
+}
+
+// RelocationTypeSPARC enumerates relocation types of SPARC
+type RelocationTypeSPARC uint32
+
+const (
+	R_SPARC_NONE     RelocationTypeSPARC = 0
+	R_SPARC_8        RelocationTypeSPARC = 1
+	R_SPARC_16       RelocationTypeSPARC = 2
+	R_SPARC_32       RelocationTypeSPARC = 3
+	R_SPARC_DISP8    RelocationTypeSPARC = 4
+	R_SPARC_DISP16   RelocationTypeSPARC = 5
+	R_SPARC_DISP32   RelocationTypeSPARC = 6
+	R_SPARC_WDISP30  RelocationTypeSPARC = 7
+	R_SPARC_WDISP22  RelocationTypeSPARC = 8
+	R_SPARC_HI22     RelocationTypeSPARC = 9
+	R_SPARC_22       RelocationTypeSPARC = 10
+	R_SPARC_13       RelocationTypeSPARC = 11
+	R_SPARC_LO10     RelocationTypeSPARC = 12
+	R_SPARC_GOT10    RelocationTypeSPARC = 13
+	R_SPARC_GOT13    RelocationTypeSPARC = 14
+	R_SPARC_GOT22    RelocationTypeSPARC = 15
+	R_SPARC_PC10     RelocationTypeSPARC = 16
+	R_SPARC_PC22     RelocationTypeSPARC = 17
+	R_SPARC_WPLT30   RelocationTypeSPARC = 18
+	R_SPARC_COPY     RelocationTypeSPARC = 19
+	R_SPARC_GLOB_DAT RelocationTypeSPARC = 20
+	R_SPARC_JMP_SLOT RelocationTypeSPARC = 21
+	R_SPARC_RELATIVE RelocationTypeSPARC = 22
+	R_SPARC_UA32     RelocationTypeSPARC = 23
+	R_SPARC_PLT32    RelocationTypeSPARC = 24
+	R_SPARC_HIPLT22  RelocationTypeSPARC = 25
+	R_SPARC_LOPLT10  RelocationTypeSPARC = 26
+	R_SPARC_PCPLT32  RelocationTypeSPARC = 27
+	R_SPARC_PCPLT22  RelocationTypeSPARC = 28
+	R_SPARC_PCPLT10  RelocationTypeSPARC = 29
+	R_SPARC_10       RelocationTypeSPARC = 30
+	R_SPARC_11       RelocationTypeSPARC = 31
+	R_SPARC_64       RelocationTypeSPARC = 32
+	R_SPARC_OLO10    RelocationTypeSPARC = 33
+	R_SPARC_HH22     RelocationTypeSPARC = 34
+	R_SPARC_HM10     RelocationTypeSPARC = 35
+	R_SPARC_LM22     RelocationTypeSPARC = 36
+	R_SPARC_PC_HH22  RelocationTypeSPARC = 37
+	R_SPARC_PC_HM10  RelocationTypeSPARC = 38
+	R_SPARC_PC_LM22  RelocationTypeSPARC = 39
+	R_SPARC_WDISP16  RelocationTypeSPARC = 40
+	R_SPARC_WDISP19  RelocationTypeSPARC = 41
+	R_SPARC_GLOB_JMP RelocationTypeSPARC = 42
+	R_SPARC_7        RelocationTypeSPARC = 43
+	R_SPARC_5        RelocationTypeSPARC = 44
+	R_SPARC_6        RelocationTypeSPARC = 45
+	R_SPARC_DISP64   RelocationTypeSPARC = 46
+	R_SPARC_PLT64    RelocationTypeSPARC = 47
+	R_SPARC_HIX22    RelocationTypeSPARC = 48
+	R_SPARC_LOX10    RelocationTypeSPARC = 49
+	R_SPARC_H44      RelocationTypeSPARC = 50
+	R_SPARC_M44      RelocationTypeSPARC = 51
+	R_SPARC_L44      RelocationTypeSPARC = 52
+	R_SPARC_REGISTER RelocationTypeSPARC = 53
+	R_SPARC_UA64     RelocationTypeSPARC = 54
+	R_SPARC_UA16     RelocationTypeSPARC = 55
+)
+
+var relocationTypeSPARCNames = map[RelocationTypeSPARC]string{
+	R_SPARC_NONE:     "R_SPARC_NONE",
+	R_SPARC_8:        "R_SPARC_8",
+	R_SPARC_16:       "R_SPARC_16",
+	R_SPARC_32:       "R_SPARC_32",
+	R_SPARC_DISP8:    "R_SPARC_DISP8",
+	R_SPARC_DISP16:   "R_SPARC_DISP16",
+	R_SPARC_DISP32:   "R_SPARC_DISP32",
+	R_SPARC_WDISP30:  "R_SPARC_WDISP30",
+	R_SPARC_WDISP22:  "R_SPARC_WDISP22",
+	R_SPARC_HI22:     "R_SPARC_HI22",
+	R_SPARC_22:       "R_SPARC_22",
+	R_SPARC_13:       "R_SPARC_13",
+	R_SPARC_LO10:     "R_SPARC_LO10",
+	R_SPARC_GOT10:    "R_SPARC_GOT10",
+	R_SPARC_GOT13:    "R_SPARC_GOT13",
+	R_SPARC_GOT22:    "R_SPARC_GOT22",
+	R_SPARC_PC10:     "R_SPARC_PC10",
+	R_SPARC_PC22:     "R_SPARC_PC22",
+	R_SPARC_WPLT30:   "R_SPARC_WPLT30",
+	R_SPARC_COPY:     "R_SPARC_COPY",
+	R_SPARC_GLOB_DAT: "R_SPARC_GLOB_DAT",
+	R_SPARC_JMP_SLOT: "R_SPARC_JMP_SLOT",
+	R_SPARC_RELATIVE: "R_SPARC_RELATIVE",
+	R_SPARC_UA32:     "R_SPARC_UA32",
+	R_SPARC_PLT32:    "R_SPARC_PLT32",
+	R_SPARC_HIPLT22:  "R_SPARC_HIPLT22",
+	R_SPARC_LOPLT10:  "R_SPARC_LOPLT10",
+	R_SPARC_PCPLT32:  "R_SPARC_PCPLT32",
+	R_SPARC_PCPLT22:  "R_SPARC_PCPLT22",
+	R_SPARC_PCPLT10:  "R_SPARC_PCPLT10",
+	R_SPARC_10:       "R_SPARC_10",
+	R_SPARC_11:       "R_SPARC_11",
+	R_SPARC_64:       "R_SPARC_64",
+	R_SPARC_OLO10:    "R_SPARC_OLO10",
+	R_SPARC_HH22:     "R_SPARC_HH22",
+	R_SPARC_HM10:     "R_SPARC_HM10",
+	R_SPARC_LM22:     "R_SPARC_LM22",
+	R_SPARC_PC_HH22:  "R_SPARC_PC_HH22",
+	R_SPARC_PC_HM10:  "R_SPARC_PC_HM10",
+	R_SPARC_PC_LM22:  "R_SPARC_PC_LM22",
+	R_SPARC_WDISP16:  "R_SPARC_WDISP16",
+	R_SPARC_WDISP19:  "R_SPARC_WDISP19",
+	R_SPARC_GLOB_JMP: "R_SPARC_GLOB_JMP",
+	R_SPARC_7:        "R_SPARC_7",
+	R_SPARC_5:        "R_SPARC_5",
+	R_SPARC_6:        "R_SPARC_6",
+	R_SPARC_DISP64:   "R_SPARC_DISP64",
+	R_SPARC_PLT64:    "R_SPARC_PLT64",
+	R_SPARC_HIX22:    "R_SPARC_HIX22",
+	R_SPARC_LOX10:    "R_SPARC_LOX10",
+	R_SPARC_H44:      "R_SPARC_H44",
+	R_SPARC_M44:      "R_SPARC_M44",
+	R_SPARC_L44:      "R_SPARC_L44",
+	R_SPARC_REGISTER: "R_SPARC_REGISTER",
+	R_SPARC_UA64:     "R_SPARC_UA64",
+	R_SPARC_UA16:     "R_SPARC_UA16",
+}
+
+func (rt RelocationTypeSPARC) Value() uint32 {
+	return uint32(rt)
+}
+
+func (rt RelocationTypeSPARC) String() string {
+	name, ok := relocationTypeSPARCNames[rt]
+	return relocationTypeName(uint32(rt), name, ok)
+}
+
+// RelocationTypeMIPS enumerates relocation types of MIPS
+type RelocationTypeMIPS uint32
+
+const (
+	R_MIPS_NONE            RelocationTypeMIPS = 0
+	R_MIPS_16              RelocationTypeMIPS = 1
+	R_MIPS_32              RelocationTypeMIPS = 2
+	R_MIPS_REL32           RelocationTypeMIPS = 3
+	R_MIPS_26              RelocationTypeMIPS = 4
+	R_MIPS_HI16            RelocationTypeMIPS = 5  // high 16 bits of symbol value
+	R_MIPS_LO16            RelocationTypeMIPS = 6  // low 16 bits of symbol value
+	R_MIPS_GPREL16         RelocationTypeMIPS = 7  // GP-relative reference
+	R_MIPS_LITERAL         RelocationTypeMIPS = 8  // Reference to literal section
+	R_MIPS_GOT16           RelocationTypeMIPS = 9  // Reference to global offset table
+	R_MIPS_PC16            RelocationTypeMIPS = 10 // 16 bit PC relative reference
+	R_MIPS_CALL16          RelocationTypeMIPS = 11 // 16 bit call through glbl offset tbl
+	R_MIPS_GPREL32         RelocationTypeMIPS = 12
+	R_MIPS_SHIFT5          RelocationTypeMIPS = 16
+	R_MIPS_SHIFT6          RelocationTypeMIPS = 17
+	R_MIPS_64              RelocationTypeMIPS = 18
+	R_MIPS_GOT_DISP        RelocationTypeMIPS = 19
+	R_MIPS_GOT_PAGE        RelocationTypeMIPS = 20
+	R_MIPS_GOT_OFST        RelocationTypeMIPS = 21
+	R_MIPS_GOT_HI16        RelocationTypeMIPS = 22
+	R_MIPS_GOT_LO16        RelocationTypeMIPS = 23
+	R_MIPS_SUB             RelocationTypeMIPS = 24
+	R_MIPS_INSERT_A        RelocationTypeMIPS = 25
+	R_MIPS_INSERT_B        RelocationTypeMIPS = 26
+	R_MIPS_DELETE          RelocationTypeMIPS = 27
+	R_MIPS_HIGHER          RelocationTypeMIPS = 28
+	R_MIPS_HIGHEST         RelocationTypeMIPS = 29
+	R_MIPS_CALL_HI16       RelocationTypeMIPS = 30
+	R_MIPS_CALL_LO16       RelocationTypeMIPS = 31
+	R_MIPS_SCN_DISP        RelocationTypeMIPS = 32
+	R_MIPS_REL16           RelocationTypeMIPS = 33
+	R_MIPS_ADD_IMMEDIATE   RelocationTypeMIPS = 34
+	R_MIPS_PJUMP           RelocationTypeMIPS = 35
+	R_MIPS_RELGOT          RelocationTypeMIPS = 36
+	R_MIPS_JALR            RelocationTypeMIPS = 37
+	R_MIPS_TLS_DTPMOD32    RelocationTypeMIPS = 38  // Module number 32 bit
+	R_MIPS_TLS_DTPREL32    RelocationTypeMIPS = 39  // Module-relative offset 32 bit
+	R_MIPS_TLS_DTPMOD64    RelocationTypeMIPS = 40  // Module number 64 bit
+	R_MIPS_TLS_DTPREL64    RelocationTypeMIPS = 41  // Module-relative offset 64 bit
+	R_MIPS_TLS_GD          RelocationTypeMIPS = 42  // 16 bit GOT offset for GD
+	R_MIPS_TLS_LDM         RelocationTypeMIPS = 43  // 16 bit GOT offset for LDM
+	R_MIPS_TLS_DTPREL_HI16 RelocationTypeMIPS = 44  // Module-relative offset, high 16 bits
+	R_MIPS_TLS_DTPREL_LO16 RelocationTypeMIPS = 45  // Module-relative offset, low 16 bits
+	R_MIPS_TLS_GOTTPREL    RelocationTypeMIPS = 46  // 16 bit GOT offset for IE
+	R_MIPS_TLS_TPREL32     RelocationTypeMIPS = 47  // TP-relative offset, 32 bit
+	R_MIPS_TLS_TPREL64     RelocationTypeMIPS = 48  // TP-relative offset, 64 bit
+	R_MIPS_TLS_TPREL_HI16  RelocationTypeMIPS = 49  // TP-relative offset, high 16 bits
+	R_MIPS_TLS_TPREL_LO16  RelocationTypeMIPS = 50  // TP-relative offset, low 16 bits
+	R_MIPS_PC32            RelocationTypeMIPS = 248 // 32 bit PC relative reference
+)
+
+var relocationTypeMIPSNames = map[RelocationTypeMIPS]string{
+	R_MIPS_NONE:            "R_MIPS_NONE",
+	R_MIPS_16:              "R_MIPS_16",
+	R_MIPS_32:              "R_MIPS_32",
+	R_MIPS_REL32:           "R_MIPS_REL32",
+	R_MIPS_26:              "R_MIPS_26",
+	R_MIPS_HI16:            "R_MIPS_HI16",
+	R_MIPS_LO16:            "R_MIPS_LO16",
+	R_MIPS_GPREL16:         "R_MIPS_GPREL16",
+	R_MIPS_LITERAL:         "R_MIPS_LITERAL",
+	R_MIPS_GOT16:           "R_MIPS_GOT16",
+	R_MIPS_PC16:            "R_MIPS_PC16",
+	R_MIPS_CALL16:          "R_MIPS_CALL16",
+	R_MIPS_GPREL32:         "R_MIPS_GPREL32",
+	R_MIPS_SHIFT5:          "R_MIPS_SHIFT5",
+	R_MIPS_SHIFT6:          "R_MIPS_SHIFT6",
+	R_MIPS_64:              "R_MIPS_64",
+	R_MIPS_GOT_DISP:        "R_MIPS_GOT_DISP",
+	R_MIPS_GOT_PAGE:        "R_MIPS_GOT_PAGE",
+	R_MIPS_GOT_OFST:        "R_MIPS_GOT_OFST",
+	R_MIPS_GOT_HI16:        "R_MIPS_GOT_HI16",
+	R_MIPS_GOT_LO16:        "R_MIPS_GOT_LO16",
+	R_MIPS_SUB:             "R_MIPS_SUB",
+	R_MIPS_INSERT_A:        "R_MIPS_INSERT_A",
+	R_MIPS_INSERT_B:        "R_MIPS_INSERT_B",
+	R_MIPS_DELETE:          "R_MIPS_DELETE",
+	R_MIPS_HIGHER:          "R_MIPS_HIGHER",
+	R_MIPS_HIGHEST:         "R_MIPS_HIGHEST",
+	R_MIPS_CALL_HI16:       "R_MIPS_CALL_HI16",
+	R_MIPS_CALL_LO16:       "R_MIPS_CALL_LO16",
+	R_MIPS_SCN_DISP:        "R_MIPS_SCN_DISP",
+	R_MIPS_REL16:           "R_MIPS_REL16",
+	R_MIPS_ADD_IMMEDIATE:   "R_MIPS_ADD_IMMEDIATE",
+	R_MIPS_PJUMP:           "R_MIPS_PJUMP",
+	R_MIPS_RELGOT:          "R_MIPS_RELGOT",
+	R_MIPS_JALR:            "R_MIPS_JALR",
+	R_MIPS_TLS_DTPMOD32:    "R_MIPS_TLS_DTPMOD32",
+	R_MIPS_TLS_DTPREL32:    "R_MIPS_TLS_DTPREL32",
+	R_MIPS_TLS_DTPMOD64:    "R_MIPS_TLS_DTPMOD64",
+	R_MIPS_TLS_DTPREL64:    "R_MIPS_TLS_DTPREL64",
+	R_MIPS_TLS_GD:          "R_MIPS_TLS_GD",
+	R_MIPS_TLS_LDM:         "R_MIPS_TLS_LDM",
+	R_MIPS_TLS_DTPREL_HI16: "R_MIPS_TLS_DTPREL_HI16",
+	R_MIPS_TLS_DTPREL_LO16: "R_MIPS_TLS_DTPREL_LO16",
+	R_MIPS_TLS_GOTTPREL:    "R_MIPS_TLS_GOTTPREL",
+	R_MIPS_TLS_TPREL32:     "R_MIPS_TLS_TPREL32",
+	R_MIPS_TLS_TPREL64:     "R_MIPS_TLS_TPREL64",
+	R_MIPS_TLS_TPREL_HI16:  "R_MIPS_TLS_TPREL_HI16",
+	R_MIPS_TLS_TPREL_LO16:  "R_MIPS_TLS_TPREL_LO16",
+	R_MIPS_PC32:            "R_MIPS_PC32",
+}
+
+func (rt RelocationTypeMIPS) Value() uint32 {
+	return uint32(rt)
+}
+
+func (rt RelocationTypeMIPS) String() string {
+	name, ok := relocationTypeMIPSNames[rt]
+	return relocationTypeName(uint32(rt), name, ok)
+}
+
+// RelocationTypeSH enumerates relocation types of SuperH
+type RelocationTypeSH uint32
+
+const (
+	R_SH_NONE          RelocationTypeSH = 0
+	R_SH_DIR32         RelocationTypeSH = 1
+	R_SH_REL32         RelocationTypeSH = 2
+	R_SH_DIR8WPN       RelocationTypeSH = 3
+	R_SH_IND12W        RelocationTypeSH = 4
+	R_SH_DIR8WPL       RelocationTypeSH = 5
+	R_SH_DIR8WPZ       RelocationTypeSH = 6
+	R_SH_DIR8BP        RelocationTypeSH = 7
+	R_SH_DIR8W         RelocationTypeSH = 8
+	R_SH_DIR8L         RelocationTypeSH = 9
+	R_SH_SWITCH16      RelocationTypeSH = 25
+	R_SH_SWITCH32      RelocationTypeSH = 26
+	R_SH_USES          RelocationTypeSH = 27
+	R_SH_COUNT         RelocationTypeSH = 28
+	R_SH_ALIGN         RelocationTypeSH = 29
+	R_SH_CODE          RelocationTypeSH = 30
+	R_SH_DATA          RelocationTypeSH = 31
+	R_SH_LABEL         RelocationTypeSH = 32
+	R_SH_SWITCH8       RelocationTypeSH = 33
+	R_SH_GNU_VTINHERIT RelocationTypeSH = 34
+	R_SH_GNU_VTENTRY   RelocationTypeSH = 35
+	R_SH_TLS_GD_32     RelocationTypeSH = 144
+	R_SH_TLS_LD_32     RelocationTypeSH = 145
+	R_SH_TLS_LDO_32    RelocationTypeSH = 146
+	R_SH_TLS_IE_32     RelocationTypeSH = 147
+	R_SH_TLS_LE_32     RelocationTypeSH = 148
+	R_SH_TLS_DTPMOD32  RelocationTypeSH = 149
+	R_SH_TLS_DTPOFF32  RelocationTypeSH = 150
+	R_SH_TLS_TPOFF32   RelocationTypeSH = 151
+	R_SH_GOT32         RelocationTypeSH = 160
+	R_SH_PLT32         RelocationTypeSH = 161
+	R_SH_COPY          RelocationTypeSH = 162
+	R_SH_GLOB_DAT      RelocationTypeSH = 163
+	R_SH_JMP_SLOT      RelocationTypeSH = 164
+	R_SH_RELATIVE      RelocationTypeSH = 165
+	R_SH_GOTOFF        RelocationTypeSH = 166
+	R_SH_GOTPC         RelocationTypeSH = 167 // Keep this the last entry
+)
+
+var relocationTypeSHNames = map[RelocationTypeSH]string{
+	R_SH_NONE:          "R_SH_NONE",
+	R_SH_DIR32:         "R_SH_DIR32",
+	R_SH_REL32:         "R_SH_REL32",
+	R_SH_DIR8WPN:       "R_SH_DIR8WPN",
+	R_SH_IND12W:        "R_SH_IND12W",
+	R_SH_DIR8WPL:       "R_SH_DIR8WPL",
+	R_SH_DIR8WPZ:       "R_SH_DIR8WPZ",
+	R_SH_DIR8BP:        "R_SH_DIR8BP",
+	R_SH_DIR8W:         "R_SH_DIR8W",
+	R_SH_DIR8L:         "R_SH_DIR8L",
+	R_SH_SWITCH16:      "R_SH_SWITCH16",
+	R_SH_SWITCH32:      "R_SH_SWITCH32",
+	R_SH_USES:          "R_SH_USES",
+	R_SH_COUNT:         "R_SH_COUNT",
+	R_SH_ALIGN:         "R_SH_ALIGN",
+	R_SH_CODE:          "R_SH_CODE",
+	R_SH_DATA:          "R_SH_DATA",
+	R_SH_LABEL:         "R_SH_LABEL",
+	R_SH_SWITCH8:       "R_SH_SWITCH8",
+	R_SH_GNU_VTINHERIT: "R_SH_GNU_VTINHERIT",
+	R_SH_GNU_VTENTRY:   "R_SH_GNU_VTENTRY",
+	R_SH_TLS_GD_32:     "R_SH_TLS_GD_32",
+	R_SH_TLS_LD_32:     "R_SH_TLS_LD_32",
+	R_SH_TLS_LDO_32:    "R_SH_TLS_LDO_32",
+	R_SH_TLS_IE_32:     "R_SH_TLS_IE_32",
+	R_SH_TLS_LE_32:     "R_SH_TLS_LE_32",
+	R_SH_TLS_DTPMOD32:  "R_SH_TLS_DTPMOD32",
+	R_SH_TLS_DTPOFF32:  "R_SH_TLS_DTPOFF32",
+	R_SH_TLS_TPOFF32:   "R_SH_TLS_TPOFF32",
+	R_SH_GOT32:         "R_SH_GOT32",
+	R_SH_PLT32:         "R_SH_PLT32",
+	R_SH_COPY:          "R_SH_COPY",
+	R_SH_GLOB_DAT:      "R_SH_GLOB_DAT",
+	R_SH_JMP_SLOT:      "R_SH_JMP_SLOT",
+	R_SH_RELATIVE:      "R_SH_RELATIVE",
+	R_SH_GOTOFF:        "R_SH_GOTOFF",
+	R_SH_GOTPC:         "R_SH_GOTPC",
+}
+
+func (rt RelocationTypeSH) Value() uint32 {
+	return uint32(rt)
+}
+
+func (rt RelocationTypeSH) String() string {
+	name, ok := relocationTypeSHNames[rt]
+	return relocationTypeName(uint32(rt), name, ok)
+}
+
+// RelocationTypeIA64 enumerates relocation types of IA-64
+type RelocationTypeIA64 uint32
+
+const (
+	R_IA64_NONE            RelocationTypeIA64 = 0   // none
+	R_IA64_IMM14           RelocationTypeIA64 = 33  // symbol + addend, add imm14
+	R_IA64_IMM22           RelocationTypeIA64 = 34  // symbol + addend, add imm22
+	R_IA64_IMM64           RelocationTypeIA64 = 35  // symbol + addend, mov imm64
+	R_IA64_DIR32MSB        RelocationTypeIA64 = 36  // symbol + addend, data4 MSB
+	R_IA64_DIR32LSB        RelocationTypeIA64 = 37  // symbol + addend, data4 LSB
+	R_IA64_DIR64MSB        RelocationTypeIA64 = 38  // symbol + addend, data8 MSB
+	R_IA64_DIR64LSB        RelocationTypeIA64 = 39  // symbol + addend, data8 LSB
+	R_IA64_GPREL22         RelocationTypeIA64 = 42  // @gprel(sym + add), add imm22
+	R_IA64_GPREL64I        RelocationTypeIA64 = 43  // @gprel(sym + add), mov imm64
+	R_IA64_GPREL32MSB      RelocationTypeIA64 = 44  // @gprel(sym + add), data4 MSB
+	R_IA64_GPREL32LSB      RelocationTypeIA64 = 45  // @gprel(sym + add), data4 LSB
+	R_IA64_GPREL64MSB      RelocationTypeIA64 = 46  // @gprel(sym + add), data8 MSB
+	R_IA64_GPREL64LSB      RelocationTypeIA64 = 47  // @gprel(sym + add), data8 LSB
+	R_IA64_LTOFF22         RelocationTypeIA64 = 50  // @ltoff(sym + add), add imm22
+	R_IA64_LTOFF64I        RelocationTypeIA64 = 51  // @ltoff(sym + add), mov imm64
+	R_IA64_PLTOFF22        RelocationTypeIA64 = 58  // @pltoff(sym + add), add imm22
+	R_IA64_PLTOFF64I       RelocationTypeIA64 = 59  // @pltoff(sym + add), mov imm64
+	R_IA64_PLTOFF64MSB     RelocationTypeIA64 = 62  // @pltoff(sym + add), data8 MSB
+	R_IA64_PLTOFF64LSB     RelocationTypeIA64 = 63  // @pltoff(sym + add), data8 LSB
+	R_IA64_FPTR64I         RelocationTypeIA64 = 67  // @fptr(sym + add), mov imm64
+	R_IA64_FPTR32MSB       RelocationTypeIA64 = 68  // @fptr(sym + add), data4 MSB
+	R_IA64_FPTR32LSB       RelocationTypeIA64 = 69  // @fptr(sym + add), data4 LSB
+	R_IA64_FPTR64MSB       RelocationTypeIA64 = 70  // @fptr(sym + add), data8 MSB
+	R_IA64_FPTR64LSB       RelocationTypeIA64 = 71  // @fptr(sym + add), data8 LSB
+	R_IA64_PCREL60B        RelocationTypeIA64 = 72  // @pcrel(sym + add), brl
+	R_IA64_PCREL21B        RelocationTypeIA64 = 73  // @pcrel(sym + add), ptb, call
+	R_IA64_PCREL21M        RelocationTypeIA64 = 74  // @pcrel(sym + add), chk.s
+	R_IA64_PCREL21F        RelocationTypeIA64 = 75  // @pcrel(sym + add), fchkf
+	R_IA64_PCREL32MSB      RelocationTypeIA64 = 76  // @pcrel(sym + add), data4 MSB
+	R_IA64_PCREL32LSB      RelocationTypeIA64 = 77  // @pcrel(sym + add), data4 LSB
+	R_IA64_PCREL64MSB      RelocationTypeIA64 = 78  // @pcrel(sym + add), data8 MSB
+	R_IA64_PCREL64LSB      RelocationTypeIA64 = 79  // @pcrel(sym + add), data8 LSB
+	R_IA64_LTOFF_FPTR22    RelocationTypeIA64 = 82  // @ltoff(@fptr(s+a)), imm22
+	R_IA64_LTOFF_FPTR64I   RelocationTypeIA64 = 83  // @ltoff(@fptr(s+a)), imm64
+	R_IA64_LTOFF_FPTR32MSB RelocationTypeIA64 = 84  // @ltoff(@fptr(s+a)), data4 MSB
+	R_IA64_LTOFF_FPTR32LSB RelocationTypeIA64 = 85  // @ltoff(@fptr(s+a)), data4 LSB
+	R_IA64_LTOFF_FPTR64MSB RelocationTypeIA64 = 86  // @ltoff(@fptr(s+a)), data8 MSB
+	R_IA64_LTOFF_FPTR64LSB RelocationTypeIA64 = 87  // @ltoff(@fptr(s+a)), data8 LSB
+	R_IA64_SEGREL32MSB     RelocationTypeIA64 = 92  // @segrel(sym + add), data4 MSB
+	R_IA64_SEGREL32LSB     RelocationTypeIA64 = 93  // @segrel(sym + add), data4 LSB
+	R_IA64_SEGREL64MSB     RelocationTypeIA64 = 94  // @segrel(sym + add), data8 MSB
+	R_IA64_SEGREL64LSB     RelocationTypeIA64 = 95  // @segrel(sym + add), data8 LSB
+	R_IA64_SECREL32MSB     RelocationTypeIA64 = 100 // @secrel(sym + add), data4 MSB
+	R_IA64_SECREL32LSB     RelocationTypeIA64 = 101 // @secrel(sym + add), data4 LSB
+	R_IA64_SECREL64MSB     RelocationTypeIA64 = 102 // @secrel(sym + add), data8 MSB
+	R_IA64_SECREL64LSB     RelocationTypeIA64 = 103 // @secrel(sym + add), data8 LSB
+	R_IA64_REL32MSB        RelocationTypeIA64 = 108 // data 4 + REL
+	R_IA64_REL32LSB        RelocationTypeIA64 = 109 // data 4 + REL
+	R_IA64_REL64MSB        RelocationTypeIA64 = 110 // data 8 + REL
+	R_IA64_REL64LSB        RelocationTypeIA64 = 111 // data 8 + REL
+	R_IA64_LTV32MSB        RelocationTypeIA64 = 116 // symbol + addend, data4 MSB
+	R_IA64_LTV32LSB        RelocationTypeIA64 = 117 // symbol + addend, data4 LSB
+	R_IA64_LTV64MSB        RelocationTypeIA64 = 118 // symbol + addend, data8 MSB
+	R_IA64_LTV64LSB        RelocationTypeIA64 = 119 // symbol + addend, data8 LSB
+	R_IA64_PCREL21BI       RelocationTypeIA64 = 121 // @pcrel(sym + add), 21bit inst
+	R_IA64_PCREL22         RelocationTypeIA64 = 122 // @pcrel(sym + add), 22bit inst
+	R_IA64_PCREL64I        RelocationTypeIA64 = 123 // @pcrel(sym + add), 64bit inst
+	R_IA64_IPLTMSB         RelocationTypeIA64 = 128 // dynamic reloc, imported PLT, MSB
+	R_IA64_IPLTLSB         RelocationTypeIA64 = 129 // dynamic reloc, imported PLT, LSB
+	R_IA64_COPY            RelocationTypeIA64 = 132 // copy relocation
+	R_IA64_SUB             RelocationTypeIA64 = 133 // Addend and symbol difference
+	R_IA64_LTOFF22X        RelocationTypeIA64 = 134 // LTOFF22, relaxable
+	R_IA64_LDXMOV          RelocationTypeIA64 = 135 // Use of LTOFF22X
+	R_IA64_TPREL14         RelocationTypeIA64 = 145 // @tprel(sym + add), imm14
+	R_IA64_TPREL22         RelocationTypeIA64 = 146 // @tprel(sym + add), imm22
+	R_IA64_TPREL64I        RelocationTypeIA64 = 147 // @tprel(sym + add), imm64
+	R_IA64_TPREL64MSB      RelocationTypeIA64 = 150 // @tprel(sym + add), data8 MSB
+	R_IA64_TPREL64LSB      RelocationTypeIA64 = 151 // @tprel(sym + add), data8 LSB
+	R_IA64_LTOFF_TPREL22   RelocationTypeIA64 = 154 // @ltoff(@tprel(s+a)), imm2
+	R_IA64_DTPMOD64MSB     RelocationTypeIA64 = 166 // @dtpmod(sym + add), data8 MSB
+	R_IA64_DTPMOD64LSB     RelocationTypeIA64 = 167 // @dtpmod(sym + add), data8 LSB
+	R_IA64_LTOFF_DTPMOD22  RelocationTypeIA64 = 170 // @ltoff(@dtpmod(sym + add)), imm22
+	R_IA64_DTPREL14        RelocationTypeIA64 = 177 // @dtprel(sym + add), imm14
+	R_IA64_DTPREL22        RelocationTypeIA64 = 178 // @dtprel(sym + add), imm22
+	R_IA64_DTPREL64I       RelocationTypeIA64 = 179 // @dtprel(sym + add), imm64
+	R_IA64_DTPREL32MSB     RelocationTypeIA64 = 180 // @dtprel(sym + add), data4 MSB
+	R_IA64_DTPREL32LSB     RelocationTypeIA64 = 181 // @dtprel(sym + add), data4 LSB
+	R_IA64_DTPREL64MSB     RelocationTypeIA64 = 182 // @dtprel(sym + add), data8 MSB
+	R_IA64_DTPREL64LSB     RelocationTypeIA64 = 183 // @dtprel(sym + add), data8 LSB
+	R_IA64_LTOFF_DTPREL22  RelocationTypeIA64 = 186 // @ltoff(@dtprel(s+a)), imm22
+)
+
+var relocationTypeIA64Names = map[RelocationTypeIA64]string{
+	R_IA64_NONE:            "R_IA64_NONE",
+	R_IA64_IMM14:           "R_IA64_IMM14",
+	R_IA64_IMM22:           "R_IA64_IMM22",
+	R_IA64_IMM64:           "R_IA64_IMM64",
+	R_IA64_DIR32MSB:        "R_IA64_DIR32MSB",
+	R_IA64_DIR32LSB:        "R_IA64_DIR32LSB",
+	R_IA64_DIR64MSB:        "R_IA64_DIR64MSB",
+	R_IA64_DIR64LSB:        "R_IA64_DIR64LSB",
+	R_IA64_GPREL22:         "R_IA64_GPREL22",
+	R_IA64_GPREL64I:        "R_IA64_GPREL64I",
+	R_IA64_GPREL32MSB:      "R_IA64_GPREL32MSB",
+	R_IA64_GPREL32LSB:      "R_IA64_GPREL32LSB",
+	R_IA64_GPREL64MSB:      "R_IA64_GPREL64MSB",
+	R_IA64_GPREL64LSB:      "R_IA64_GPREL64LSB",
+	R_IA64_LTOFF22:         "R_IA64_LTOFF22",
+	R_IA64_LTOFF64I:        "R_IA64_LTOFF64I",
+	R_IA64_PLTOFF22:        "R_IA64_PLTOFF22",
+	R_IA64_PLTOFF64I:       "R_IA64_PLTOFF64I",
+	R_IA64_PLTOFF64MSB:     "R_IA64_PLTOFF64MSB",
+	R_IA64_PLTOFF64LSB:     "R_IA64_PLTOFF64LSB",
+	R_IA64_FPTR64I:         "R_IA64_FPTR64I",
+	R_IA64_FPTR32MSB:       "R_IA64_FPTR32MSB",
+	R_IA64_FPTR32LSB:       "R_IA64_FPTR32LSB",
+	R_IA64_FPTR64MSB:       "R_IA64_FPTR64MSB",
+	R_IA64_FPTR64LSB:       "R_IA64_FPTR64LSB",
+	R_IA64_PCREL60B:        "R_IA64_PCREL60B",
+	R_IA64_PCREL21B:        "R_IA64_PCREL21B",
+	R_IA64_PCREL21M:        "R_IA64_PCREL21M",
+	R_IA64_PCREL21F:        "R_IA64_PCREL21F",
+	R_IA64_PCREL32MSB:      "R_IA64_PCREL32MSB",
+	R_IA64_PCREL32LSB:      "R_IA64_PCREL32LSB",
+	R_IA64_PCREL64MSB:      "R_IA64_PCREL64MSB",
+	R_IA64_PCREL64LSB:      "R_IA64_PCREL64LSB",
+	R_IA64_LTOFF_FPTR22:    "R_IA64_LTOFF_FPTR22",
+	R_IA64_LTOFF_FPTR64I:   "R_IA64_LTOFF_FPTR64I",
+	R_IA64_LTOFF_FPTR32MSB: "R_IA64_LTOFF_FPTR32MSB",
+	R_IA64_LTOFF_FPTR32LSB: "R_IA64_LTOFF_FPTR32LSB",
+	R_IA64_LTOFF_FPTR64MSB: "R_IA64_LTOFF_FPTR64MSB",
+	R_IA64_LTOFF_FPTR64LSB: "R_IA64_LTOFF_FPTR64LSB",
+	R_IA64_SEGREL32MSB:     "R_IA64_SEGREL32MSB",
+	R_IA64_SEGREL32LSB:     "R_IA64_SEGREL32LSB",
+	R_IA64_SEGREL64MSB:     "R_IA64_SEGREL64MSB",
+	R_IA64_SEGREL64LSB:     "R_IA64_SEGREL64LSB",
+	R_IA64_SECREL32MSB:     "R_IA64_SECREL32MSB",
+	R_IA64_SECREL32LSB:     "R_IA64_SECREL32LSB",
+	R_IA64_SECREL64MSB:     "R_IA64_SECREL64MSB",
+	R_IA64_SECREL64LSB:     "R_IA64_SECREL64LSB",
+	R_IA64_REL32MSB:        "R_IA64_REL32MSB",
+	R_IA64_REL32LSB:        "R_IA64_REL32LSB",
+	R_IA64_REL64MSB:        "R_IA64_REL64MSB",
+	R_IA64_REL64LSB:        "R_IA64_REL64LSB",
+	R_IA64_LTV32MSB:        "R_IA64_LTV32MSB",
+	R_IA64_LTV32LSB:        "R_IA64_LTV32LSB",
+	R_IA64_LTV64MSB:        "R_IA64_LTV64MSB",
+	R_IA64_LTV64LSB:        "R_IA64_LTV64LSB",
+	R_IA64_PCREL21BI:       "R_IA64_PCREL21BI",
+	R_IA64_PCREL22:         "R_IA64_PCREL22",
+	R_IA64_PCREL64I:        "R_IA64_PCREL64I",
+	R_IA64_IPLTMSB:         "R_IA64_IPLTMSB",
+	R_IA64_IPLTLSB:         "R_IA64_IPLTLSB",
+	R_IA64_COPY:            "R_IA64_COPY",
+	R_IA64_SUB:             "R_IA64_SUB",
+	R_IA64_LTOFF22X:        "R_IA64_LTOFF22X",
+	R_IA64_LDXMOV:          "R_IA64_LDXMOV",
+	R_IA64_TPREL14:         "R_IA64_TPREL14",
+	R_IA64_TPREL22:         "R_IA64_TPREL22",
+	R_IA64_TPREL64I:        "R_IA64_TPREL64I",
+	R_IA64_TPREL64MSB:      "R_IA64_TPREL64MSB",
+	R_IA64_TPREL64LSB:      "R_IA64_TPREL64LSB",
+	R_IA64_LTOFF_TPREL22:   "R_IA64_LTOFF_TPREL22",
+	R_IA64_DTPMOD64MSB:     "R_IA64_DTPMOD64MSB",
+	R_IA64_DTPMOD64LSB:     "R_IA64_DTPMOD64LSB",
+	R_IA64_LTOFF_DTPMOD22:  "R_IA64_LTOFF_DTPMOD22",
+	R_IA64_DTPREL14:        "R_IA64_DTPREL14",
+	R_IA64_DTPREL22:        "R_IA64_DTPREL22",
+	R_IA64_DTPREL64I:       "R_IA64_DTPREL64I",
+	R_IA64_DTPREL32MSB:     "R_IA64_DTPREL32MSB",
+	R_IA64_DTPREL32LSB:     "R_IA64_DTPREL32LSB",
+	R_IA64_DTPREL64MSB:     "R_IA64_DTPREL64MSB",
+	R_IA64_DTPREL64LSB:     "R_IA64_DTPREL64LSB",
+	R_IA64_LTOFF_DTPREL22:  "R_IA64_LTOFF_DTPREL22",
+}
+
+func (rt RelocationTypeIA64) Value() uint32 {
+	return uint32(rt)
+}
+
+func (rt RelocationTypeIA64) String() string {
+	name, ok := relocationTypeIA64Names[rt]
+	return relocationTypeName(uint32(rt), name, ok)
+}
+
+// RelocationTypeC6000 enumerates relocation types of TMS320C6000
+type RelocationTypeC6000 uint32
+
+const (
+	R_C6000_NONE          RelocationTypeC6000 = 0
+	R_C6000_ABS32         RelocationTypeC6000 = 1
+	R_C6000_ABS16         RelocationTypeC6000 = 2
+	R_C6000_ABS8          RelocationTypeC6000 = 3
+	R_C6000_PCR_S21       RelocationTypeC6000 = 4
+	R_C6000_PCR_S12       RelocationTypeC6000 = 5
+	R_C6000_PCR_S10       RelocationTypeC6000 = 6
+	R_C6000_PCR_S7        RelocationTypeC6000 = 7
+	R_C6000_ABS_S16       RelocationTypeC6000 = 8
+	R_C6000_ABS_L16       RelocationTypeC6000 = 9
+	R_C6000_ABS_H16       RelocationTypeC6000 = 10
+	R_C6000_SBR_U15_B     RelocationTypeC6000 = 11
+	R_C6000_SBR_U15_H     RelocationTypeC6000 = 12
+	R_C6000_SBR_U15_W     RelocationTypeC6000 = 13
+	R_C6000_SBR_S16       RelocationTypeC6000 = 14
+	R_C6000_SBR_L16_B     RelocationTypeC6000 = 15
+	R_C6000_SBR_L16_H     RelocationTypeC6000 = 16
+	R_C6000_SBR_L16_W     RelocationTypeC6000 = 17
+	R_C6000_SBR_H16_B     RelocationTypeC6000 = 18
+	R_C6000_SBR_H16_H     RelocationTypeC6000 = 19
+	R_C6000_SBR_H16_W     RelocationTypeC6000 = 20
+	R_C6000_SBR_GOT_U15_W RelocationTypeC6000 = 21
+	R_C6000_SBR_GOT_L16_W RelocationTypeC6000 = 22
+	R_C6000_SBR_GOT_H16_W RelocationTypeC6000 = 23
+	R_C6000_DSBT_INDEX    RelocationTypeC6000 = 24
+	R_C6000_PREL31        RelocationTypeC6000 = 25
+	R_C6000_COPY          RelocationTypeC6000 = 26
+	R_C6000_JUMP_SLOT     RelocationTypeC6000 = 27
+	R_C6000_EHTYPE        RelocationTypeC6000 = 28
+	R_C6000_PCR_H16       RelocationTypeC6000 = 29
+	R_C6000_PCR_L16       RelocationTypeC6000 = 30
+	R_C6000_ALIGN         RelocationTypeC6000 = 253
+	R_C6000_FPHEAD        RelocationTypeC6000 = 254
+	R_C6000_NOCMP         RelocationTypeC6000 = 255
+)
+
+var relocationTypeC6000Names = map[RelocationTypeC6000]string{
+	R_C6000_NONE:          "R_C6000_NONE",
+	R_C6000_ABS32:         "R_C6000_ABS32",
+	R_C6000_ABS16:         "R_C6000_ABS16",
+	R_C6000_ABS8:          "R_C6000_ABS8",
+	R_C6000_PCR_S21:       "R_C6000_PCR_S21",
+	R_C6000_PCR_S12:       "R_C6000_PCR_S12",
+	R_C6000_PCR_S10:       "R_C6000_PCR_S10",
+	R_C6000_PCR_S7:        "R_C6000_PCR_S7",
+	R_C6000_ABS_S16:       "R_C6000_ABS_S16",
+	R_C6000_ABS_L16:       "R_C6000_ABS_L16",
+	R_C6000_ABS_H16:       "R_C6000_ABS_H16",
+	R_C6000_SBR_U15_B:     "R_C6000_SBR_U15_B",
+	R_C6000_SBR_U15_H:     "R_C6000_SBR_U15_H",
+	R_C6000_SBR_U15_W:     "R_C6000_SBR_U15_W",
+	R_C6000_SBR_S16:       "R_C6000_SBR_S16",
+	R_C6000_SBR_L16_B:     "R_C6000_SBR_L16_B",
+	R_C6000_SBR_L16_H:     "R_C6000_SBR_L16_H",
+	R_C6000_SBR_L16_W:     "R_C6000_SBR_L16_W",
+	R_C6000_SBR_H16_B:     "R_C6000_SBR_H16_B",
+	R_C6000_SBR_H16_H:     "R_C6000_SBR_H16_H",
+	R_C6000_SBR_H16_W:     "R_C6000_SBR_H16_W",
+	R_C6000_SBR_GOT_U15_W: "R_C6000_SBR_GOT_U15_W",
+	R_C6000_SBR_GOT_L16_W: "R_C6000_SBR_GOT_L16_W",
+	R_C6000_SBR_GOT_H16_W: "R_C6000_SBR_GOT_H16_W",
+	R_C6000_DSBT_INDEX:    "R_C6000_DSBT_INDEX",
+	R_C6000_PREL31:        "R_C6000_PREL31",
+	R_C6000_COPY:          "R_C6000_COPY",
+	R_C6000_JUMP_SLOT:     "R_C6000_JUMP_SLOT",
+	R_C6000_EHTYPE:        "R_C6000_EHTYPE",
+	R_C6000_PCR_H16:       "R_C6000_PCR_H16",
+	R_C6000_PCR_L16:       "R_C6000_PCR_L16",
+	R_C6000_ALIGN:         "R_C6000_ALIGN",
+	R_C6000_FPHEAD:        "R_C6000_FPHEAD",
+	R_C6000_NOCMP:         "R_C6000_NOCMP",
+}
+
+func (rt RelocationTypeC6000) Value() uint32 {
+	return uint32(rt)
+}
+
+func (rt RelocationTypeC6000) String() string {
+	name, ok := relocationTypeC6000Names[rt]
+	return relocationTypeName(uint32(rt), name, ok)
+}
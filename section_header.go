@@ -9,80 +9,346 @@ type SectionType uint32
 
 const (
 	//0x0	SHT_NULL	Section header table entry unused
-	SectionTypeNull SectionType = iota
+	SectionTypeNull SectionType = 0x0
 	//0x1	SHT_PROGBITS	Program data
-	SectionTypeProgBits
+	SectionTypeProgBits SectionType = 0x1
 	//0x2	SHT_SYMTAB	Symbol table
-	SectionTypeSymTable
+	SectionTypeSymTable SectionType = 0x2
 	//0x3	SHT_STRTAB	String table
-	SectionTypeStrTable
+	SectionTypeStrTable SectionType = 0x3
 	//0x4	SHT_RELA	Relocation entries with addends
-	SectionTypeRelocEnt
+	SectionTypeRelocEnt SectionType = 0x4
 	//0x5	SHT_HASH	Symbol hash table
-	SectionTypeSymHash
+	SectionTypeSymHash SectionType = 0x5
 	//0x6	SHT_DYNAMIC	Dynamic linking information
-	SectionTypeDynLinkInfo
+	SectionTypeDynLinkInfo SectionType = 0x6
 	//0x7	SHT_NOTE	Notes
-	SectionTypeNotes
+	SectionTypeNotes SectionType = 0x7
 	//0x8	SHT_NOBITS	Program space with no data (bss)
-	SectionTypeBSS
+	SectionTypeBSS SectionType = 0x8
 	//0x9	SHT_REL	Relocation entries, no addends
-	SectionTypeRelocEntNA
-	//0x0A	SHT_SHLIB	Reserved
-	SectionTypeReserved
-	//0x0B	SHT_DYNSYM	Dynamic linker symbol table
-	SectionTypeDynLinkSymTab
-	//0x0E	SHT_INIT_ARRAY	Array of constructors
-	SectionTypeArrayOfConstr = iota + 2
-	//0x0F	SHT_FINI_ARRAY	Array of destructors
-	SectionTypeArrayOfDestr
+	SectionTypeRelocEntNA SectionType = 0x9
+	//0xA	SHT_SHLIB	Reserved
+	SectionTypeReserved SectionType = 0xA
+	//0xB	SHT_DYNSYM	Dynamic linker symbol table
+	SectionTypeDynLinkSymTab SectionType = 0xB
+	//0xE	SHT_INIT_ARRAY	Array of constructors
+	SectionTypeArrayOfConstr SectionType = 0xE
+	//0xF	SHT_FINI_ARRAY	Array of destructors
+	SectionTypeArrayOfDestr SectionType = 0xF
 	//0x10	SHT_PREINIT_ARRAY	Array of pre-constructors
-	SectionTypeArrayOfPreConstr
+	SectionTypeArrayOfPreConstr SectionType = 0x10
 	//0x11	SHT_GROUP	Section group
-	SectionTypeSectionGroup
+	SectionTypeSectionGroup SectionType = 0x11
 	//0x12	SHT_SYMTAB_SHNDX	Extended section indices
-	SectionTypeExtSectionInd
-	//0x13	SHT_NUM	Number of defined types.
-	SectionTypeDefinedTypesNum
+	SectionTypeExtSectionInd SectionType = 0x12
+	//0x13	SHT_RELR	Relative relocations in compact form
+	SectionTypeRelr SectionType = 0x13
 	//0x60000000	SHT_LOOS	Start OS-specific.
-	SectionTypeOSSpecific = 0x60000000
+	SectionTypeOSSpecific SectionType = 0x60000000
+	//0x60000001	SHT_ANDROID_REL	Android packed relocations without addends
+	SectionTypeAndroidRel SectionType = 0x60000001
+	//0x60000002	SHT_ANDROID_RELA	Android packed relocations with addends
+	SectionTypeAndroidRela SectionType = 0x60000002
+	//0x6FFF4700	SHT_GNU_INCREMENTAL_INPUTS	Gold incremental linking inputs
+	SectionTypeGNUIncrementalInputs SectionType = 0x6FFF4700
+	//0x6FFF4C00	SHT_LLVM_ODRTAB	LLVM ODR table
+	SectionTypeLLVMODRTab SectionType = 0x6FFF4C00
+	//0x6FFF4C01	SHT_LLVM_LINKER_OPTIONS	LLVM linker options
+	SectionTypeLLVMLinkerOptions SectionType = 0x6FFF4C01
+	//0x6FFF4C02	SHT_LLVM_CALL_GRAPH_PROFILE_V0	LLVM call graph profile, deprecated form
+	SectionTypeLLVMCallGraphProfileV0 SectionType = 0x6FFF4C02
+	//0x6FFF4C03	SHT_LLVM_ADDRSIG	LLVM address-significance table
+	SectionTypeLLVMAddrSig SectionType = 0x6FFF4C03
+	//0x6FFF4C04	SHT_LLVM_DEPENDENT_LIBRARIES	LLVM dependent libraries
+	SectionTypeLLVMDependentLibraries SectionType = 0x6FFF4C04
+	//0x6FFF4C05	SHT_LLVM_SYMPART	LLVM symbol partition specification
+	SectionTypeLLVMSymPart SectionType = 0x6FFF4C05
+	//0x6FFF4C06	SHT_LLVM_PART_EHDR	LLVM ELF header of loadable partition
+	SectionTypeLLVMPartEHdr SectionType = 0x6FFF4C06
+	//0x6FFF4C07	SHT_LLVM_PART_PHDR	LLVM program headers of loadable partition
+	SectionTypeLLVMPartPHdr SectionType = 0x6FFF4C07
+	//0x6FFF4C08	SHT_LLVM_BB_ADDR_MAP_V0	LLVM basic block address map, deprecated form
+	SectionTypeLLVMBBAddrMapV0 SectionType = 0x6FFF4C08
+	//0x6FFF4C09	SHT_LLVM_CALL_GRAPH_PROFILE	LLVM call graph profile
+	SectionTypeLLVMCallGraphProfile SectionType = 0x6FFF4C09
+	//0x6FFF4C0A	SHT_LLVM_BB_ADDR_MAP	LLVM basic block address map
+	SectionTypeLLVMBBAddrMap SectionType = 0x6FFF4C0A
+	//0x6FFF4C0B	SHT_LLVM_OFFLOADING	LLVM device offloading data
+	SectionTypeLLVMOffloading SectionType = 0x6FFF4C0B
+	//0x6FFF4C0C	SHT_LLVM_LTO	LLVM bitcode for fat LTO
+	SectionTypeLLVMLTO SectionType = 0x6FFF4C0C
+	//0x6FFFFF00	SHT_ANDROID_RELR	Android relative relocations, pre SHT_RELR form
+	SectionTypeAndroidRelr SectionType = 0x6FFFFF00
+	//0x6FFFFFF5	SHT_GNU_ATTRIBUTES	Object attributes
+	SectionTypeGNUAttributes SectionType = 0x6FFFFFF5
+	//0x6FFFFFF6	SHT_GNU_HASH	GNU-style hash table
+	SectionTypeGNUHash SectionType = 0x6FFFFFF6
+	//0x6FFFFFF7	SHT_GNU_LIBLIST	Prelink library list
+	SectionTypeGNULibList SectionType = 0x6FFFFFF7
+	//0x6FFFFFF8	SHT_CHECKSUM	Checksum for DSO content
+	SectionTypeChecksum SectionType = 0x6FFFFFF8
+	//0x6FFFFFFA	SHT_SUNW_move	Sun partially initialized data
+	SectionTypeSunMove SectionType = 0x6FFFFFFA
+	//0x6FFFFFFB	SHT_SUNW_COMDAT	Sun COMDAT section
+	SectionTypeSunCOMDAT SectionType = 0x6FFFFFFB
+	//0x6FFFFFFC	SHT_SUNW_syminfo	Sun symbol information
+	SectionTypeSunSymInfo SectionType = 0x6FFFFFFC
+	//0x6FFFFFFD	SHT_GNU_verdef	Version definition section
+	SectionTypeGNUVerDef SectionType = 0x6FFFFFFD
+	//0x6FFFFFFE	SHT_GNU_verneed	Version needs section
+	SectionTypeGNUVerNeed SectionType = 0x6FFFFFFE
+	//0x6FFFFFFF	SHT_GNU_versym	Version symbol table
+	SectionTypeGNUVerSym SectionType = 0x6FFFFFFF
+	//0x6FFFFFFF	SHT_HIOS	End OS-specific.
+	SectionTypeHighOS SectionType = 0x6FFFFFFF
+	//0x70000000	SHT_LOPROC	Start processor-specific.
+	SectionTypeLowProc SectionType = 0x70000000
+	//0x7FFFFFFF	SHT_HIPROC	End processor-specific.
+	SectionTypeHighProc SectionType = 0x7FFFFFFF
+	//0x80000000	SHT_LOUSER	Start application-specific.
+	SectionTypeLowUser SectionType = 0x80000000
+	//0xFFFFFFFF	SHT_HIUSER	End application-specific.
+	SectionTypeHighUser SectionType = 0xFFFFFFFF
+
+	// Deprecated: SHT_NUM was number of generic types, 0x13 is SHT_RELR now
+	SectionTypeDefinedTypesNum SectionType = 0x13
 )
 
+var sectionTypeNames = newConstantNames("SectionType", []constantName{
+	{0x0, "SHT_NULL", "SectionTypeNull", "NULL"},
+	{0x1, "SHT_PROGBITS", "SectionTypeProgBits", "PROGBITS"},
+	{0x2, "SHT_SYMTAB", "SectionTypeSymTable", "SYMTAB"},
+	{0x3, "SHT_STRTAB", "SectionTypeStrTable", "STRTAB"},
+	{0x4, "SHT_RELA", "SectionTypeRelocEnt", "RELA"},
+	{0x5, "SHT_HASH", "SectionTypeSymHash", "HASH"},
+	{0x6, "SHT_DYNAMIC", "SectionTypeDynLinkInfo", "DYNAMIC"},
+	{0x7, "SHT_NOTE", "SectionTypeNotes", "NOTE"},
+	{0x8, "SHT_NOBITS", "SectionTypeBSS", "NOBITS"},
+	{0x9, "SHT_REL", "SectionTypeRelocEntNA", "REL"},
+	{0xA, "SHT_SHLIB", "SectionTypeReserved", "SHLIB"},
+	{0xB, "SHT_DYNSYM", "SectionTypeDynLinkSymTab", "DYNSYM"},
+	{0xE, "SHT_INIT_ARRAY", "SectionTypeArrayOfConstr", "INIT_ARRAY"},
+	{0xF, "SHT_FINI_ARRAY", "SectionTypeArrayOfDestr", "FINI_ARRAY"},
+	{0x10, "SHT_PREINIT_ARRAY", "SectionTypeArrayOfPreConstr", "PREINIT_ARRAY"},
+	{0x11, "SHT_GROUP", "SectionTypeSectionGroup", "GROUP"},
+	{0x12, "SHT_SYMTAB_SHNDX", "SectionTypeExtSectionInd", "SYMTAB_SHNDX"},
+	{0x13, "SHT_RELR", "SectionTypeRelr", "RELR"},
+	{0x60000000, "SHT_LOOS", "SectionTypeOSSpecific", ""},
+	{0x60000001, "SHT_ANDROID_REL", "SectionTypeAndroidRel", "ANDROID_REL"},
+	{0x60000002, "SHT_ANDROID_RELA", "SectionTypeAndroidRela", "ANDROID_RELA"},
+	{0x6FFF4700, "SHT_GNU_INCREMENTAL_INPUTS", "SectionTypeGNUIncrementalInputs", "GNU_INCREMENTAL_INPUTS"},
+	{0x6FFF4C00, "SHT_LLVM_ODRTAB", "SectionTypeLLVMODRTab", "LLVM_ODRTAB"},
+	{0x6FFF4C01, "SHT_LLVM_LINKER_OPTIONS", "SectionTypeLLVMLinkerOptions", "LLVM_LINKER_OPTIONS"},
+	{0x6FFF4C02, "SHT_LLVM_CALL_GRAPH_PROFILE_V0", "SectionTypeLLVMCallGraphProfileV0", "LLVM_CALL_GRAPH_PROFILE_V0"},
+	{0x6FFF4C03, "SHT_LLVM_ADDRSIG", "SectionTypeLLVMAddrSig", "LLVM_ADDRSIG"},
+	{0x6FFF4C04, "SHT_LLVM_DEPENDENT_LIBRARIES", "SectionTypeLLVMDependentLibraries", "LLVM_DEPENDENT_LIBRARIES"},
+	{0x6FFF4C05, "SHT_LLVM_SYMPART", "SectionTypeLLVMSymPart", "LLVM_SYMPART"},
+	{0x6FFF4C06, "SHT_LLVM_PART_EHDR", "SectionTypeLLVMPartEHdr", "LLVM_PART_EHDR"},
+	{0x6FFF4C07, "SHT_LLVM_PART_PHDR", "SectionTypeLLVMPartPHdr", "LLVM_PART_PHDR"},
+	{0x6FFF4C08, "SHT_LLVM_BB_ADDR_MAP_V0", "SectionTypeLLVMBBAddrMapV0", "LLVM_BB_ADDR_MAP_V0"},
+	{0x6FFF4C09, "SHT_LLVM_CALL_GRAPH_PROFILE", "SectionTypeLLVMCallGraphProfile", "LLVM_CALL_GRAPH_PROFILE"},
+	{0x6FFF4C0A, "SHT_LLVM_BB_ADDR_MAP", "SectionTypeLLVMBBAddrMap", "LLVM_BB_ADDR_MAP"},
+	{0x6FFF4C0B, "SHT_LLVM_OFFLOADING", "SectionTypeLLVMOffloading", "LLVM_OFFLOADING"},
+	{0x6FFF4C0C, "SHT_LLVM_LTO", "SectionTypeLLVMLTO", "LLVM_LTO"},
+	{0x6FFFFF00, "SHT_ANDROID_RELR", "SectionTypeAndroidRelr", "ANDROID_RELR"},
+	{0x6FFFFFF5, "SHT_GNU_ATTRIBUTES", "SectionTypeGNUAttributes", "GNU_ATTRIBUTES"},
+	{0x6FFFFFF6, "SHT_GNU_HASH", "SectionTypeGNUHash", "GNU_HASH"},
+	{0x6FFFFFF7, "SHT_GNU_LIBLIST", "SectionTypeGNULibList", "GNU_LIBLIST"},
+	{0x6FFFFFF8, "SHT_CHECKSUM", "SectionTypeChecksum", "CHECKSUM"},
+	{0x6FFFFFFA, "SHT_SUNW_move", "SectionTypeSunMove", "SUNW_move"},
+	{0x6FFFFFFB, "SHT_SUNW_COMDAT", "SectionTypeSunCOMDAT", "SUNW_COMDAT"},
+	{0x6FFFFFFC, "SHT_SUNW_syminfo", "SectionTypeSunSymInfo", "SUNW_syminfo"},
+	{0x6FFFFFFD, "SHT_GNU_verdef", "SectionTypeGNUVerDef", "VERDEF"},
+	{0x6FFFFFFE, "SHT_GNU_verneed", "SectionTypeGNUVerNeed", "VERNEED"},
+	{0x6FFFFFFF, "SHT_GNU_versym", "SectionTypeGNUVerSym", "VERSYM"},
+	{0x6FFFFFFF, "SHT_HIOS", "SectionTypeHighOS", ""},
+	{0x70000000, "SHT_LOPROC", "SectionTypeLowProc", ""},
+	{0x7FFFFFFF, "SHT_HIPROC", "SectionTypeHighProc", ""},
+	{0x80000000, "SHT_LOUSER", "SectionTypeLowUser", ""},
+	{0xFFFFFFFF, "SHT_HIUSER", "SectionTypeHighUser", ""},
+})
+
 type SectionFlags uint64
 
+const (
+	//0x1	SHF_WRITE	Writable
+	SectionFlagWrite SectionFlags = 0x1
+	//0x2	SHF_ALLOC	Occupies memory during execution
+	SectionFlagAlloc SectionFlags = 0x2
+	//0x4	SHF_EXECINSTR	Executable
+	SectionFlagExecInstr SectionFlags = 0x4
+	//0x10	SHF_MERGE	Might be merged
+	SectionFlagMerge SectionFlags = 0x10
+	//0x20	SHF_STRINGS	Contains null-terminated strings
+	SectionFlagStrings SectionFlags = 0x20
+	//0x40	SHF_INFO_LINK	sh_info contains section header table index
+	SectionFlagInfoLink SectionFlags = 0x40
+	//0x80	SHF_LINK_ORDER	Preserve order after combining
+	SectionFlagLinkOrder SectionFlags = 0x80
+	//0x100	SHF_OS_NONCONFORMING	Non-standard OS specific handling required
+	SectionFlagOSNonConforming SectionFlags = 0x100
+	//0x200	SHF_GROUP	Section is member of a group
+	SectionFlagGroup SectionFlags = 0x200
+	//0x400	SHF_TLS	Section holds thread-local data
+	SectionFlagTLS SectionFlags = 0x400
+	//0x800	SHF_COMPRESSED	Section content is compressed
+	SectionFlagCompressed SectionFlags = 0x800
+	//0x200000	SHF_GNU_RETAIN	Section is not garbage collected by linker
+	SectionFlagGNURetain SectionFlags = 0x200000
+	//0x80000000	SHF_EXCLUDE	Section is excluded unless referenced or allocated
+	SectionFlagExclude SectionFlags = 0x80000000
+	//0x0FF00000	SHF_MASKOS	OS-specific
+	SectionFlagMaskOS SectionFlags = 0x0ff00000
+	//0xF0000000	SHF_MASKPROC	Processor-specific
+	SectionFlagMaskProc SectionFlags = 0xf0000000
+)
+
+// sectionFlagLetters are readelf letters of known flags in bit order
+var sectionFlagLetters = []struct {
+	flag   SectionFlags
+	letter byte
+}{
+	{SectionFlagWrite, 'W'},
+	{SectionFlagAlloc, 'A'},
+	{SectionFlagExecInstr, 'X'},
+	{SectionFlagMerge, 'M'},
+	{SectionFlagStrings, 'S'},
+	{SectionFlagInfoLink, 'I'},
+	{SectionFlagLinkOrder, 'L'},
+	{SectionFlagOSNonConforming, 'O'},
+	{SectionFlagGroup, 'G'},
+	{SectionFlagTLS, 'T'},
+	{SectionFlagCompressed, 'C'},
+	{SectionFlagGNURetain, 'R'},
+	{SectionFlagExclude, 'E'},
+}
+
+const sectionFlagsKnown = SectionFlagWrite | SectionFlagAlloc | SectionFlagExecInstr | SectionFlagMerge | SectionFlagStrings |
+	SectionFlagInfoLink | SectionFlagLinkOrder | SectionFlagOSNonConforming | SectionFlagGroup | SectionFlagTLS |
+	SectionFlagCompressed | SectionFlagGNURetain | SectionFlagExclude
+
+func (sf SectionFlags) HasSet(mask uint64) bool {
+	return uint64(sf)&mask > 0
+}
+
+func (sf SectionFlags) Writable() bool {
+	return sf.HasSet(uint64(SectionFlagWrite))
+}
+
+func (sf SectionFlags) Allocated() bool {
+	return sf.HasSet(uint64(SectionFlagAlloc))
+}
+
+func (sf SectionFlags) Executable() bool {
+	return sf.HasSet(uint64(SectionFlagExecInstr))
+}
+
+func (sf SectionFlags) Mergeable() bool {
+	return sf.HasSet(uint64(SectionFlagMerge))
+}
+
+func (sf SectionFlags) Strings() bool {
+	return sf.HasSet(uint64(SectionFlagStrings))
+}
+
+func (sf SectionFlags) InfoLink() bool {
+	return sf.HasSet(uint64(SectionFlagInfoLink))
+}
+
+func (sf SectionFlags) LinkOrder() bool {
+	return sf.HasSet(uint64(SectionFlagLinkOrder))
+}
+
+func (sf SectionFlags) OSNonConforming() bool {
+	return sf.HasSet(uint64(SectionFlagOSNonConforming))
+}
+
+func (sf SectionFlags) InGroup() bool {
+	return sf.HasSet(uint64(SectionFlagGroup))
+}
+
+func (sf SectionFlags) TLS() bool {
+	return sf.HasSet(uint64(SectionFlagTLS))
+}
+
+func (sf SectionFlags) Compressed() bool {
+	return sf.HasSet(uint64(SectionFlagCompressed))
+}
+
+func (sf SectionFlags) Retained() bool {
+	return sf.HasSet(uint64(SectionFlagGNURetain))
+}
+
+func (sf SectionFlags) Excluded() bool {
+	return sf.HasSet(uint64(SectionFlagExclude))
+}
+
+// OSSpecific returns flags of SHF_MASKOS range which have no generic meaning (SHF_GNU_RETAIN is known)
+func (sf SectionFlags) OSSpecific() SectionFlags {
+	return sf & SectionFlagMaskOS &^ sectionFlagsKnown
+}
+
+// ProcSpecific returns flags of SHF_MASKPROC range which have no generic meaning (SHF_EXCLUDE is known)
+func (sf SectionFlags) ProcSpecific() SectionFlags {
+	return sf & SectionFlagMaskProc &^ sectionFlagsKnown
+}
+
+// Unknown returns flags outside of OS and processor ranges which are not defined by specification
+func (sf SectionFlags) Unknown() SectionFlags {
+	return sf &^ (SectionFlagMaskOS | SectionFlagMaskProc | sectionFlagsKnown)
+}
+
+// Letters renders flags the way readelf does: known flags in bit order,
+// followed by single x, o or p for any unknown, OS specific or processor specific bits
+func (sf SectionFlags) Letters() string {
+	var letters []byte
+	for _, flagLetter := range sectionFlagLetters {
+		if sf&flagLetter.flag != 0 {
+			letters = append(letters, flagLetter.letter)
+		}
+	}
+	if sf.Unknown() != 0 {
+		letters = append(letters, 'x')
+	}
+	if sf.OSSpecific() != 0 {
+		letters = append(letters, 'o')
+	}
+	if sf.ProcSpecific() != 0 {
+		letters = append(letters, 'p')
+	}
+	return string(letters)
+}
+
 func (sf SectionFlags) String() string {
-	return fmt.Sprintf("0x%08X", uint64(sf))
+	return fmt.Sprintf("0x%08X [%v]", uint64(sf), sf.Letters())
 }
 
 func (st SectionType) String() string {
+	if text, ok := sectionTypeNames.text(uint64(st)); ok {
+		return text
+	}
 	switch {
-	case st < 18:
-		return [...]string{
-			"NULL",
-			"PROGBITS",
-			"SYMTAB",
-			"STRTAB",
-			"RELA",
-			"HASH",
-			"DYNAMIC",
-			"NOTE",
-			"NOBITS",
-			"REL",
-			"SHLIB",
-			"DYNSYM",
-			"INIT_ARRAY",
-			"FINI_ARRAY",
-			"PREINIT_ARRAY",
-			"GROUP",
-			"SYMTAB_SHNDX",
-			"NUM",
-		}[st]
-	case st >= SectionTypeOSSpecific:
+	case st >= SectionTypeOSSpecific && st <= SectionTypeHighOS:
 		return fmt.Sprintf("OS specific: 0x%08X", uint32(st))
+	case st >= SectionTypeLowProc && st <= SectionTypeHighProc:
+		return fmt.Sprintf("proc specific: 0x%08X", uint32(st))
+	case st >= SectionTypeLowUser:
+		return fmt.Sprintf("user specific: 0x%08X", uint32(st))
 	}
 	return fmt.Sprintf("unknown: 0x%08X", uint32(st))
 }
 
+func (st SectionType) GoString() string {
+	return sectionTypeNames.goString(uint64(st))
+}
+
+// ParseSectionType resolves specification (SHT_GNU_HASH) or Go (SectionTypeGNUHash) constant name
+func ParseSectionType(name string) (SectionType, error) {
+	value, err := sectionTypeNames.parse(name)
+	return SectionType(value), err
+}
+
 type SectionHeader struct {
 	NameOffset uint32        // 4 bytes offset to .
 	Type       SectionType   // 4 bytes
@@ -0,0 +1,60 @@
+package elf
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSectionFlagLettersMatchReadelf(t *testing.T) {
+	file, err := Open(filepath.Join("testdata", "sample_linux_amd64.o"))
+	assert.NoError(t, err)
+	defer file.Close()
+
+	expected := map[int]string{
+		0:  "",
+		1:  "",
+		5:  "AX",
+		6:  "I",
+		7:  "WA",
+		9:  "AXG",
+		10: "IG",
+		11: "AMS",
+		14: "AG",
+		19: "AM",
+		31: "MS",
+		33: "WAG",
+	}
+	for index, letters := range expected {
+		assert.Equal(t, letters, file.Sections[index].Flags.Letters(), file.Sections[index].Name)
+	}
+}
+
+func TestSectionFlagPredicates(t *testing.T) {
+	flags := SectionFlagWrite | SectionFlagAlloc | SectionFlagExecInstr
+	assert.True(t, flags.Writable())
+	assert.True(t, flags.Allocated())
+	assert.True(t, flags.Executable())
+	assert.False(t, flags.TLS())
+	assert.False(t, flags.Compressed())
+	assert.Equal(t, "0x00000007 [WAX]", flags.String())
+
+	flags = SectionFlagMerge | SectionFlagStrings | SectionFlagCompressed | SectionFlagGNURetain | SectionFlagExclude
+	assert.True(t, flags.Mergeable())
+	assert.True(t, flags.Strings())
+	assert.True(t, flags.Compressed())
+	assert.True(t, flags.Retained())
+	assert.True(t, flags.Excluded())
+	assert.False(t, flags.Writable())
+	assert.Equal(t, "MSCRE", flags.Letters())
+}
+
+func TestSectionFlagSpecificMasks(t *testing.T) {
+	flags := SectionFlagAlloc | SectionFlagGNURetain | 0x00100000 | SectionFlagExclude | 0x10000000 | 0x1000
+	assert.Equal(t, SectionFlags(0x00100000), flags.OSSpecific())
+	assert.Equal(t, SectionFlags(0x10000000), flags.ProcSpecific())
+	assert.Equal(t, SectionFlags(0x1000), flags.Unknown())
+	assert.Equal(t, "ARExop", flags.Letters())
+	assert.Equal(t, "", SectionFlags(0).Letters())
+}
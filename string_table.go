@@ -0,0 +1,23 @@
+package elf
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+)
+
+// StringTable is content of SHT_STRTAB section - null terminated strings referenced by offset
+type StringTable []byte
+
+var ErrInvalidStringTable = errors.New("invalid string table")
+
+func (st StringTable) String(offset uint32) (string, error) {
+	if uint64(offset) >= uint64(len(st)) {
+		return "", fmt.Errorf("%w offset %v out of bounds: %v", ErrInvalidStringTable, offset, len(st))
+	}
+	end := bytes.IndexByte(st[offset:], 0)
+	if end < 0 {
+		return "", fmt.Errorf("%w string at offset %v is not null terminated", ErrInvalidStringTable, offset)
+	}
+	return string(st[offset : offset+uint32(end)]), nil
+}
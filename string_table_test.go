@@ -0,0 +1,30 @@
+package elf
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStringTable(t *testing.T) {
+	table := StringTable("\x00.text\x00.data\x00unterminated")
+
+	name, err := table.String(0)
+	assert.NoError(t, err)
+	assert.Equal(t, "", name)
+
+	name, err = table.String(1)
+	assert.NoError(t, err)
+	assert.Equal(t, ".text", name)
+
+	name, err = table.String(3)
+	assert.NoError(t, err)
+	assert.Equal(t, "ext", name)
+
+	_, err = table.String(13)
+	assert.True(t, errors.Is(err, ErrInvalidStringTable))
+
+	_, err = table.String(100)
+	assert.True(t, errors.Is(err, ErrInvalidStringTable))
+}
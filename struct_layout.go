@@ -0,0 +1,679 @@
+package elf
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strings"
+)
+
+// DefaultCachelineSize is cacheline size in bytes used when reports have none configured
+const DefaultCachelineSize = 64
+
+// StructLayout is memory layout of structure, class or union decoded from its DWARF type entry
+type StructLayout struct {
+	Entry      *DIE   // DW_TAG_structure_type, DW_TAG_class_type or DW_TAG_union_type
+	Name       string // empty for anonymous types
+	Size       uint64
+	Alignment  uint64 // DW_AT_alignment or natural alignment inferred from member types
+	Members    []MemberLayout
+	Padding    uint64 // unused bytes at the end
+	BitPadding uint64 // unused bits of the last bit field storage in addition to Padding
+	// Savable is number of bytes saved by reordering members by descending alignment, zero if it wouldn't help
+	Savable uint64
+}
+
+// MemberLayout is data member or base class within structure
+type MemberLayout struct {
+	Entry     *DIE   // DW_TAG_member or DW_TAG_inheritance
+	Name      string // empty for anonymous members and base classes
+	TypeName  string // C declaration of member type like "const char *"
+	Offset    uint64 // byte offset from start of structure, for bit fields offset of their storage unit
+	Size      uint64 // byte size of member type
+	Alignment uint64
+	BitOffset uint64 // bit fields only, offset of the first bit within storage unit counting from its lowest address
+	BitSize   uint64 // bit fields only
+	Hole      uint64 // unused bytes up to the next member
+	BitHole   uint64 // unused bits up to the next member in addition to Hole
+}
+
+// IsBitField reports if member is bit field
+func (ml MemberLayout) IsBitField() bool {
+	return ml.BitSize != 0
+}
+
+// IsBaseClass reports if member is base class of C++ class
+func (ml MemberLayout) IsBaseClass() bool {
+	return ml.Entry.Tag == DWARFTagInheritance
+}
+
+// startBit and endBit locate member in bits from start of structure
+func (ml MemberLayout) startBit() uint64 {
+	return ml.Offset*8 + ml.BitOffset
+}
+
+func (ml MemberLayout) endBit() uint64 {
+	if ml.IsBitField() {
+		return ml.startBit() + ml.BitSize
+	}
+	return ml.startBit() + ml.Size*8
+}
+
+// Holes returns number of holes between members of at least one byte and their total size
+func (sl *StructLayout) Holes() (int, uint64) {
+	var count int
+	var size uint64
+	for _, member := range sl.Members {
+		if member.Hole > 0 {
+			count++
+			size += member.Hole
+		}
+	}
+	return count, size
+}
+
+// Cachelines returns number of cachelines of given size spanned by structure, zero for zero cacheline size
+func (sl *StructLayout) Cachelines(cachelineSize uint64) uint64 {
+	if cachelineSize == 0 {
+		return 0
+	}
+	return (sl.Size + cachelineSize - 1) / cachelineSize
+}
+
+// CachelineBoundaries returns indexes of members which are the first ones to start in a cacheline, none for zero
+// cacheline size
+func (sl *StructLayout) CachelineBoundaries(cachelineSize uint64) []int {
+	var boundaries []int
+	if cachelineSize == 0 {
+		return boundaries
+	}
+	var cacheline uint64
+	for i, member := range sl.Members {
+		if member.Offset/cachelineSize > cacheline {
+			cacheline = member.Offset / cachelineSize
+			boundaries = append(boundaries, i)
+		}
+	}
+	return boundaries
+}
+
+// dwarfOpPlusUconst is DW_OP_plus_uconst operation of DWARF expressions
+const dwarfOpPlusUconst = 0x23
+
+// maxTypeDepth limits chains of type references like pointers to pointers
+const maxTypeDepth = 32
+
+// StructLayouts decodes layouts of all defined structures, classes and unions, see DWARFData.StructLayouts
+func (f *File) StructLayouts() ([]*StructLayout, error) {
+	data, err := f.DWARF()
+	if err != nil {
+		return nil, err
+	}
+	return data.StructLayouts()
+}
+
+// StructLayouts decodes layouts of all defined structures, classes and unions of all units in order of their entries.
+// Types defined by several units are returned for each of them
+func (d *DWARFData) StructLayouts() ([]*StructLayout, error) {
+	types := newTypeResolver(d)
+	var layouts []*StructLayout
+	for _, unit := range d.units {
+		reader := unit.Entries()
+		for {
+			die, err := reader.Next()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				return nil, err
+			}
+			if !isStructType(die) || isDeclaration(die) {
+				continue
+			}
+			if _, ok := die.Constant(DWARFAttrByteSize); !ok {
+				continue
+			}
+			layout, err := types.structLayout(die)
+			if err != nil {
+				return nil, err
+			}
+			layouts = append(layouts, layout)
+		}
+	}
+	return layouts, nil
+}
+
+// StructLayoutOf decodes layout of structure, class or union type entry
+func (d *DWARFData) StructLayoutOf(entry *DIE) (*StructLayout, error) {
+	if !isStructType(entry) {
+		return nil, fmt.Errorf("%w entry at 0x%x is %v, not structure, class or union", ErrInvalidDWARF, entry.Offset, entry.Tag)
+	}
+	return newTypeResolver(d).structLayout(entry)
+}
+
+func isStructType(die *DIE) bool {
+	return die.Tag == DWARFTagStructureType || die.Tag == DWARFTagClassType || die.Tag == DWARFTagUnionType
+}
+
+func isDeclaration(die *DIE) bool {
+	declaration, _ := die.Value(DWARFAttrDeclaration).(bool)
+	return declaration
+}
+
+// typeResolver decodes type entries referenced by DW_AT_type, each of them once
+type typeResolver struct {
+	data      *DWARFData
+	entries   map[DIEOffset]*DIE
+	typeUnits map[uint64]*CompilationUnit // by type signature, indexed on first DW_FORM_ref_sig8 reference
+}
+
+func newTypeResolver(data *DWARFData) *typeResolver {
+	return &typeResolver{data: data, entries: map[DIEOffset]*DIE{}}
+}
+
+// typeOf returns entry referenced by DW_AT_type, nil for void. Type signatures and declarations carrying them are
+// resolved to type entries of type units in .debug_info
+func (tr *typeResolver) typeOf(die *DIE) (*DIE, error) {
+	offset, ok, err := tr.reference(die, DWARFAttrType)
+	if err != nil || !ok {
+		return nil, err
+	}
+	entry, err := tr.entryAt(offset)
+	if err != nil || !isDeclaration(entry) {
+		return entry, err
+	}
+	if offset, ok, err = tr.reference(entry, DWARFAttrSignature); err != nil || !ok {
+		return entry, err
+	}
+	return tr.entryAt(offset)
+}
+
+// reference returns offset of entry referenced by attribute, false if entry has no such attribute
+func (tr *typeResolver) reference(die *DIE, attribute DWARFAttribute) (DIEOffset, bool, error) {
+	attr, ok := die.attribute(attribute)
+	if !ok {
+		return 0, false, nil
+	}
+	switch value := attr.Value.(type) {
+	case DIEOffset:
+		return value, true, nil
+	case uint64:
+		if attr.Form == DWARFFormRefSig8 {
+			offset, err := tr.signatureOffset(value)
+			if err != nil {
+				return 0, false, fmt.Errorf("entry at 0x%x: %w", die.Offset, err)
+			}
+			return offset, true, nil
+		}
+	}
+	return 0, false, fmt.Errorf("%w entry at 0x%x refers to type by unsupported form %v", ErrInvalidDWARF, die.Offset, attr.Form)
+}
+
+func (tr *typeResolver) entryAt(offset DIEOffset) (*DIE, error) {
+	if entry, ok := tr.entries[offset]; ok {
+		return entry, nil
+	}
+	entry, err := tr.data.EntryAt(offset)
+	if err != nil {
+		return nil, err
+	}
+	tr.entries[offset] = entry
+	return entry, nil
+}
+
+// signatureOffset returns offset of type entry of type unit with given signature
+func (tr *typeResolver) signatureOffset(signature uint64) (DIEOffset, error) {
+	if tr.typeUnits == nil {
+		tr.typeUnits = map[uint64]*CompilationUnit{}
+		for _, unit := range tr.data.units {
+			if unit.Type == DWARFUnitTypeUnit || unit.Type == DWARFUnitSplitType {
+				tr.typeUnits[unit.ID] = unit
+			}
+		}
+	}
+	unit, ok := tr.typeUnits[signature]
+	if !ok {
+		return 0, fmt.Errorf("%w no type unit with signature 0x%x", ErrInvalidDWARF, signature)
+	}
+	return DIEOffset(unit.Offset + unit.TypeOffset), nil
+}
+
+// children returns direct children of entry
+func (tr *typeResolver) children(die *DIE) ([]*DIE, error) {
+	if !die.Children {
+		return nil, nil
+	}
+	reader := die.Unit.EntriesAt(die.Offset)
+	if _, err := reader.Next(); err != nil {
+		return nil, err
+	}
+	var children []*DIE
+	for {
+		child, err := reader.Next()
+		if err == io.EOF {
+			return children, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if reader.Depth() == 0 {
+			return children, nil
+		}
+		children = append(children, child)
+		reader.SkipChildren()
+	}
+}
+
+func (tr *typeResolver) structLayout(die *DIE) (*StructLayout, error) {
+	size, _ := die.Constant(DWARFAttrByteSize)
+	layout := &StructLayout{Entry: die, Name: die.Name(), Size: uint64(size)}
+	alignment, err := tr.alignment(die, 0)
+	if err != nil {
+		return nil, err
+	}
+	layout.Alignment = alignment
+
+	children, err := tr.children(die)
+	if err != nil {
+		return nil, err
+	}
+	for _, child := range children {
+		if child.Tag != DWARFTagMember && child.Tag != DWARFTagInheritance || isDeclaration(child) {
+			continue
+		}
+		// static members of DWARF 4 and earlier are external members without location
+		if external, _ := child.Value(DWARFAttrExternal).(bool); external {
+			continue
+		}
+		member, err := tr.memberLayout(child)
+		if err != nil {
+			return nil, fmt.Errorf("member at 0x%x: %w", child.Offset, err)
+		}
+		layout.Members = append(layout.Members, member)
+	}
+
+	var end uint64
+	if die.Tag == DWARFTagUnionType {
+		for _, member := range layout.Members {
+			end = maxUint64(end, member.endBit())
+		}
+	} else {
+		for i := range layout.Members {
+			end = maxUint64(end, layout.Members[i].endBit())
+			if i+1 < len(layout.Members) && layout.Members[i+1].startBit() > end {
+				hole := layout.Members[i+1].startBit() - end
+				layout.Members[i].Hole, layout.Members[i].BitHole = hole/8, hole%8
+			}
+		}
+		layout.Savable = reorderSavings(layout)
+	}
+	// empty structures occupy a byte without any padding
+	if size := layout.Size * 8; size > end && len(layout.Members) > 0 {
+		layout.Padding, layout.BitPadding = (size-end)/8, (size-end)%8
+	}
+	return layout, nil
+}
+
+func (tr *typeResolver) memberLayout(die *DIE) (MemberLayout, error) {
+	member := MemberLayout{Entry: die, Name: die.Name()}
+	memberType, err := tr.typeOf(die)
+	if err != nil {
+		return member, err
+	}
+	if member.TypeName, err = tr.typeName(memberType, 0); err != nil {
+		return member, err
+	}
+	if member.Size, err = tr.size(memberType, 0); err != nil {
+		return member, err
+	}
+	if member.Alignment, err = tr.alignment(memberType, 0); err != nil {
+		return member, err
+	}
+
+	switch location := die.Value(DWARFAttrDataMemberLocation).(type) {
+	case uint64:
+		member.Offset = location
+	case int64:
+		member.Offset = uint64(location)
+	case []byte:
+		// DWARF 2 expression of DW_OP_plus_uconst adding offset to address of structure
+		if len(location) < 2 || location[0] != dwarfOpPlusUconst {
+			return member, fmt.Errorf("%w unsupported member location expression %x", ErrInvalidDWARF, location)
+		}
+		offset, size := readULEB128(location[1:])
+		if size == 0 {
+			return member, fmt.Errorf("%w truncated member location expression %x", ErrInvalidDWARF, location)
+		}
+		member.Offset = offset
+	}
+
+	bitSize, ok := die.Constant(DWARFAttrBitSize)
+	if !ok {
+		return member, nil
+	}
+	member.BitSize = uint64(bitSize)
+	storageSize := member.Size
+	if byteSize, ok := die.Constant(DWARFAttrByteSize); ok {
+		storageSize = uint64(byteSize)
+	}
+	if storageSize == 0 || storageSize > math.MaxUint64/8 {
+		return member, fmt.Errorf("%w bit field %v has storage size %v", ErrInvalidDWARF, member.Name, storageSize)
+	}
+	// bit field without bit offsets starts at the first bit of its storage unit
+	start := member.Offset * 8
+	if dataBitOffset, ok := die.Constant(DWARFAttrDataBitOffset); ok {
+		start = uint64(dataBitOffset)
+	} else if bitOffset, ok := die.Constant(DWARFAttrBitOffset); ok {
+		// DWARF 2 and 3 count bits of storage unit from its most significant bit
+		if die.Unit.data.byteOrder == binary.LittleEndian {
+			start += storageSize*8 - uint64(bitOffset) - member.BitSize
+		} else {
+			start += uint64(bitOffset)
+		}
+	}
+	member.Offset = start / (storageSize * 8) * storageSize
+	member.BitOffset = start - member.Offset*8
+	return member, nil
+}
+
+// reorderSavings packs members by descending alignment, runs of bit fields are packed as byte aligned blocks
+func reorderSavings(layout *StructLayout) uint64 {
+	type block struct {
+		size      uint64
+		alignment uint64
+	}
+	var blocks []block
+	for i := 0; i < len(layout.Members); i++ {
+		member := layout.Members[i]
+		if !member.IsBitField() {
+			blocks = append(blocks, block{member.Size, maxUint64(member.Alignment, 1)})
+			continue
+		}
+		start, end := member.startBit(), member.endBit()
+		for ; i+1 < len(layout.Members) && layout.Members[i+1].IsBitField(); i++ {
+			end = maxUint64(end, layout.Members[i+1].endBit())
+		}
+		blocks = append(blocks, block{(end - start + 7) / 8, 1})
+	}
+	if len(blocks) == 0 {
+		return 0
+	}
+	sort.SliceStable(blocks, func(i, j int) bool {
+		return blocks[i].alignment > blocks[j].alignment
+	})
+	var size uint64
+	for _, block := range blocks {
+		size = alignUp(size, block.alignment) + block.size
+	}
+	size = alignUp(size, maxUint64(layout.Alignment, 1))
+	if size >= layout.Size {
+		return 0
+	}
+	return layout.Size - size
+}
+
+func maxUint64(a, b uint64) uint64 {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+// naturalAlignment is the largest power of two up to 16 dividing size
+func naturalAlignment(size uint64) uint64 {
+	alignment := uint64(1)
+	for alignment < 16 && size%(alignment*2) == 0 {
+		alignment *= 2
+	}
+	return alignment
+}
+
+// size returns byte size of type, zero for void and types of unknown size
+func (tr *typeResolver) size(die *DIE, depth int) (uint64, error) {
+	if die == nil {
+		return 0, nil
+	}
+	if depth > maxTypeDepth {
+		return 0, fmt.Errorf("%w type at 0x%x nests too deep", ErrInvalidDWARF, die.Offset)
+	}
+	if size, ok := die.Constant(DWARFAttrByteSize); ok {
+		return uint64(size), nil
+	}
+	switch die.Tag {
+	case DWARFTagPointerType, DWARFTagReferenceType, DWARFTagRvalueReferenceType:
+		return uint64(die.Unit.AddressSize), nil
+	case DWARFTagArrayType:
+		element, err := tr.typeOf(die)
+		if err != nil {
+			return 0, err
+		}
+		size, err := tr.size(element, depth+1)
+		if err != nil {
+			return 0, err
+		}
+		counts, err := tr.arrayCounts(die)
+		for _, count := range counts {
+			size *= count
+		}
+		return size, err
+	case DWARFTagTypedef, DWARFTagConstType, DWARFTagVolatileType, DWARFTagRestrictType, DWARFTagAtomicType,
+		DWARFTagImmutableType, DWARFTagSharedType:
+		target, err := tr.typeOf(die)
+		if err != nil {
+			return 0, err
+		}
+		return tr.size(target, depth+1)
+	}
+	return 0, nil
+}
+
+// alignment returns DW_AT_alignment of type or its natural alignment
+func (tr *typeResolver) alignment(die *DIE, depth int) (uint64, error) {
+	if die == nil {
+		return 1, nil
+	}
+	if depth > maxTypeDepth {
+		return 0, fmt.Errorf("%w type at 0x%x nests too deep", ErrInvalidDWARF, die.Offset)
+	}
+	if alignment, ok := die.Constant(DWARFAttrAlignment); ok && alignment > 0 {
+		return uint64(alignment), nil
+	}
+	switch die.Tag {
+	case DWARFTagStructureType, DWARFTagClassType, DWARFTagUnionType:
+		children, err := tr.children(die)
+		if err != nil {
+			return 0, err
+		}
+		alignment := uint64(1)
+		for _, child := range children {
+			if child.Tag != DWARFTagMember && child.Tag != DWARFTagInheritance || isDeclaration(child) {
+				continue
+			}
+			if external, _ := child.Value(DWARFAttrExternal).(bool); external {
+				continue
+			}
+			memberType, err := tr.typeOf(child)
+			if err != nil {
+				return 0, err
+			}
+			memberAlignment, err := tr.alignment(memberType, depth+1)
+			if err != nil {
+				return 0, err
+			}
+			alignment = maxUint64(alignment, memberAlignment)
+		}
+		return alignment, nil
+	case DWARFTagArrayType, DWARFTagTypedef, DWARFTagConstType, DWARFTagVolatileType, DWARFTagRestrictType,
+		DWARFTagAtomicType, DWARFTagImmutableType, DWARFTagSharedType:
+		target, err := tr.typeOf(die)
+		if err != nil {
+			return 0, err
+		}
+		return tr.alignment(target, depth+1)
+	}
+	size, err := tr.size(die, depth+1)
+	if err != nil {
+		return 0, err
+	}
+	return naturalAlignment(size), nil
+}
+
+// arrayCounts returns element counts of array dimensions, zero for flexible or unknown ones
+func (tr *typeResolver) arrayCounts(die *DIE) ([]uint64, error) {
+	children, err := tr.children(die)
+	if err != nil {
+		return nil, err
+	}
+	var counts []uint64
+	for _, child := range children {
+		if child.Tag != DWARFTagSubrangeType && child.Tag != DWARFTagEnumerationType {
+			continue
+		}
+		if count, ok := child.Constant(DWARFAttrCount); ok {
+			counts = append(counts, uint64(count))
+			continue
+		}
+		upper, ok := child.Constant(DWARFAttrUpperBound)
+		if !ok {
+			counts = append(counts, 0)
+			continue
+		}
+		lower, _ := child.Constant(DWARFAttrLowerBound)
+		counts = append(counts, uint64(upper-lower+1))
+	}
+	return counts, nil
+}
+
+// typeName returns C declaration of type without declared name
+func (tr *typeResolver) typeName(die *DIE, depth int) (string, error) {
+	if die == nil {
+		return "void", nil
+	}
+	if depth > maxTypeDepth {
+		return "", fmt.Errorf("%w type at 0x%x nests too deep", ErrInvalidDWARF, die.Offset)
+	}
+	target, err := tr.typeOf(die)
+	if err != nil {
+		return "", err
+	}
+	name := die.Name()
+
+	switch die.Tag {
+	case DWARFTagStructureType, DWARFTagClassType, DWARFTagUnionType, DWARFTagEnumerationType:
+		keyword := map[DWARFTag]string{
+			DWARFTagStructureType:   "struct",
+			DWARFTagClassType:       "class",
+			DWARFTagUnionType:       "union",
+			DWARFTagEnumerationType: "enum",
+		}[die.Tag]
+		if name == "" {
+			return keyword + " {...}", nil
+		}
+		return keyword + " " + name, nil
+	case DWARFTagPointerType, DWARFTagReferenceType, DWARFTagRvalueReferenceType:
+		declarator := map[DWARFTag]string{
+			DWARFTagPointerType:         "*",
+			DWARFTagReferenceType:       "&",
+			DWARFTagRvalueReferenceType: "&&",
+		}[die.Tag]
+		if target != nil && target.Tag == DWARFTagSubroutineType {
+			return tr.subroutineName(target, "("+declarator+")", depth)
+		}
+		targetName, err := tr.typeName(target, depth+1)
+		return targetName + " " + declarator, err
+	case DWARFTagConstType, DWARFTagVolatileType, DWARFTagRestrictType, DWARFTagAtomicType:
+		qualifier := map[DWARFTag]string{
+			DWARFTagConstType:    "const",
+			DWARFTagVolatileType: "volatile",
+			DWARFTagRestrictType: "restrict",
+			DWARFTagAtomicType:   "_Atomic",
+		}[die.Tag]
+		targetName, err := tr.typeName(target, depth+1)
+		// qualified pointers are written after the pointer declarator
+		if target != nil && (target.Tag == DWARFTagPointerType || target.Tag == DWARFTagReferenceType) {
+			return targetName + " " + qualifier, err
+		}
+		return qualifier + " " + targetName, err
+	case DWARFTagArrayType:
+		elementName, err := tr.typeName(target, depth+1)
+		if err != nil {
+			return "", err
+		}
+		counts, err := tr.arrayCounts(die)
+		for _, count := range counts {
+			if count == 0 {
+				elementName += "[]"
+			} else {
+				elementName += fmt.Sprintf("[%v]", count)
+			}
+		}
+		return elementName, err
+	case DWARFTagSubroutineType:
+		return tr.subroutineName(die, "", depth)
+	case DWARFTagPtrToMemberType:
+		targetName, err := tr.typeName(target, depth+1)
+		if err != nil {
+			return "", err
+		}
+		containing, err := tr.referencedEntry(die, DWARFAttrContainingType)
+		if err != nil || containing == nil {
+			return targetName + " ::*", err
+		}
+		return targetName + " " + containing.Name() + "::*", nil
+	}
+	if name == "" && target != nil {
+		return tr.typeName(target, depth+1)
+	}
+	return name, nil
+}
+
+// subroutineName returns declaration of subroutine type with declarator placed in place of its name
+func (tr *typeResolver) subroutineName(die *DIE, declarator string, depth int) (string, error) {
+	returnType, err := tr.typeOf(die)
+	if err != nil {
+		return "", err
+	}
+	returnName, err := tr.typeName(returnType, depth+1)
+	if err != nil {
+		return "", err
+	}
+	children, err := tr.children(die)
+	if err != nil {
+		return "", err
+	}
+	var parameters []string
+	for _, child := range children {
+		switch child.Tag {
+		case DWARFTagFormalParameter:
+			parameterType, err := tr.typeOf(child)
+			if err != nil {
+				return "", err
+			}
+			parameter, err := tr.typeName(parameterType, depth+1)
+			if err != nil {
+				return "", err
+			}
+			parameters = append(parameters, parameter)
+		case DWARFTagUnspecifiedParameters:
+			parameters = append(parameters, "...")
+		}
+	}
+	if prototyped, _ := die.Value(DWARFAttrPrototyped).(bool); prototyped && len(parameters) == 0 {
+		parameters = append(parameters, "void")
+	}
+	if declarator == "" {
+		return fmt.Sprintf("%v (%v)", returnName, strings.Join(parameters, ", ")), nil
+	}
+	return fmt.Sprintf("%v %v(%v)", returnName, declarator, strings.Join(parameters, ", ")), nil
+}
+
+// referencedEntry decodes entry referenced by attribute, nil if entry has no such attribute
+func (tr *typeResolver) referencedEntry(die *DIE, attribute DWARFAttribute) (*DIE, error) {
+	offset, ok := die.Value(attribute).(DIEOffset)
+	if !ok {
+		return nil, nil
+	}
+	return tr.data.EntryAt(offset)
+}
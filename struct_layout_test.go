@@ -0,0 +1,224 @@
+package elf
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func structLayoutsByName(t *testing.T, filename string) map[string]*StructLayout {
+	file, err := Open(filepath.Join("testdata", filename))
+	assert.NoError(t, err)
+	defer file.Close()
+
+	layouts, err := file.StructLayouts()
+	assert.NoError(t, err)
+	byName := map[string]*StructLayout{}
+	for _, layout := range layouts {
+		if _, ok := byName[layout.Name]; !ok {
+			byName[layout.Name] = layout
+		}
+	}
+	return byName
+}
+
+func TestStructLayouts(t *testing.T) {
+	// DWARF 5 locates bit fields by DW_AT_data_bit_offset, DWARF 2 by DW_AT_bit_offset and member location expressions.
+	// C++ type units refer to types of other type units by signature
+	for _, filename := range []string{"layout_linux_amd64.o", "layout_dwarf2_linux_amd64.o", "layout_types_linux_amd64.so"} {
+		t.Run(filename, func(t *testing.T) {
+			layouts := structLayoutsByName(t, filename)
+			// compare with offsetof and sizeof of layout.c
+			padded := layouts["padded"]
+			assert.Equal(t, uint64(24), padded.Size)
+			assert.Equal(t, uint64(8), padded.Alignment)
+			assert.Equal(t, []MemberLayout{
+				{Name: "tag", TypeName: "char", Offset: 0, Size: 1, Alignment: 1, Hole: 7},
+				{Name: "value", TypeName: "long int", Offset: 8, Size: 8, Alignment: 8},
+				{Name: "count", TypeName: "short int", Offset: 16, Size: 2, Alignment: 2},
+			}, withoutEntries(padded.Members))
+			assert.Equal(t, uint64(6), padded.Padding)
+			assert.Equal(t, uint64(8), padded.Savable)
+			count, size := padded.Holes()
+			assert.Equal(t, 1, count)
+			assert.Equal(t, uint64(7), size)
+
+			flags := layouts["flags"]
+			assert.Equal(t, uint64(16), flags.Size)
+			assert.Equal(t, []MemberLayout{
+				{Name: "ready", TypeName: "unsigned int", Offset: 0, Size: 4, Alignment: 4, BitOffset: 0, BitSize: 1},
+				{Name: "mode", TypeName: "unsigned int", Offset: 0, Size: 4, Alignment: 4, BitOffset: 1, BitSize: 3, BitHole: 4},
+				{Name: "name", TypeName: "char[5]", Offset: 1, Size: 5, Alignment: 1},
+				{Name: "level", TypeName: "unsigned int", Offset: 4, Size: 4, Alignment: 4, BitOffset: 16, BitSize: 12, BitHole: 4},
+				{Name: "next", TypeName: "int *", Offset: 8, Size: 8, Alignment: 8},
+			}, withoutEntries(flags.Members))
+			assert.True(t, flags.Members[0].IsBitField())
+			assert.False(t, flags.Members[2].IsBitField())
+			assert.Zero(t, flags.Padding)
+			assert.Zero(t, flags.Savable)
+
+			number := layouts["number"]
+			assert.Equal(t, DWARFTagUnionType, number.Entry.Tag)
+			assert.Equal(t, uint64(16), number.Size)
+			assert.Len(t, number.Members, 3)
+			for _, member := range number.Members {
+				assert.Zero(t, member.Offset)
+				assert.Zero(t, member.Hole)
+			}
+			assert.Equal(t, uint64(4), number.Padding)
+
+			wide := layouts["wide"]
+			assert.Equal(t, uint64(128), wide.Size)
+			var names, typeNames []string
+			var offsets []uint64
+			for _, member := range wide.Members {
+				names = append(names, member.Name)
+				typeNames = append(typeNames, member.TypeName)
+				offsets = append(offsets, member.Offset)
+			}
+			assert.Equal(t, []string{"name", "code", "first", "values", "callback", "counter", "point"}, names)
+			assert.Equal(t, []string{"const char *", "char", "padded_t", "union number[4]", "void (*)(int, const char *)",
+				"volatile uint16_t", "struct {...}"}, typeNames)
+			assert.Equal(t, []uint64{0, 8, 16, 40, 104, 112, 116}, offsets)
+			assert.Equal(t, uint64(24), wide.Members[2].Size)
+			assert.Equal(t, uint64(64), wide.Members[3].Size)
+			count, size = wide.Holes()
+			assert.Equal(t, 2, count)
+			assert.Equal(t, uint64(9), size)
+			assert.Equal(t, uint64(4), wide.Padding)
+			assert.Equal(t, uint64(8), wide.Savable)
+			assert.Equal(t, uint64(2), wide.Cachelines(DefaultCachelineSize))
+			assert.Equal(t, []int{4}, wide.CachelineBoundaries(DefaultCachelineSize))
+			assert.Equal(t, uint64(4), wide.Cachelines(32))
+			assert.Equal(t, []int{3, 4}, wide.CachelineBoundaries(32))
+			assert.Zero(t, wide.Cachelines(0))
+			assert.Empty(t, wide.CachelineBoundaries(0))
+
+			tight := layouts["packed_tight"]
+			assert.Equal(t, uint64(1), tight.Padding)
+			assert.Zero(t, tight.Savable)
+
+			// anonymous structure of wide.point
+			point := layouts[""]
+			assert.Equal(t, uint64(8), point.Size)
+			assert.Equal(t, uint64(4), point.Alignment)
+		})
+	}
+}
+
+func TestStructLayoutsCPlusPlus(t *testing.T) {
+	layouts := structLayoutsByName(t, "sample_linux_amd64")
+
+	padded := layouts["Padded"]
+	assert.Equal(t, uint64(24), padded.Size)
+	low, high := padded.Members[3], padded.Members[4]
+	assert.Equal(t, "low", low.Name)
+	assert.Equal(t, uint64(16), low.Offset)
+	assert.Equal(t, uint64(16), low.BitOffset)
+	assert.Equal(t, uint64(3), low.BitSize)
+	assert.Equal(t, uint64(19), high.BitOffset)
+	assert.Equal(t, uint64(5), high.BitSize)
+	assert.Equal(t, uint64(19), padded.Members[5].Offset)
+	assert.Equal(t, uint64(4), padded.Padding)
+	assert.Equal(t, uint64(8), padded.Savable)
+
+	// empty classes occupy one byte which is not padding
+	tag := layouts["input_iterator_tag"]
+	assert.Equal(t, uint64(1), tag.Size)
+	assert.Empty(t, tag.Members)
+	assert.Zero(t, tag.Padding)
+	assert.Zero(t, tag.Savable)
+
+	// iterator tags derive from each other
+	derived := layouts["forward_iterator_tag"]
+	assert.Len(t, derived.Members, 1)
+	assert.True(t, derived.Members[0].IsBaseClass())
+	assert.Equal(t, "struct input_iterator_tag", derived.Members[0].TypeName)
+}
+
+func TestStructLayoutOf(t *testing.T) {
+	file, err := Open(filepath.Join("testdata", "layout_linux_amd64.o"))
+	assert.NoError(t, err)
+	defer file.Close()
+	data, err := file.DWARF()
+	assert.NoError(t, err)
+
+	unit := data.Units()[0]
+	layout, err := data.StructLayoutOf(unit.Entry())
+	assert.Nil(t, layout)
+	assert.True(t, errors.Is(err, ErrInvalidDWARF))
+
+	layouts, err := data.StructLayouts()
+	assert.NoError(t, err)
+	layout, err = data.StructLayoutOf(layouts[0].Entry)
+	assert.NoError(t, err)
+	assert.Equal(t, layouts[0], layout)
+}
+
+func TestBitFieldLayoutWithoutBitOffsets(t *testing.T) {
+	file, err := Open(filepath.Join("testdata", "layout_dwarf2_linux_amd64.o"))
+	assert.NoError(t, err)
+	defer file.Close()
+	data, err := file.DWARF()
+	assert.NoError(t, err)
+	layouts, err := data.StructLayouts()
+	assert.NoError(t, err)
+	var level *DIE
+	for _, layout := range layouts {
+		if layout.Name == "flags" {
+			level = layout.Members[3].Entry
+		}
+	}
+	assert.NotNil(t, level)
+
+	// bit field without DW_AT_bit_offset starts at the first bit of storage unit at its location
+	entry := *level
+	entry.Attributes = nil
+	for _, attr := range level.Attributes {
+		if attr.Attribute != DWARFAttrBitOffset {
+			entry.Attributes = append(entry.Attributes, attr)
+		}
+	}
+	member, err := newTypeResolver(data).memberLayout(&entry)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(4), member.Offset)
+	assert.Zero(t, member.BitOffset)
+	assert.Equal(t, uint64(12), member.BitSize)
+
+	// storage size of more than 2^61 bytes has no bit size
+	for i, attr := range entry.Attributes {
+		if attr.Attribute == DWARFAttrByteSize {
+			entry.Attributes[i].Value = uint64(1 << 62)
+		}
+	}
+	_, err = newTypeResolver(data).memberLayout(&entry)
+	assert.True(t, errors.Is(err, ErrInvalidDWARF))
+}
+
+func TestTypeSignatureWithoutTypeUnit(t *testing.T) {
+	file, err := Open(filepath.Join("testdata", "layout_types_linux_amd64.so"))
+	assert.NoError(t, err)
+	defer file.Close()
+	data, err := file.DWARF()
+	assert.NoError(t, err)
+
+	// member of type which is not in .debug_info isn't reported as void
+	member := &DIE{Tag: DWARFTagMember, Attributes: []DIEAttribute{
+		{Attribute: DWARFAttrName, Form: DWARFFormString, Value: "missing"},
+		{Attribute: DWARFAttrType, Form: DWARFFormRefSig8, Value: uint64(0x1234)},
+	}}
+	_, err = newTypeResolver(data).memberLayout(member)
+	assert.True(t, errors.Is(err, ErrInvalidDWARF))
+	assert.Contains(t, err.Error(), "signature 0x1234")
+}
+
+func withoutEntries(members []MemberLayout) []MemberLayout {
+	stripped := make([]MemberLayout, len(members))
+	for i, member := range members {
+		member.Entry = nil
+		stripped[i] = member
+	}
+	return stripped
+}
@@ -0,0 +1,333 @@
+package elf
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+)
+
+// symbol table entry sizes as defined by the spec
+const (
+	symbolSize32 = 0x10
+	symbolSize64 = 0x18
+)
+
+type SymbolBinding uint8
+
+const (
+	//0	STB_LOCAL	Not visible outside the object file
+	SymbolBindingLocal SymbolBinding = 0
+	//1	STB_GLOBAL	Visible to all object files being combined
+	SymbolBindingGlobal SymbolBinding = 1
+	//2	STB_WEAK	Global with lower precedence
+	SymbolBindingWeak SymbolBinding = 2
+	//10	STB_GNU_UNIQUE	Unique in the whole process (STB_LOOS)
+	SymbolBindingGNUUnique SymbolBinding = 10
+	//12	STB_HIOS
+	SymbolBindingHiOS SymbolBinding = 12
+	//13	STB_LOPROC
+	SymbolBindingLowProc SymbolBinding = 13
+	//15	STB_HIPROC
+	SymbolBindingHighProc SymbolBinding = 15
+)
+
+func (sb SymbolBinding) String() string {
+	switch {
+	case sb <= SymbolBindingWeak:
+		return [...]string{"LOCAL", "GLOBAL", "WEAK"}[sb]
+	case sb == SymbolBindingGNUUnique:
+		return "UNIQUE"
+	case sb > SymbolBindingGNUUnique && sb <= SymbolBindingHiOS:
+		return fmt.Sprintf("OS specific: %v", uint8(sb))
+	case sb >= SymbolBindingLowProc && sb <= SymbolBindingHighProc:
+		return fmt.Sprintf("proc specific: %v", uint8(sb))
+	}
+	return fmt.Sprintf("unknown: %v", uint8(sb))
+}
+
+type SymbolType uint8
+
+const (
+	//0	STT_NOTYPE	Type is not specified
+	SymbolTypeNone SymbolType = iota
+	//1	STT_OBJECT	Data object
+	SymbolTypeObject
+	//2	STT_FUNC	Function or other executable code
+	SymbolTypeFunc
+	//3	STT_SECTION	Section
+	SymbolTypeSection
+	//4	STT_FILE	Source file name
+	SymbolTypeFile
+	//5	STT_COMMON	Uninitialized common block
+	SymbolTypeCommon
+	//6	STT_TLS	Thread local storage entity
+	SymbolTypeTLS
+	//10	STT_GNU_IFUNC	Indirect function (STT_LOOS)
+	SymbolTypeGNUIFunc SymbolType = 10
+	//12	STT_HIOS
+	SymbolTypeHiOS SymbolType = 12
+	//13	STT_LOPROC
+	SymbolTypeLowProc SymbolType = 13
+	//15	STT_HIPROC
+	SymbolTypeHighProc SymbolType = 15
+)
+
+func (st SymbolType) String() string {
+	switch {
+	case st <= SymbolTypeTLS:
+		return [...]string{"NOTYPE", "OBJECT", "FUNC", "SECTION", "FILE", "COMMON", "TLS"}[st]
+	case st == SymbolTypeGNUIFunc:
+		return "IFUNC"
+	case st > SymbolTypeGNUIFunc && st <= SymbolTypeHiOS:
+		return fmt.Sprintf("OS specific: %v", uint8(st))
+	case st >= SymbolTypeLowProc && st <= SymbolTypeHighProc:
+		return fmt.Sprintf("proc specific: %v", uint8(st))
+	}
+	return fmt.Sprintf("unknown: %v", uint8(st))
+}
+
+type SymbolVisibility uint8
+
+const (
+	//0	STV_DEFAULT	Visibility defined by binding
+	SymbolVisibilityDefault SymbolVisibility = iota
+	//1	STV_INTERNAL	Processor specific hidden
+	SymbolVisibilityInternal
+	//2	STV_HIDDEN	Not visible to other components
+	SymbolVisibilityHidden
+	//3	STV_PROTECTED	Visible but not preemptable
+	SymbolVisibilityProtected
+)
+
+func (sv SymbolVisibility) String() string {
+	return [...]string{"DEFAULT", "INTERNAL", "HIDDEN", "PROTECTED"}[sv&0x03]
+}
+
+// SectionIndex is index to section header table, values from SectionIndexLowReserve are special
+type SectionIndex uint32
+
+const (
+	//0	SHN_UNDEF	Undefined, missing or meaningless section reference
+	SectionIndexUndefined SectionIndex = 0
+	//0xff00	SHN_LORESERVE	Start of reserved indices, also SHN_LOPROC
+	SectionIndexLowReserve SectionIndex = 0xff00
+	//0xff1f	SHN_HIPROC
+	SectionIndexHighProc SectionIndex = 0xff1f
+	//0xff20	SHN_LOOS
+	SectionIndexLowOS SectionIndex = 0xff20
+	//0xff3f	SHN_HIOS
+	SectionIndexHiOS SectionIndex = 0xff3f
+	//0xfff1	SHN_ABS	Absolute values, not affected by relocation
+	SectionIndexAbsolute SectionIndex = 0xfff1
+	//0xfff2	SHN_COMMON	Common symbols (unallocated C external variables)
+	SectionIndexCommon SectionIndex = 0xfff2
+	//0xffff	SHN_XINDEX	Real index is stored elsewhere, also SHN_HIRESERVE
+	SectionIndexExtended SectionIndex = 0xffff
+)
+
+func (si SectionIndex) String() string {
+	switch {
+	case si == SectionIndexUndefined:
+		return "UND"
+	case si == SectionIndexAbsolute:
+		return "ABS"
+	case si == SectionIndexCommon:
+		return "COMMON"
+	case si == SectionIndexExtended:
+		return "XINDEX"
+	case si >= SectionIndexLowReserve && si <= SectionIndexHighProc:
+		return fmt.Sprintf("PRC[0x%04X]", uint32(si))
+	case si >= SectionIndexLowOS && si <= SectionIndexHiOS:
+		return fmt.Sprintf("OS [0x%04X]", uint32(si))
+	case si >= SectionIndexLowReserve && si <= SectionIndexExtended:
+		return fmt.Sprintf("RSV[0x%04X]", uint32(si))
+	}
+	return fmt.Sprintf("%v", uint32(si))
+}
+
+type Symbol struct {
+	NameOffset   uint32 // 4 bytes offset to string table linked by symbol table section
+	Name         string
+	Value        MemoryAddress    // 4 bytes on elf32 8 bytes on elf64
+	Size         uint64           // 4 bytes on elf32 8 bytes on elf64
+	Binding      SymbolBinding    // high 4 bits of info byte
+	Type         SymbolType       // low 4 bits of info byte
+	Visibility   SymbolVisibility // low 2 bits of other byte
+	SectionIndex SectionIndex     // 2 bytes
+
+	// GNU symbol versioning, filled only for dynamic symbols
+	Version       string // version name, empty for unversioned symbols
+	VersionHidden bool   // symbol is not default for its name (name@VERSION rather than name@@VERSION)
+	Library       string // library expected to provide version of undefined symbol
+}
+
+var ErrInvalidSymbol = errors.New("invalid symbol")
+var ErrNoSymbols = errors.New("no symbol table")
+
+// ReadSymbol reads single symbol table entry, Name is left unresolved
+func ReadSymbol(nativeReader NativeWordReader) (Symbol, error) {
+	var symbol Symbol
+
+	uint32val, err := nativeReader.Uint32()
+	if err != nil {
+		return symbol, fmt.Errorf("%w name offset read: %v", ErrInvalidSymbol, err)
+	}
+	symbol.NameOffset = uint32val
+
+	if nativeReader.Class == ELFClass32 {
+		if err := readSymbolValueSize(nativeReader, &symbol); err != nil {
+			return symbol, err
+		}
+	}
+
+	info, err := nativeReader.Uint8()
+	if err != nil {
+		return symbol, fmt.Errorf("%w info read: %v", ErrInvalidSymbol, err)
+	}
+	symbol.Binding = SymbolBinding(info >> 4)
+	symbol.Type = SymbolType(info & 0x0F)
+
+	other, err := nativeReader.Uint8()
+	if err != nil {
+		return symbol, fmt.Errorf("%w other read: %v", ErrInvalidSymbol, err)
+	}
+	symbol.Visibility = SymbolVisibility(other & 0x03)
+
+	uint16val, err := nativeReader.Uint16()
+	if err != nil {
+		return symbol, fmt.Errorf("%w section index read: %v", ErrInvalidSymbol, err)
+	}
+	symbol.SectionIndex = SectionIndex(uint16val)
+
+	if nativeReader.Class == ELFClass64 {
+		if err := readSymbolValueSize(nativeReader, &symbol); err != nil {
+			return symbol, err
+		}
+	}
+
+	return symbol, nil
+}
+
+func readSymbolValueSize(nativeReader NativeWordReader, symbol *Symbol) error {
+	wordVal, err := nativeReader.ReadNativeWord()
+	if err != nil {
+		return fmt.Errorf("%w value read: %v", ErrInvalidSymbol, err)
+	}
+	symbol.Value = MemoryAddress(wordVal)
+
+	wordVal, err = nativeReader.ReadNativeWord()
+	if err != nil {
+		return fmt.Errorf("%w size read: %v", ErrInvalidSymbol, err)
+	}
+	symbol.Size = wordVal
+	return nil
+}
+
+// Symbols returns content of SHT_SYMTAB section. Slice is indexed by symbol index, so first entry is always reserved null symbol
+func (f *File) Symbols() ([]Symbol, error) {
+	return f.symbolsOfType(SectionTypeSymTable)
+}
+
+// DynamicSymbols returns content of SHT_DYNSYM section with GNU versions attached. Slice is indexed by symbol index, so first entry
+// is always reserved null symbol. If file has no such section, symbols are read through DT_SYMTAB with count taken from hash table
+func (f *File) DynamicSymbols() ([]Symbol, error) {
+	if len(f.SectionsByType(SectionTypeDynLinkSymTab)) > 0 {
+		symbols, err := f.symbolsOfType(SectionTypeDynLinkSymTab)
+		if err != nil {
+			return nil, err
+		}
+		if err := f.attachVersions(symbols); err != nil {
+			return nil, err
+		}
+		return symbols, nil
+	}
+	table, err := f.dynamicSymbolTable()
+	if err != nil {
+		return nil, fmt.Errorf("%w of type %v: %v", ErrNoSymbols, SectionTypeDynLinkSymTab, err)
+	}
+	return append([]Symbol(nil), table.symbols...), nil
+}
+
+func (f *File) symbolsOfType(sectionType SectionType) ([]Symbol, error) {
+	sections := f.SectionsByType(sectionType)
+	if len(sections) == 0 {
+		return nil, fmt.Errorf("%w of type %v", ErrNoSymbols, sectionType)
+	}
+	return f.SectionSymbols(sections[0])
+}
+
+// SectionSymbols decodes all entries of given symbol table section with names from linked string table
+func (f *File) SectionSymbols(section *Section) ([]Symbol, error) {
+	minSize := uint64(symbolSize32)
+	if f.Class == ELFClass64 {
+		minSize = symbolSize64
+	}
+	entrySize := section.EntrySize
+	if entrySize == 0 {
+		entrySize = minSize
+	}
+	if entrySize < minSize {
+		return nil, fmt.Errorf("%w entry size %v is smaller than %v", ErrInvalidSymbol, entrySize, minSize)
+	}
+
+	if int(section.Link) >= len(f.Sections) {
+		return nil, fmt.Errorf("%w string table index %v out of bounds: %v", ErrInvalidSymbol, section.Link, len(f.Sections))
+	}
+	strContent, err := f.Sections[section.Link].Data()
+	if err != nil {
+		return nil, fmt.Errorf("%w string table read: %v", ErrInvalidSymbol, err)
+	}
+	names := StringTable(strContent)
+
+	content, err := section.Data()
+	if err != nil {
+		return nil, fmt.Errorf("%w symbol table read: %v", ErrInvalidSymbol, err)
+	}
+
+	var extendedIndices []byte
+	symbols := make([]Symbol, 0, uint64(len(content))/entrySize)
+	for offset := uint64(0); offset+entrySize <= uint64(len(content)); offset += entrySize {
+		symbol, err := ReadSymbol(f.NativeReader(bytes.NewReader(content[offset : offset+entrySize])))
+		if err != nil {
+			return nil, fmt.Errorf("symbol %v: %w", len(symbols), err)
+		}
+		symbol.Name, err = names.String(symbol.NameOffset)
+		if err != nil {
+			return nil, fmt.Errorf("symbol %v name: %w", len(symbols), err)
+		}
+		if symbol.SectionIndex == SectionIndexExtended {
+			if extendedIndices == nil {
+				extendedIndices, err = f.extendedSectionIndices(section)
+				if err != nil {
+					return nil, fmt.Errorf("symbol %v: %w", len(symbols), err)
+				}
+			}
+			position := len(symbols) * 4
+			if position+4 > len(extendedIndices) {
+				return nil, fmt.Errorf("%w symbol %v has no extended section index", ErrInvalidSymbol, len(symbols))
+			}
+			symbol.SectionIndex = SectionIndex(f.ByteOrder().Uint32(extendedIndices[position:]))
+		}
+		symbols = append(symbols, symbol)
+	}
+	return symbols, nil
+}
+
+// extendedSectionIndices returns content of SHT_SYMTAB_SHNDX section linked to given symbol table - one 4 byte section index per symbol
+func (f *File) extendedSectionIndices(symbolSection *Section) ([]byte, error) {
+	index, ok := f.sectionIndex(symbolSection)
+	if !ok {
+		return nil, fmt.Errorf("%w symbol table is not part of file", ErrInvalidSymbol)
+	}
+	for _, section := range f.SectionsByType(SectionTypeExtSectionInd) {
+		if int(section.Link) != index {
+			continue
+		}
+		content, err := section.Data()
+		if err != nil {
+			return nil, fmt.Errorf("%w extended section indices read: %v", ErrInvalidSymbol, err)
+		}
+		return content, nil
+	}
+	return nil, fmt.Errorf("%w no extended section indices for symbol table %v", ErrInvalidSymbol, index)
+}
@@ -0,0 +1,152 @@
+package elf
+
+import (
+	"errors"
+	"fmt"
+)
+
+// SymbolSource selects symbol tables searched by symbol lookups, tables are searched in order of constants
+type SymbolSource uint8
+
+const (
+	// SHT_SYMTAB section of the file
+	SymbolSourceSymTable SymbolSource = 1 << iota
+	// dynamic symbols, see DynamicSymbols
+	SymbolSourceDynamic
+	// SHT_SYMTAB section of MiniDebugInfo embedded in .gnu_debugdata, see MiniDebugInfo
+	SymbolSourceMiniDebugInfo
+
+	SymbolSourceDefault = SymbolSourceSymTable | SymbolSourceDynamic
+	SymbolSourceAll     = SymbolSourceDefault | SymbolSourceMiniDebugInfo
+)
+
+var symbolSourceNames = [...]string{"SYMTAB", "DYNAMIC", "MINIDEBUGINFO"}
+
+func (ss SymbolSource) String() string {
+	return flagNames(uint64(ss), symbolSourceNames[:])
+}
+
+// LookupSymbol finds defined symbol by name in symbol tables of given sources. Symbol with default version is preferred
+// over hidden versions of the same name. Sources without symbol table are skipped
+func (f *File) LookupSymbol(name string, sources SymbolSource) (Symbol, error) {
+	var found *Symbol
+	err := f.forEachSymbolSource(sources, func(symbols []Symbol) bool {
+		var match matchingSymbol
+		for i := range symbols {
+			if symbols[i].SectionIndex != SectionIndexUndefined && match.check(symbols, uint32(i), name) {
+				break
+			}
+		}
+		if index, ok := match.result(); ok {
+			found = &symbols[index]
+		}
+		return found != nil
+	})
+	if err != nil {
+		return Symbol{}, err
+	}
+	if found == nil {
+		return Symbol{}, fmt.Errorf("%w: %v", ErrSymbolNotFound, name)
+	}
+	return *found, nil
+}
+
+// SymbolForAddress finds function or data object symbol covering given virtual address in symbol tables of given sources.
+// Symbols with size are preferred. Zero sized symbols, like functions of crt files, cover addresses up to the next symbol
+// within the same section. Sources without symbol table are skipped
+func (f *File) SymbolForAddress(address MemoryAddress, sources SymbolSource) (Symbol, error) {
+	var found, nearest *Symbol
+	err := f.forEachSymbolSource(sources, func(symbols []Symbol) bool {
+		var candidate *Symbol
+		for i := range symbols {
+			symbol := &symbols[i]
+			if !isAddressSymbol(*symbol) || address < symbol.Value {
+				continue
+			}
+			if uint64(address-symbol.Value) < symbol.Size {
+				found = symbol
+				return true
+			}
+			if symbol.Size == 0 && (candidate == nil || symbol.Value > candidate.Value) {
+				candidate = symbol
+			}
+		}
+		if nearest == nil && candidate != nil && f.coversUpTo(symbols, *candidate, address) {
+			nearest = candidate
+		}
+		return false
+	})
+	if err != nil {
+		return Symbol{}, err
+	}
+	if found == nil {
+		found = nearest
+	}
+	if found == nil {
+		return Symbol{}, fmt.Errorf("%w at address %v", ErrSymbolNotFound, address)
+	}
+	return *found, nil
+}
+
+// coversUpTo reports if zero sized symbol is not followed by other symbol before address and both are in the same section
+func (f *File) coversUpTo(symbols []Symbol, symbol Symbol, address MemoryAddress) bool {
+	for _, other := range symbols {
+		if isAddressSymbol(other) && other.Value > symbol.Value && other.Value <= address {
+			return false
+		}
+	}
+	for _, section := range f.Sections {
+		if !section.Flags.Allocated() || section.Virtual > address || uint64(address-section.Virtual) >= section.Size {
+			continue
+		}
+		return symbol.Value >= section.Virtual
+	}
+	return true
+}
+
+func isAddressSymbol(symbol Symbol) bool {
+	switch symbol.Type {
+	case SymbolTypeNone, SymbolTypeObject, SymbolTypeFunc, SymbolTypeGNUIFunc:
+	default:
+		return false
+	}
+	switch symbol.SectionIndex {
+	case SectionIndexUndefined, SectionIndexAbsolute, SectionIndexCommon:
+		return false
+	}
+	return true
+}
+
+// forEachSymbolSource calls visit with symbols of each selected source until it returns true
+func (f *File) forEachSymbolSource(sources SymbolSource, visit func(symbols []Symbol) bool) error {
+	readers := []struct {
+		source SymbolSource
+		read   func() ([]Symbol, error)
+	}{
+		{SymbolSourceSymTable, f.Symbols},
+		{SymbolSourceDynamic, f.DynamicSymbols},
+		{SymbolSourceMiniDebugInfo, func() ([]Symbol, error) {
+			embedded, err := f.MiniDebugInfo()
+			if err != nil {
+				return nil, err
+			}
+			return embedded.Symbols()
+		}},
+	}
+	for _, reader := range readers {
+		if sources&reader.source == 0 {
+			continue
+		}
+		symbols, err := reader.read()
+		if errors.Is(err, ErrNoSymbols) || errors.Is(err, ErrNoMiniDebugInfo) {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("%v symbols: %w", reader.source, err)
+		}
+		if visit(symbols) {
+			return nil
+		}
+	}
+	return nil
+}
@@ -0,0 +1,86 @@
+package elf
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSymbols(t *testing.T) {
+	tcs := []struct {
+		filename  string
+		count     int
+		mainIndex int
+		mainValue MemoryAddress
+		mainSize  uint64
+	}{
+		{"helloworld_linux_386", 2648, 2647, 0x080cb540, 116},
+		{"helloworld_linux_amd64", 2626, 2625, 0x491410, 137},
+		{"helloworld_linux_ppc64", 2641, 2640, 0x09fbf0, 128},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.filename, func(t *testing.T) {
+			file, err := Open(filepath.Join("testdata", tc.filename))
+			assert.NoError(t, err)
+			defer file.Close()
+
+			symbols, err := file.Symbols()
+			assert.NoError(t, err)
+			assert.Len(t, symbols, tc.count)
+			assert.Equal(t, Symbol{}, symbols[0])
+			assert.Equal(t, Symbol{
+				NameOffset:   symbols[tc.mainIndex].NameOffset,
+				Name:         "main.main",
+				Value:        tc.mainValue,
+				Size:         tc.mainSize,
+				Binding:      SymbolBindingGlobal,
+				Type:         SymbolTypeFunc,
+				Visibility:   SymbolVisibilityDefault,
+				SectionIndex: 1,
+			}, symbols[tc.mainIndex])
+
+			_, err = file.DynamicSymbols()
+			assert.True(t, errors.Is(err, ErrNoSymbols))
+		})
+	}
+}
+
+func TestDynamicSymbols(t *testing.T) {
+	file, err := Open(filepath.Join("testdata", "libsample.so"))
+	assert.NoError(t, err)
+	defer file.Close()
+
+	symbols, err := file.DynamicSymbols()
+	assert.NoError(t, err)
+	assert.Len(t, symbols, 12)
+
+	assert.Equal(t, "getenv", symbols[1].Name)
+	assert.Equal(t, SectionIndexUndefined, symbols[1].SectionIndex)
+	assert.Equal(t, SymbolTypeFunc, symbols[1].Type)
+
+	assert.Equal(t, "__gmon_start__", symbols[3].Name)
+	assert.Equal(t, SymbolBindingWeak, symbols[3].Binding)
+	assert.Equal(t, SymbolTypeNone, symbols[3].Type)
+
+	assert.Equal(t, "SAMPLE_2.0", symbols[8].Name)
+	assert.Equal(t, SectionIndexAbsolute, symbols[8].SectionIndex)
+	assert.Equal(t, SymbolTypeObject, symbols[8].Type)
+
+	assert.Equal(t, "sample_value", symbols[9].Name)
+	assert.Equal(t, SymbolTypeFunc, symbols[9].Type)
+	assert.Equal(t, SectionIndex(14), symbols[9].SectionIndex)
+	assert.Equal(t, ".text", file.Sections[symbols[9].SectionIndex].Name)
+}
+
+func TestSymbolEnumStrings(t *testing.T) {
+	assert.Equal(t, "GLOBAL", SymbolBindingGlobal.String())
+	assert.Equal(t, "UNIQUE", SymbolBindingGNUUnique.String())
+	assert.Equal(t, "IFUNC", SymbolTypeGNUIFunc.String())
+	assert.Equal(t, "unknown: 8", SymbolType(8).String())
+	assert.Equal(t, "HIDDEN", SymbolVisibilityHidden.String())
+	assert.Equal(t, "ABS", SectionIndexAbsolute.String())
+	assert.Equal(t, "COMMON", SectionIndexCommon.String())
+	assert.Equal(t, "12", SectionIndex(12).String())
+}
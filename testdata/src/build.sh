@@ -0,0 +1,63 @@
+#!/bin/sh
+# Rebuilds C/C++ test binaries in testdata directory. Requires gcc, g++ and binutils
+set -e
+cd "$(dirname "$0")"
+OUT=..
+
+gcc -shared -fPIC -g -O1 -Wl,--build-id -Wl,--hash-style=both -Wl,-soname,libsample.so.1 -Wl,--version-script=libsample.map \
+	-o $OUT/libsample.so libsample.c
+g++ -g -O1 -Wl,--build-id -Wl,--enable-new-dtags -Wl,-rpath,'$ORIGIN/lib' -o $OUT/sample_linux_amd64 sample.cpp -L$OUT -l:libsample.so
+g++ -g -O1 -c -o $OUT/sample_linux_amd64.o sample.cpp
+gcc -m32 -g -O1 -c -o $OUT/object_linux_386.o object.c
+# gold version note and systemd package metadata note
+gcc -shared -fPIC -g -O1 -fuse-ld=gold -DSAMPLE_PACKAGE_NOTE -Wl,--build-id -Wl,--hash-style=both -Wl,-soname,libsample.so.1 -Wl,--version-script=libsample.map \
+	-o $OUT/libsample_gold.so libsample.c
+# compressed debug sections: gABI zlib and zstd with Elf32_Chdr/Elf64_Chdr and legacy .zdebug_* form
+objcopy --compress-debug-sections=zlib-gabi $OUT/object_linux_386.o $OUT/object_zlib_linux_386.o
+objcopy --compress-debug-sections=zstd $OUT/object_linux_386.o $OUT/object_zstd_linux_386.o
+objcopy --compress-debug-sections=zlib-gnu $OUT/object_linux_386.o $OUT/object_zdebug_linux_386.o
+gcc -g -O1 -c -o $OUT/object_linux_amd64.o object.c
+objcopy --compress-debug-sections=zstd $OUT/object_linux_amd64.o $OUT/object_zstd_linux_amd64.o
+rm $OUT/object_linux_amd64.o
+# big endian ELF64 SPARC v9 relocations
+llvm-mc -triple=sparcv9-unknown-linux-gnu -filetype=obj -o $OUT/relocation_linux_sparc64.o relocation_sparcv9.s
+# MiniDebugInfo as added by Fedora find-debuginfo: xz compressed .symtab of functions missing from .dynsym in stripped library
+nm -D $OUT/libsample.so --format=posix --defined-only | awk '{ print $1 }' | sed 's/@.*//' | sort > $OUT/dynsyms
+nm $OUT/libsample.so --format=posix --defined-only | awk '{ if ($2 == "T" || $2 == "t") print $1 }' | sort > $OUT/funcsyms
+comm -13 $OUT/dynsyms $OUT/funcsyms > $OUT/keep_symbols
+objcopy --only-keep-debug $OUT/libsample.so $OUT/mini_debuginfo
+objcopy -S --remove-section .comment --keep-symbols=$OUT/keep_symbols $OUT/mini_debuginfo
+xz -f $OUT/mini_debuginfo
+strip --strip-all -o $OUT/libsample_minidebuginfo.so $OUT/libsample.so
+objcopy --add-section .gnu_debugdata=$OUT/mini_debuginfo.xz $OUT/libsample_minidebuginfo.so
+rm $OUT/dynsyms $OUT/funcsyms $OUT/keep_symbols $OUT/mini_debuginfo.xz
+# separate debug file found through .gnu_debuglink in .debug directory and through build ID in debug root
+mkdir -p $OUT/debug/.debug $OUT/debug/root/.build-id
+objcopy --only-keep-debug $OUT/libsample.so $OUT/debug/.debug/libsample_stripped.so.debug
+strip --strip-debug --strip-unneeded -o $OUT/debug/libsample_stripped.so $OUT/libsample.so
+objcopy --add-gnu-debuglink=$OUT/debug/.debug/libsample_stripped.so.debug $OUT/debug/libsample_stripped.so
+BUILD_ID=$(readelf -n $OUT/libsample.so | sed -n 's/.*Build ID: //p')
+mkdir -p $OUT/debug/root/.build-id/$(echo $BUILD_ID | cut -c1-2)
+ln -sf ../../../.debug/libsample_stripped.so.debug $OUT/debug/root/.build-id/$(echo $BUILD_ID | cut -c1-2)/$(echo $BUILD_ID | cut -c3-).debug
+# DWARF 2 line table emitted by compiler itself and DWARF 4 one emitted by assembler, DWARF 5 is the default of other files
+gcc -gdwarf-2 -gno-as-loc-support -O1 -c -o $OUT/object_dwarf2_linux_amd64.o object.c
+gcc -gdwarf-4 -O1 -c -o $OUT/object_dwarf4_linux_amd64.o object.c
+# inlined subroutines with range and location lists of DWARF 5 and DWARF 4
+gcc -g -O2 -o $OUT/inline_linux_amd64 inline.c
+gcc -gdwarf-4 -O2 -o $OUT/inline_dwarf4_linux_amd64 inline.c
+# structures with holes, bit fields and trailing padding, DWARF 2 uses member location expressions
+gcc -g -c -o $OUT/layout_linux_amd64.o layout.c
+gcc -gdwarf-2 -c -o $OUT/layout_dwarf2_linux_amd64.o layout.c
+# C++ type units of DWARF 5, whose members refer to types of other type units by signature
+g++ -g -fdebug-types-section -shared -fPIC -o $OUT/layout_types_linux_amd64.so -x c++ layout.c
+# split DWARF 5 and GNU split DWARF 4 with .dwo files next to executables and packages of them
+gcc -g -O2 -gsplit-dwarf -o $OUT/split_linux_amd64 inline.c layout.c
+gcc -gdwarf-4 -O2 -gsplit-dwarf -o $OUT/split_dwarf4_linux_amd64 inline.c layout.c
+llvm-dwp -e $OUT/split_linux_amd64 -o $OUT/split_linux_amd64.dwp
+llvm-dwp -e $OUT/split_dwarf4_linux_amd64 -o $OUT/split_dwarf4_linux_amd64.dwp
+# name indexes: llc emits .debug_names of hand written IR, layout.c unit has none, gold adds .gdb_index of C++ units
+llc -O0 -filetype=obj -relocation-model=pic -accel-tables=Dwarf -o $OUT/names.o names.ll
+llc -O0 -filetype=obj -relocation-model=pic -accel-tables=Dwarf -o $OUT/names_helper.o names_helper.ll
+gcc -g -o $OUT/names_linux_amd64 $OUT/names.o $OUT/names_helper.o layout.c
+rm $OUT/names.o $OUT/names_helper.o
+g++ -g -O1 -fuse-ld=gold -Wl,--gdb-index -o $OUT/gdb_index_linux_amd64 sample.cpp -x c libsample.c
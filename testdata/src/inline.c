@@ -0,0 +1,28 @@
+#include <stdio.h>
+#include <stdlib.h>
+
+static volatile int sink;
+
+static inline __attribute__((always_inline)) int square(int value) {
+	sink = value;
+	return value * value;
+}
+
+static inline __attribute__((always_inline)) int sum_of_squares(int a, int b) {
+	int result = square(a);
+	result += square(b);
+	return result;
+}
+
+__attribute__((noinline)) int compute(int a, int b) {
+	int result = sum_of_squares(a, b);
+	for (int i = 0; i < a; i++) {
+		result += sum_of_squares(i, result);
+	}
+	return result;
+}
+
+int main(int argc, char **argv) {
+	printf("%d\n", compute(argc, atoi(argv[0])));
+	return 0;
+}
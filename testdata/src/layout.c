@@ -0,0 +1,48 @@
+#include <stdint.h>
+
+struct padded {
+	char tag;
+	long value;
+	short count;
+};
+
+struct flags {
+	unsigned int ready : 1;
+	unsigned int mode : 3;
+	char name[5];
+	unsigned int level : 12;
+	int *next;
+};
+
+union number {
+	int integer;
+	double real;
+	char bytes[12];
+};
+
+typedef struct padded padded_t;
+
+struct wide {
+	const char *name;
+	char code;
+	padded_t first;
+	union number values[4];
+	void (*callback)(int, const char *);
+	volatile uint16_t counter;
+	struct {
+		int x, y;
+	} point;
+};
+
+struct packed_tight {
+	long a;
+	int b;
+	short c;
+	char d;
+};
+
+struct padded g_padded;
+struct flags g_flags;
+union number g_number;
+struct wide g_wide;
+struct packed_tight g_packed_tight;
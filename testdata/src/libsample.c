@@ -0,0 +1,29 @@
+#include <stdlib.h>
+#include <string.h>
+#include "sample.h"
+
+__attribute__((symver("sample_value@SAMPLE_1.0"))) int sample_value_v1(int input) {
+	return input + 1;
+}
+
+__attribute__((symver("sample_value@@SAMPLE_2.0"))) int sample_value_v2(int input) {
+	return input * 2;
+}
+
+const char *sample_name(void) {
+	const char *name = getenv("SAMPLE_NAME");
+	return name != NULL ? strdup(name) : "sample";
+}
+
+#ifdef SAMPLE_PACKAGE_NOTE
+/* systemd package metadata note, see https://systemd.io/ELF_PACKAGE_METADATA/ */
+__asm__(".pushsection .note.package, \"a\", @note\n"
+	".balign 4\n"
+	".long 4\n"
+	".long 2f - 1f\n"
+	".long 0xcafe1a7e\n"
+	".asciz \"FDO\"\n"
+	"1: .asciz \"{\\\"type\\\":\\\"deb\\\",\\\"os\\\":\\\"debian\\\",\\\"osVersion\\\":\\\"12\\\",\\\"name\\\":\\\"libsample\\\",\\\"version\\\":\\\"1.0-1\\\",\\\"architecture\\\":\\\"amd64\\\"}\"\n"
+	"2: .balign 4\n"
+	".popsection");
+#endif
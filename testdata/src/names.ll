@@ -0,0 +1,62 @@
+; hand written IR of names.c, llc emits DWARF 5 .debug_names for it
+;
+; struct point { int x, y; };
+; typedef struct point point_t;
+; point_t origin;
+; int helper(int value);
+; static int twice(int value) { return value * 2; }
+; int main(void) { return twice(helper(origin.x)); }
+source_filename = "names.c"
+target datalayout = "e-m:e-p270:32:32-p271:32:32-p272:64:64-i64:64-f80:128-n8:16:32:64-S128"
+target triple = "x86_64-pc-linux-gnu"
+
+%struct.point = type { i32, i32 }
+
+@origin = dso_local global %struct.point zeroinitializer, align 4, !dbg !0
+
+define internal i32 @twice(i32 %value) !dbg !30 {
+entry:
+  call void @llvm.dbg.value(metadata i32 %value, metadata !33, metadata !DIExpression()), !dbg !34
+  %result = mul nsw i32 %value, 2, !dbg !34
+  ret i32 %result, !dbg !34
+}
+
+define dso_local i32 @main() !dbg !20 {
+entry:
+  %x = load i32, i32* getelementptr inbounds (%struct.point, %struct.point* @origin, i32 0, i32 0), align 4, !dbg !24
+  %value = call i32 @helper(i32 %x), !dbg !24
+  %result = call i32 @twice(i32 %value), !dbg !24
+  ret i32 %result, !dbg !24
+}
+
+declare i32 @helper(i32)
+
+declare void @llvm.dbg.value(metadata, metadata, metadata)
+
+!llvm.dbg.cu = !{!2}
+!llvm.module.flags = !{!10, !11, !12}
+
+!0 = !DIGlobalVariableExpression(var: !1, expr: !DIExpression())
+!1 = distinct !DIGlobalVariable(name: "origin", scope: !2, file: !3, line: 3, type: !14, isLocal: false, isDefinition: true)
+!2 = distinct !DICompileUnit(language: DW_LANG_C99, file: !3, producer: "hand written", isOptimized: false, runtimeVersion: 0, emissionKind: FullDebug, globals: !5)
+!3 = !DIFile(filename: "names.c", directory: "/root/module/testdata/src")
+!4 = !{}
+!5 = !{!0}
+!6 = distinct !DICompositeType(tag: DW_TAG_structure_type, name: "point", file: !3, line: 1, size: 64, elements: !7)
+!7 = !{!8, !9}
+!8 = !DIDerivedType(tag: DW_TAG_member, name: "x", scope: !6, file: !3, line: 1, baseType: !13, size: 32)
+!9 = !DIDerivedType(tag: DW_TAG_member, name: "y", scope: !6, file: !3, line: 1, baseType: !13, size: 32, offset: 32)
+!10 = !{i32 7, !"Dwarf Version", i32 5}
+!11 = !{i32 2, !"Debug Info Version", i32 3}
+!12 = !{i32 1, !"wchar_size", i32 4}
+!13 = !DIBasicType(name: "int", size: 32, encoding: DW_ATE_signed)
+!14 = !DIDerivedType(tag: DW_TAG_typedef, name: "point_t", file: !3, line: 2, baseType: !6)
+!20 = distinct !DISubprogram(name: "main", scope: !3, file: !3, line: 8, type: !21, scopeLine: 8, flags: DIFlagPrototyped, spFlags: DISPFlagDefinition, unit: !2, retainedNodes: !4)
+!21 = !DISubroutineType(types: !22)
+!22 = !{!13}
+!24 = !DILocation(line: 8, column: 18, scope: !20)
+!30 = distinct !DISubprogram(name: "twice", scope: !3, file: !3, line: 7, type: !31, scopeLine: 7, flags: DIFlagPrototyped, spFlags: DISPFlagLocalToUnit | DISPFlagDefinition, unit: !2, retainedNodes: !4)
+!31 = !DISubroutineType(types: !32)
+!32 = !{!13, !13}
+!33 = !DILocalVariable(name: "value", arg: 1, scope: !30, file: !3, line: 7, type: !13)
+!34 = !DILocation(line: 7, column: 31, scope: !30)
@@ -0,0 +1,50 @@
+; hand written IR of names_helper.c, llc emits DWARF 5 .debug_names for it
+;
+; static int twice(int value) { return value + value; }
+; int helper(int value) { static int calls; calls++; return twice(value); }
+source_filename = "names_helper.c"
+target datalayout = "e-m:e-p270:32:32-p271:32:32-p272:64:64-i64:64-f80:128-n8:16:32:64-S128"
+target triple = "x86_64-pc-linux-gnu"
+
+@calls = internal global i32 0, align 4, !dbg !0
+
+define internal i32 @twice(i32 %value) !dbg !30 {
+entry:
+  call void @llvm.dbg.value(metadata i32 %value, metadata !33, metadata !DIExpression()), !dbg !34
+  %result = add nsw i32 %value, %value, !dbg !34
+  ret i32 %result, !dbg !34
+}
+
+define dso_local i32 @helper(i32 %value) !dbg !20 {
+entry:
+  call void @llvm.dbg.value(metadata i32 %value, metadata !23, metadata !DIExpression()), !dbg !24
+  %calls = load i32, i32* @calls, align 4, !dbg !24
+  %next = add nsw i32 %calls, 1, !dbg !24
+  store i32 %next, i32* @calls, align 4, !dbg !24
+  %result = call i32 @twice(i32 %value), !dbg !24
+  ret i32 %result, !dbg !24
+}
+
+declare void @llvm.dbg.value(metadata, metadata, metadata)
+
+!llvm.dbg.cu = !{!2}
+!llvm.module.flags = !{!10, !11, !12}
+
+!0 = !DIGlobalVariableExpression(var: !1, expr: !DIExpression())
+!1 = distinct !DIGlobalVariable(name: "calls", scope: !20, file: !3, line: 2, type: !13, isLocal: true, isDefinition: true)
+!2 = distinct !DICompileUnit(language: DW_LANG_C99, file: !3, producer: "hand written", isOptimized: false, runtimeVersion: 0, emissionKind: FullDebug, globals: !5)
+!3 = !DIFile(filename: "names_helper.c", directory: "/root/module/testdata/src")
+!4 = !{}
+!5 = !{!0}
+!10 = !{i32 7, !"Dwarf Version", i32 5}
+!11 = !{i32 2, !"Debug Info Version", i32 3}
+!12 = !{i32 1, !"wchar_size", i32 4}
+!13 = !DIBasicType(name: "int", size: 32, encoding: DW_ATE_signed)
+!20 = distinct !DISubprogram(name: "helper", scope: !3, file: !3, line: 2, type: !21, scopeLine: 2, flags: DIFlagPrototyped, spFlags: DISPFlagDefinition, unit: !2, retainedNodes: !4)
+!21 = !DISubroutineType(types: !22)
+!22 = !{!13, !13}
+!23 = !DILocalVariable(name: "value", arg: 1, scope: !20, file: !3, line: 2, type: !13)
+!24 = !DILocation(line: 2, column: 25, scope: !20)
+!30 = distinct !DISubprogram(name: "twice", scope: !3, file: !3, line: 1, type: !21, scopeLine: 1, flags: DIFlagPrototyped, spFlags: DISPFlagLocalToUnit | DISPFlagDefinition, unit: !2, retainedNodes: !4)
+!33 = !DILocalVariable(name: "value", arg: 1, scope: !30, file: !3, line: 1, type: !13)
+!34 = !DILocation(line: 1, column: 31, scope: !30)
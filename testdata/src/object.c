@@ -0,0 +1,11 @@
+extern int external_value;
+
+static int counter;
+int *counter_ref = &counter;
+
+int add(int input) {
+	counter++;
+	return input + external_value + counter;
+}
+
+int (*add_ref)(int) = add;
@@ -0,0 +1,14 @@
+! SPARC v9 relocations of external symbols, r_info of ELF64 SPARC keeps type in its low 8 bits only
+	.text
+	.globl	entry
+entry:
+	sethi	%hi(external_value), %o0
+	or	%o0, %lo(external_value), %o0
+	call	external_function
+	nop
+	retl
+	nop
+
+	.data
+	.xword	external_value
+	.word	external_function
@@ -0,0 +1,56 @@
+#include <cstdio>
+#include <cstring>
+#include <stdexcept>
+#include <string>
+#include <vector>
+
+#include "sample.h"
+
+struct Padded {
+	char flag;
+	long value;
+	short small;
+	int low : 3;
+	int high : 5;
+	char tail;
+};
+
+union Number {
+	int integer;
+	double real;
+};
+
+template <typename T> T twice(T value) {
+	return value + value;
+}
+
+static inline __attribute__((always_inline)) int scaled(int a, int b) {
+	return a * b + a;
+}
+
+long padded_sum(const Padded *padded) {
+	return padded->flag + padded->value + padded->small + padded->low + padded->high + padded->tail;
+}
+
+int main(int argc, char **argv) {
+	std::vector<std::string> args(argv, argv + argc);
+	Padded padded{};
+	padded.value = static_cast<long>(args.size());
+	Number number;
+	number.integer = twice(argc);
+
+	char buff[64];
+	std::memcpy(buff, args[0].c_str(), std::min(sizeof(buff) - 1, args[0].size() + 1));
+	buff[sizeof(buff) - 1] = 0;
+
+	try {
+		if (argc > 5) {
+			throw std::runtime_error("too many arguments");
+		}
+	} catch (const std::exception &e) {
+		std::puts(e.what());
+	}
+
+	std::printf("%s %s %d %ld %f\n", buff, sample_name(), sample_value(scaled(argc, 3)), padded_sum(&padded), twice(1.5));
+	return number.integer > 100;
+}
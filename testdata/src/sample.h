@@ -0,0 +1,15 @@
+#ifndef SAMPLE_H
+#define SAMPLE_H
+
+#ifdef __cplusplus
+extern "C" {
+#endif
+
+int sample_value(int input);
+const char *sample_name(void);
+
+#ifdef __cplusplus
+}
+#endif
+
+#endif
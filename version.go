@@ -0,0 +1,350 @@
+package elf
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math"
+)
+
+// VersionFlags are flags of version definition or needed version entries
+type VersionFlags uint16
+
+const (
+	//0x1	VER_FLG_BASE	Version definition of the file itself
+	VersionFlagBase VersionFlags = 0x1
+	//0x2	VER_FLG_WEAK	Weak version identifier
+	VersionFlagWeak VersionFlags = 0x2
+	//0x4	VER_FLG_INFO	Reference exists for informational purposes only
+	VersionFlagInfo VersionFlags = 0x4
+)
+
+var versionFlagNames = [...]string{"BASE", "WEAK", "INFO"}
+
+func (vf VersionFlags) String() string {
+	return flagNames(uint64(vf), versionFlagNames[:])
+}
+
+const (
+	// versym entry of local symbol
+	versionIndexLocal = 0
+	// versym entry of unversioned global symbol
+	versionIndexGlobal = 1
+	// versym bit marking non default version
+	versionIndexHidden = 0x8000
+)
+
+// VersionDefinition is single Elf_Verdef entry with its Elf_Verdaux names, first name is the version itself, others are its parents
+type VersionDefinition struct {
+	Index   uint16
+	Flags   VersionFlags
+	Hash    uint32
+	Name    string
+	Parents []string
+}
+
+// VersionNeed is single Elf_Verneed entry - versions required from one library
+type VersionNeed struct {
+	File     string
+	Versions []NeededVersion
+}
+
+// NeededVersion is single Elf_Vernaux entry
+type NeededVersion struct {
+	Name  string
+	Hash  uint32
+	Flags VersionFlags
+	Index uint16 // index used by versym entries referring to this version
+}
+
+var ErrInvalidVersion = errors.New("invalid symbol version")
+
+// version entry sizes, they are the same for both classes
+const (
+	verdefSize  = 20
+	verdauxSize = 8
+	verneedSize = 16
+	vernauxSize = 16
+)
+
+// versionTable is location of verdef or verneed entries, either from section or from dynamic section tags
+type versionTable struct {
+	reader  io.ReaderAt
+	offset  int64
+	count   uint32
+	strings StringTable
+}
+
+// VersionDefinitions returns entries of SHT_GNU_verdef section or DT_VERDEF table if there is no such section, nil if file defines no versions
+func (f *File) VersionDefinitions() ([]VersionDefinition, error) {
+	table, err := f.versionTable(SectionTypeGNUVerDef, DynamicTagVerDef, DynamicTagVerDefNum)
+	if err != nil || table == nil {
+		return nil, err
+	}
+
+	var definitions []VersionDefinition
+	offset := table.offset
+	for i := uint32(0); i < table.count; i++ {
+		nativeReader := f.NativeReader(io.NewSectionReader(table.reader, offset, verdefSize))
+		var fields [4]uint16
+		for j := range fields {
+			if fields[j], err = nativeReader.Uint16(); err != nil {
+				return nil, fmt.Errorf("%w definition %v read: %v", ErrInvalidVersion, i, err)
+			}
+		}
+		var words [3]uint32
+		for j := range words {
+			if words[j], err = nativeReader.Uint32(); err != nil {
+				return nil, fmt.Errorf("%w definition %v read: %v", ErrInvalidVersion, i, err)
+			}
+		}
+		if fields[0] != 1 {
+			return nil, fmt.Errorf("%w definition %v revision %v", ErrInvalidVersion, i, fields[0])
+		}
+		definition := VersionDefinition{Flags: VersionFlags(fields[1]), Index: fields[2], Hash: words[0]}
+
+		auxOffset := offset + int64(words[1])
+		for j := uint16(0); j < fields[3]; j++ {
+			nativeReader := f.NativeReader(io.NewSectionReader(table.reader, auxOffset, verdauxSize))
+			nameOffset, err := nativeReader.Uint32()
+			if err != nil {
+				return nil, fmt.Errorf("%w definition %v name %v read: %v", ErrInvalidVersion, i, j, err)
+			}
+			next, err := nativeReader.Uint32()
+			if err != nil {
+				return nil, fmt.Errorf("%w definition %v name %v read: %v", ErrInvalidVersion, i, j, err)
+			}
+			name, err := table.strings.String(nameOffset)
+			if err != nil {
+				return nil, fmt.Errorf("%w definition %v name: %v", ErrInvalidVersion, i, err)
+			}
+			if j == 0 {
+				definition.Name = name
+			} else {
+				definition.Parents = append(definition.Parents, name)
+			}
+			if next == 0 {
+				break
+			}
+			auxOffset += int64(next)
+		}
+		definitions = append(definitions, definition)
+
+		if words[2] == 0 {
+			break
+		}
+		offset += int64(words[2])
+	}
+	return definitions, nil
+}
+
+// VersionNeeds returns entries of SHT_GNU_verneed section or DT_VERNEED table if there is no such section, nil if file needs no versions
+func (f *File) VersionNeeds() ([]VersionNeed, error) {
+	table, err := f.versionTable(SectionTypeGNUVerNeed, DynamicTagVerNeed, DynamicTagVerNeedNum)
+	if err != nil || table == nil {
+		return nil, err
+	}
+
+	var needs []VersionNeed
+	offset := table.offset
+	for i := uint32(0); i < table.count; i++ {
+		nativeReader := f.NativeReader(io.NewSectionReader(table.reader, offset, verneedSize))
+		var fields [2]uint16
+		for j := range fields {
+			if fields[j], err = nativeReader.Uint16(); err != nil {
+				return nil, fmt.Errorf("%w need %v read: %v", ErrInvalidVersion, i, err)
+			}
+		}
+		var words [3]uint32
+		for j := range words {
+			if words[j], err = nativeReader.Uint32(); err != nil {
+				return nil, fmt.Errorf("%w need %v read: %v", ErrInvalidVersion, i, err)
+			}
+		}
+		if fields[0] != 1 {
+			return nil, fmt.Errorf("%w need %v revision %v", ErrInvalidVersion, i, fields[0])
+		}
+		var need VersionNeed
+		if need.File, err = table.strings.String(words[0]); err != nil {
+			return nil, fmt.Errorf("%w need %v file: %v", ErrInvalidVersion, i, err)
+		}
+
+		auxOffset := offset + int64(words[1])
+		for j := uint16(0); j < fields[1]; j++ {
+			version, next, err := f.readNeededVersion(io.NewSectionReader(table.reader, auxOffset, vernauxSize), table.strings)
+			if err != nil {
+				return nil, fmt.Errorf("need %v version %v: %w", i, j, err)
+			}
+			need.Versions = append(need.Versions, version)
+			if next == 0 {
+				break
+			}
+			auxOffset += int64(next)
+		}
+		needs = append(needs, need)
+
+		if words[2] == 0 {
+			break
+		}
+		offset += int64(words[2])
+	}
+	return needs, nil
+}
+
+func (f *File) readNeededVersion(reader io.Reader, strings StringTable) (NeededVersion, uint32, error) {
+	var version NeededVersion
+	nativeReader := f.NativeReader(reader)
+	var err error
+	if version.Hash, err = nativeReader.Uint32(); err != nil {
+		return version, 0, fmt.Errorf("%w hash read: %v", ErrInvalidVersion, err)
+	}
+	flags, err := nativeReader.Uint16()
+	if err != nil {
+		return version, 0, fmt.Errorf("%w flags read: %v", ErrInvalidVersion, err)
+	}
+	version.Flags = VersionFlags(flags)
+	if version.Index, err = nativeReader.Uint16(); err != nil {
+		return version, 0, fmt.Errorf("%w index read: %v", ErrInvalidVersion, err)
+	}
+	nameOffset, err := nativeReader.Uint32()
+	if err != nil {
+		return version, 0, fmt.Errorf("%w name read: %v", ErrInvalidVersion, err)
+	}
+	if version.Name, err = strings.String(nameOffset); err != nil {
+		return version, 0, fmt.Errorf("%w name: %v", ErrInvalidVersion, err)
+	}
+	next, err := nativeReader.Uint32()
+	if err != nil {
+		return version, 0, fmt.Errorf("%w next read: %v", ErrInvalidVersion, err)
+	}
+	return version, next, nil
+}
+
+// versionTable locates verdef or verneed entries, returns nil if there are none
+func (f *File) versionTable(sectionType SectionType, addressTag, countTag DynamicTag) (*versionTable, error) {
+	if sections := f.SectionsByType(sectionType); len(sections) > 0 {
+		section := sections[0]
+		if int(section.Link) >= len(f.Sections) {
+			return nil, fmt.Errorf("%w string table index %v out of bounds: %v", ErrInvalidVersion, section.Link, len(f.Sections))
+		}
+		strings, err := f.Sections[section.Link].Data()
+		if err != nil {
+			return nil, fmt.Errorf("%w string table read: %v", ErrInvalidVersion, err)
+		}
+		return &versionTable{
+			reader:  io.NewSectionReader(section.reader, int64(section.Offset), int64(section.Size)),
+			count:   section.Info,
+			strings: StringTable(strings),
+		}, nil
+	}
+
+	entries, err := f.DynamicEntries()
+	if errors.Is(err, ErrNoDynamicSection) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	address, hasAddress := dynamicValue(entries, addressTag)
+	if !hasAddress {
+		return nil, nil
+	}
+	count, hasCount := dynamicValue(entries, countTag)
+	if !hasCount || count > math.MaxUint32 {
+		return nil, fmt.Errorf("%w %v without valid %v", ErrInvalidVersion, addressTag, countTag)
+	}
+	strings, err := f.dynamicStringTable(entries)
+	if err != nil {
+		return nil, err
+	}
+	return &versionTable{
+		reader:  f.VirtualMemory(),
+		offset:  int64(address),
+		count:   uint32(count),
+		strings: strings,
+	}, nil
+}
+
+// symbolVersionIndices returns versym entries for given count of dynamic symbols, nil if file has no versym table
+func (f *File) symbolVersionIndices(count int) ([]uint16, error) {
+	content := make([]byte, count*2)
+	if sections := f.SectionsByType(SectionTypeGNUVerSym); len(sections) > 0 {
+		data, err := sections[0].Data()
+		if err != nil {
+			return nil, fmt.Errorf("%w versym read: %v", ErrInvalidVersion, err)
+		}
+		if len(data) < len(content) {
+			return nil, fmt.Errorf("%w versym has %v entries for %v symbols", ErrInvalidVersion, len(data)/2, count)
+		}
+		copy(content, data)
+	} else {
+		entries, err := f.DynamicEntries()
+		if errors.Is(err, ErrNoDynamicSection) {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		address, ok := dynamicValue(entries, DynamicTagVerSym)
+		if !ok {
+			return nil, nil
+		}
+		if _, err := f.VirtualMemory().ReadAt(content, int64(address)); err != nil {
+			return nil, fmt.Errorf("%w DT_VERSYM read: %v", ErrInvalidVersion, err)
+		}
+	}
+
+	byteOrder := f.ByteOrder()
+	indices := make([]uint16, count)
+	for i := range indices {
+		indices[i] = byteOrder.Uint16(content[i*2:])
+	}
+	return indices, nil
+}
+
+// attachVersions fills version fields of dynamic symbols, symbols are left as is if file has no versym table
+func (f *File) attachVersions(symbols []Symbol) error {
+	indices, err := f.symbolVersionIndices(len(symbols))
+	if err != nil || indices == nil {
+		return err
+	}
+
+	type version struct {
+		name    string
+		library string
+	}
+	versions := map[uint16]version{}
+	definitions, err := f.VersionDefinitions()
+	if err != nil {
+		return err
+	}
+	for _, definition := range definitions {
+		if definition.Flags&VersionFlagBase == 0 {
+			versions[definition.Index] = version{name: definition.Name}
+		}
+	}
+	needs, err := f.VersionNeeds()
+	if err != nil {
+		return err
+	}
+	for _, need := range needs {
+		for _, needed := range need.Versions {
+			versions[needed.Index] = version{name: needed.Name, library: need.File}
+		}
+	}
+
+	for i, index := range indices {
+		symbols[i].VersionHidden = index&versionIndexHidden != 0
+		index &^= versionIndexHidden
+		if index == versionIndexLocal || index == versionIndexGlobal {
+			continue
+		}
+		v, ok := versions[index]
+		if !ok {
+			return fmt.Errorf("%w symbol %v refers to unknown version %v", ErrInvalidVersion, i, index)
+		}
+		symbols[i].Version = v.name
+		symbols[i].Library = v.library
+	}
+	return nil
+}
@@ -0,0 +1,189 @@
+package elf
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// PolicyVersionPrefixes are version name prefixes of toolchain runtime libraries tracked by version policies
+var PolicyVersionPrefixes = []string{"GLIBC", "GLIBCXX", "CXXABI", "GCC"}
+
+// SymbolVersion is version name split to prefix and numeric part, e.g. GLIBC_2.2.5 is GLIBC and 2, 2, 5
+type SymbolVersion struct {
+	Prefix  string
+	Numbers []int
+}
+
+var ErrInvalidSymbolVersion = errors.New("invalid symbol version name")
+
+// ParseSymbolVersion parses version name of PREFIX_N.N.N form
+func ParseSymbolVersion(name string) (SymbolVersion, error) {
+	separator := strings.LastIndexByte(name, '_')
+	if separator <= 0 {
+		return SymbolVersion{}, fmt.Errorf("%w: %v", ErrInvalidSymbolVersion, name)
+	}
+	numbers, err := parseVersionNumbers(name[separator+1:])
+	if err != nil {
+		return SymbolVersion{}, fmt.Errorf("%w: %v", ErrInvalidSymbolVersion, name)
+	}
+	return SymbolVersion{Prefix: name[:separator], Numbers: numbers}, nil
+}
+
+func parseVersionNumbers(version string) ([]int, error) {
+	var numbers []int
+	for _, part := range strings.Split(version, ".") {
+		number, err := strconv.Atoi(part)
+		if err != nil || number < 0 {
+			return nil, fmt.Errorf("%w number %q", ErrInvalidSymbolVersion, part)
+		}
+		numbers = append(numbers, number)
+	}
+	return numbers, nil
+}
+
+// Compare compares numeric parts of versions, missing trailing numbers are treated as zeros. Result is -1, 0 or 1
+func (sv SymbolVersion) Compare(other SymbolVersion) int {
+	for i := 0; i < len(sv.Numbers) || i < len(other.Numbers); i++ {
+		var a, b int
+		if i < len(sv.Numbers) {
+			a = sv.Numbers[i]
+		}
+		if i < len(other.Numbers) {
+			b = other.Numbers[i]
+		}
+		switch {
+		case a < b:
+			return -1
+		case a > b:
+			return 1
+		}
+	}
+	return 0
+}
+
+func (sv SymbolVersion) String() string {
+	parts := make([]string, len(sv.Numbers))
+	for i, number := range sv.Numbers {
+		parts[i] = strconv.Itoa(number)
+	}
+	return sv.Prefix + "_" + strings.Join(parts, ".")
+}
+
+// LibraryVersions is highest version of each tracked prefix required from single DT_NEEDED library
+type LibraryVersions struct {
+	Library  string
+	Versions []SymbolVersion // sorted by prefix
+}
+
+// RequiredVersions returns highest required GLIBC_, GLIBCXX_, CXXABI_ and GCC_ versions per DT_NEEDED library in DT_NEEDED order.
+// Libraries without any tracked versions are listed with empty Versions, statically linked file requires nothing
+func (f *File) RequiredVersions() ([]LibraryVersions, error) {
+	libraries, err := f.ImportedLibraries()
+	if errors.Is(err, ErrNoDynamicSection) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	needs, err := f.VersionNeeds()
+	if err != nil {
+		return nil, err
+	}
+
+	highest := map[string]map[string]SymbolVersion{}
+	for _, need := range needs {
+		for _, needed := range need.Versions {
+			version, err := ParseSymbolVersion(needed.Name)
+			if err != nil || !isPolicyPrefix(version.Prefix) {
+				continue
+			}
+			if highest[need.File] == nil {
+				highest[need.File] = map[string]SymbolVersion{}
+			}
+			if current, ok := highest[need.File][version.Prefix]; !ok || current.Compare(version) < 0 {
+				highest[need.File][version.Prefix] = version
+			}
+		}
+	}
+
+	result := make([]LibraryVersions, 0, len(libraries))
+	for _, library := range libraries {
+		libraryVersions := LibraryVersions{Library: library}
+		for _, version := range highest[library] {
+			libraryVersions.Versions = append(libraryVersions.Versions, version)
+		}
+		sort.Slice(libraryVersions.Versions, func(i, j int) bool {
+			return libraryVersions.Versions[i].Prefix < libraryVersions.Versions[j].Prefix
+		})
+		result = append(result, libraryVersions)
+	}
+	return result, nil
+}
+
+func isPolicyPrefix(prefix string) bool {
+	for _, candidate := range PolicyVersionPrefixes {
+		if candidate == prefix {
+			return true
+		}
+	}
+	return false
+}
+
+// VersionPolicy is highest allowed version per prefix, e.g. manylinux2014 allows up to GLIBC_2.17 and GLIBCXX_3.4.19.
+// JSON form is {"name": "manylinux2014", "max_versions": {"GLIBC": "2.17", "GLIBCXX": "3.4.19"}}
+type VersionPolicy struct {
+	Name        string
+	MaxVersions map[string]SymbolVersion
+}
+
+// PolicyViolation is required version higher than policy allows
+type PolicyViolation struct {
+	Library  string
+	Required SymbolVersion
+	Allowed  SymbolVersion
+}
+
+func (pv PolicyViolation) String() string {
+	return fmt.Sprintf("%v requires %v, allowed up to %v", pv.Library, pv.Required, pv.Allowed)
+}
+
+var ErrInvalidPolicy = errors.New("invalid version policy")
+
+// ReadVersionPolicy decodes policy from its JSON form
+func ReadVersionPolicy(reader io.Reader) (VersionPolicy, error) {
+	var raw struct {
+		Name        string            `json:"name"`
+		MaxVersions map[string]string `json:"max_versions"`
+	}
+	if err := json.NewDecoder(reader).Decode(&raw); err != nil {
+		return VersionPolicy{}, fmt.Errorf("%w decode: %v", ErrInvalidPolicy, err)
+	}
+	policy := VersionPolicy{Name: raw.Name, MaxVersions: map[string]SymbolVersion{}}
+	for prefix, version := range raw.MaxVersions {
+		numbers, err := parseVersionNumbers(version)
+		if err != nil {
+			return VersionPolicy{}, fmt.Errorf("%w %v version: %v", ErrInvalidPolicy, prefix, err)
+		}
+		policy.MaxVersions[prefix] = SymbolVersion{Prefix: prefix, Numbers: numbers}
+	}
+	return policy, nil
+}
+
+// Check returns required versions exceeding policy, prefixes not mentioned by policy are not restricted
+func (vp VersionPolicy) Check(required []LibraryVersions) []PolicyViolation {
+	var violations []PolicyViolation
+	for _, library := range required {
+		for _, version := range library.Versions {
+			allowed, ok := vp.MaxVersions[version.Prefix]
+			if ok && version.Compare(allowed) > 0 {
+				violations = append(violations, PolicyViolation{Library: library.Library, Required: version, Allowed: allowed})
+			}
+		}
+	}
+	return violations
+}
@@ -0,0 +1,107 @@
+package elf
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseSymbolVersion(t *testing.T) {
+	version, err := ParseSymbolVersion("GLIBC_2.2.5")
+	assert.NoError(t, err)
+	assert.Equal(t, SymbolVersion{Prefix: "GLIBC", Numbers: []int{2, 2, 5}}, version)
+	assert.Equal(t, "GLIBC_2.2.5", version.String())
+
+	version, err = ParseSymbolVersion("CXXABI_TM_1")
+	assert.NoError(t, err)
+	assert.Equal(t, "CXXABI_TM", version.Prefix)
+
+	for _, name := range []string{"GLIBC_PRIVATE", "libc.so.6", "_2.3", "GLIBC_2..3"} {
+		_, err = ParseSymbolVersion(name)
+		assert.True(t, errors.Is(err, ErrInvalidSymbolVersion), name)
+	}
+}
+
+func TestSymbolVersionCompare(t *testing.T) {
+	parse := func(name string) SymbolVersion {
+		version, err := ParseSymbolVersion(name)
+		assert.NoError(t, err)
+		return version
+	}
+	assert.Equal(t, -1, parse("GLIBC_2.2.5").Compare(parse("GLIBC_2.14")))
+	assert.Equal(t, 1, parse("GLIBCXX_3.4.21").Compare(parse("GLIBCXX_3.4")))
+	assert.Equal(t, 0, parse("GCC_3.0").Compare(parse("GCC_3")))
+}
+
+func TestRequiredVersions(t *testing.T) {
+	file, err := Open(filepath.Join("testdata", "sample_linux_amd64"))
+	assert.NoError(t, err)
+	defer file.Close()
+
+	required, err := file.RequiredVersions()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		"libsample.so.1:",
+		"libstdc++.so.6: CXXABI_1.3.9 GLIBCXX_3.4.21",
+		"libgcc_s.so.1: GCC_3.0",
+		"libc.so.6: GLIBC_2.34",
+	}, formatRequiredVersions(required))
+}
+
+func TestStaticFileRequiresNoVersions(t *testing.T) {
+	file, err := Open(filepath.Join("testdata", "helloworld_linux_amd64"))
+	assert.NoError(t, err)
+	defer file.Close()
+
+	required, err := file.RequiredVersions()
+	assert.NoError(t, err)
+	assert.Empty(t, required)
+}
+
+func TestVersionPolicyCheck(t *testing.T) {
+	policyFile, err := os.Open(filepath.Join("testdata", "manylinux2014_policy.json"))
+	assert.NoError(t, err)
+	defer policyFile.Close()
+	policy, err := ReadVersionPolicy(policyFile)
+	assert.NoError(t, err)
+	assert.Equal(t, "manylinux2014", policy.Name)
+
+	file, err := Open(filepath.Join("testdata", "sample_linux_amd64"))
+	assert.NoError(t, err)
+	defer file.Close()
+	required, err := file.RequiredVersions()
+	assert.NoError(t, err)
+
+	var violations []string
+	for _, violation := range policy.Check(required) {
+		violations = append(violations, violation.String())
+	}
+	assert.Equal(t, []string{
+		"libstdc++.so.6 requires CXXABI_1.3.9, allowed up to CXXABI_1.3.7",
+		"libstdc++.so.6 requires GLIBCXX_3.4.21, allowed up to GLIBCXX_3.4.19",
+		"libc.so.6 requires GLIBC_2.34, allowed up to GLIBC_2.17",
+	}, violations)
+}
+
+func TestInvalidVersionPolicy(t *testing.T) {
+	_, err := ReadVersionPolicy(strings.NewReader(`{"max_versions": {"GLIBC": "two"}}`))
+	assert.True(t, errors.Is(err, ErrInvalidPolicy))
+	_, err = ReadVersionPolicy(strings.NewReader(`[`))
+	assert.True(t, errors.Is(err, ErrInvalidPolicy))
+}
+
+func formatRequiredVersions(required []LibraryVersions) []string {
+	var lines []string
+	for _, library := range required {
+		line := library.Library + ":"
+		for _, version := range library.Versions {
+			line += " " + version.String()
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
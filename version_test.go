@@ -0,0 +1,117 @@
+package elf
+
+import (
+	"bytes"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVersionDefinitions(t *testing.T) {
+	file, err := Open(filepath.Join("testdata", "libsample.so"))
+	assert.NoError(t, err)
+	defer file.Close()
+
+	definitions, err := file.VersionDefinitions()
+	assert.NoError(t, err)
+	assert.Len(t, definitions, 3)
+	assert.Equal(t, "libsample.so.1", definitions[0].Name)
+	assert.Equal(t, VersionFlagBase, definitions[0].Flags)
+	assert.Equal(t, uint16(1), definitions[0].Index)
+	assert.Equal(t, VersionDefinition{Index: 2, Hash: SysVHash("SAMPLE_1.0"), Name: "SAMPLE_1.0"}, definitions[1])
+	assert.Equal(t, VersionDefinition{Index: 3, Hash: SysVHash("SAMPLE_2.0"), Name: "SAMPLE_2.0", Parents: []string{"SAMPLE_1.0"}}, definitions[2])
+}
+
+func TestVersionNeeds(t *testing.T) {
+	file, err := Open(filepath.Join("testdata", "sample_linux_amd64"))
+	assert.NoError(t, err)
+	defer file.Close()
+
+	needs, err := file.VersionNeeds()
+	assert.NoError(t, err)
+	assert.Len(t, needs, 4)
+	assert.Equal(t, "libsample.so.1", needs[1].File)
+	assert.Equal(t, []NeededVersion{
+		{Name: "SAMPLE_2.0", Hash: SysVHash("SAMPLE_2.0"), Index: 11},
+		{Name: "SAMPLE_1.0", Hash: SysVHash("SAMPLE_1.0"), Index: 5},
+	}, needs[1].Versions)
+	assert.Equal(t, "libc.so.6", needs[3].File)
+	assert.Len(t, needs[3].Versions, 3)
+	assert.Equal(t, "GLIBC_2.14", needs[3].Versions[0].Name)
+
+	definitions, err := file.VersionDefinitions()
+	assert.NoError(t, err)
+	assert.Nil(t, definitions)
+}
+
+func TestDynamicSymbolVersions(t *testing.T) {
+	file, err := Open(filepath.Join("testdata", "libsample.so"))
+	assert.NoError(t, err)
+	defer file.Close()
+
+	symbols, err := file.DynamicSymbols()
+	assert.NoError(t, err)
+	assertSampleSymbolVersions(t, symbols)
+
+	// sample_value@SAMPLE_1.0 is hidden, default sample_value@@SAMPLE_2.0 has to be found
+	symbol, err := file.LookupDynamicSymbol("sample_value")
+	assert.NoError(t, err)
+	assert.Equal(t, "SAMPLE_2.0", symbol.Version)
+	assert.False(t, symbol.VersionHidden)
+}
+
+func TestDynamicSymbolVersionsWithoutSectionHeaders(t *testing.T) {
+	file, err := NewFile(bytes.NewReader(withoutSectionHeaders(t, "libsample.so")))
+	assert.NoError(t, err)
+
+	symbols, err := file.DynamicSymbols()
+	assert.NoError(t, err)
+	assertSampleSymbolVersions(t, symbols)
+
+	definitions, err := file.VersionDefinitions()
+	assert.NoError(t, err)
+	assert.Len(t, definitions, 3)
+	needs, err := file.VersionNeeds()
+	assert.NoError(t, err)
+	assert.Len(t, needs, 1)
+}
+
+func TestNeededSymbolVersions(t *testing.T) {
+	file, err := Open(filepath.Join("testdata", "sample_linux_amd64"))
+	assert.NoError(t, err)
+	defer file.Close()
+
+	symbols, err := file.DynamicSymbols()
+	assert.NoError(t, err)
+	assert.Len(t, symbols, 30)
+	for _, symbol := range symbols {
+		if symbol.Name == "sample_name" {
+			assert.Equal(t, "SAMPLE_1.0", symbol.Version)
+			assert.Equal(t, "libsample.so.1", symbol.Library)
+		}
+	}
+	assert.Equal(t, "GLIBC_2.14", symbols[11].Version)
+	assert.Equal(t, "libc.so.6", symbols[11].Library)
+	assert.Equal(t, "", symbols[0x15].Version)
+}
+
+func TestVersionFlagsString(t *testing.T) {
+	assert.Equal(t, "BASE WEAK", (VersionFlagBase | VersionFlagWeak).String())
+}
+
+func assertSampleSymbolVersions(t *testing.T, symbols []Symbol) {
+	assert.Len(t, symbols, 12)
+	assert.Equal(t, "getenv", symbols[1].Name)
+	assert.Equal(t, "GLIBC_2.2.5", symbols[1].Version)
+	assert.Equal(t, "libc.so.6", symbols[1].Library)
+	assert.Equal(t, "", symbols[4].Version)
+
+	assert.Equal(t, "sample_value", symbols[9].Name)
+	assert.Equal(t, "SAMPLE_2.0", symbols[9].Version)
+	assert.False(t, symbols[9].VersionHidden)
+	assert.Equal(t, "", symbols[9].Library)
+	assert.Equal(t, "sample_value", symbols[11].Name)
+	assert.Equal(t, "SAMPLE_1.0", symbols[11].Version)
+	assert.True(t, symbols[11].VersionHidden)
+}